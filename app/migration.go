@@ -0,0 +1,241 @@
+package app
+
+import (
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MigrateProductTaxRates moves products from the old per product tax_rate column onto tax classes. Every
+// distinct rate becomes a class (Exempt for 0, VAT otherwise) and the column is dropped afterwards, so
+// the migration only runs once.
+func MigrateProductTaxRates(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.Product{}, "tax_rate") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var rates []float64
+		if err := tx.Table("products").Distinct("tax_rate").Where("tax_id IS NULL").
+			Order("tax_rate").Pluck("tax_rate", &rates).Error; err != nil {
+			return err
+		}
+
+		for _, rate := range rates {
+			tax := domain.Tax{Name: fmt.Sprintf("VAT %g%%", rate), TaxRate: rate, TaxType: domain.TaxTypeVAT}
+			if rate == 0 {
+				tax = domain.Tax{Name: "Exempt", TaxType: domain.TaxTypeExempt}
+			}
+			if err := tx.Where(&domain.Tax{TaxRate: tax.TaxRate, TaxType: tax.TaxType}).FirstOrCreate(&tax).Error; err != nil {
+				return err
+			}
+			if err := tx.Table("products").Where("tax_id IS NULL AND tax_rate = ?", rate).
+				Update("tax_id", tax.TaxID).Error; err != nil {
+				return err
+			}
+			log.Printf("Moved products taxed at %g%% to tax class %q", rate, tax.Name)
+		}
+
+		return tx.Migrator().DropColumn(&domain.Product{}, "tax_rate")
+	})
+}
+
+// MigrateOpeningStock gives every product that has stock but no movements yet an opening movement, so
+// stock kept from before the ledger existed still adds up when it is rebuilt from the ledger
+func MigrateOpeningStock(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO stock_movements (product_id, delta, reason, reference, created_at)
+		SELECT products.id, products.stock_qty, ?, ?, ? FROM products
+		WHERE products.stock_qty <> 0
+		AND NOT EXISTS (SELECT 1 FROM stock_movements WHERE stock_movements.product_id = products.id)`,
+		domain.StockReasonAdjustment, "Opening stock", time.Now()).Error
+}
+
+// MigrateOpeningPoints gives every customer whose points were set by hand before the loyalty ledger
+// existed an opening adjustment, so their points add up to their ledger
+func MigrateOpeningPoints(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO loyalty_transactions (customer_id, type, points, reference, created_at)
+		SELECT customers.id, ?, customers.loyalty_pts, ?, ? FROM customers
+		WHERE customers.loyalty_pts <> 0
+		AND NOT EXISTS (SELECT 1 FROM loyalty_transactions WHERE loyalty_transactions.customer_id = customers.id)`,
+		domain.LoyaltyTypeAdjustment, "Opening balance", time.Now()).Error
+}
+
+// MigrateDefaultStore puts everything from before the chain had stores into one main store: its orders,
+// shifts, stock movements, purchase orders and stocktakes, its staff and the stock of every product. It only
+// runs while there are no stores yet.
+func MigrateDefaultStore(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&domain.Store{}).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		store := domain.Store{Code: "MAIN", Name: "Main store", Active: true}
+		if err := tx.Create(&store).Error; err != nil {
+			return err
+		}
+
+		for _, table := range []string{"orders", "shifts", "stock_movements", "purchase_orders", "stocktakes"} {
+			if err := tx.Table(table).Where("store_id = 0 OR store_id IS NULL").
+				Update("store_id", store.StoreID).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Table("employees").Where("store_id IS NULL").Update("store_id", store.StoreID).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`INSERT INTO store_products (store_id, product_id, stock_qty)
+			SELECT ?, products.id, products.stock_qty FROM products`, store.StoreID).Error; err != nil {
+			return err
+		}
+		log.Printf("Moved existing stock and records to store %q", store.Code)
+		return nil
+	})
+}
+
+// MigrateTierThresholds splits the one threshold of the loyalty tiers, which meant spend or points depending
+// on the tier basis, into a spend and a points threshold. Both start out as the old value, so the tiers keep
+// working whichever basis is set. It only runs while the old threshold is still a float, so it has to run
+// before MigrateMoneyColumns turns that into the spend threshold in minor units.
+func MigrateTierThresholds(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&domain.LoyaltyTier{}) {
+		return nil
+	}
+	columnType, err := gormMoneyColumns{db: db}.Type("loyalty_tiers", "threshold")
+	if err != nil || !isFloatColumn(columnType) {
+		return err
+	}
+
+	if !db.Migrator().HasColumn(&domain.LoyaltyTier{}, "PointsThreshold") {
+		if err := db.Migrator().AddColumn(&domain.LoyaltyTier{}, "PointsThreshold"); err != nil {
+			return err
+		}
+	}
+	return db.Exec("UPDATE loyalty_tiers SET points_threshold = ROUND(threshold)").Error
+}
+
+// MigrateMoneyColumns turns the floating point amount columns of the models into whole minor units. Every
+// money.Money column still stored as a float is copied, scaled by money.Scale and rounded to the nearest minor
+// unit, into a new integer column that then takes its place. The copy is always taken from the untouched float
+// column, which is only dropped once the copy is done, so a migration cut off half way picks up where it
+// stopped on the next start and never scales an amount twice. Amounts written by the old code had two decimals
+// at most, nothing is lost. It has to run before AutoMigrate alters the columns on its own.
+func MigrateMoneyColumns(db *gorm.DB, models ...interface{}) error {
+	columns := gormMoneyColumns{db: db}
+	moneyType := reflect.TypeOf(money.Money(0))
+	for _, model := range models {
+		if !db.Migrator().HasTable(model) {
+			continue
+		}
+		statement := &gorm.Statement{DB: db}
+		if err := statement.Parse(model); err != nil {
+			return err
+		}
+
+		for _, field := range statement.Schema.Fields {
+			if field.FieldType != moneyType {
+				continue
+			}
+			if err := migrateMoneyColumn(columns, statement.Schema.Table, field.DBName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// moneyColumns are the steps MigrateMoneyColumns takes on a table
+type moneyColumns interface {
+	Type(table string, column string) (string, error) // lower case type name, empty when there is no such column
+	AddInteger(table string, column string) error
+	CopyScaled(table string, from string, to string) error
+	Drop(table string, column string) error
+	Rename(table string, from string, to string) error
+}
+
+// migrateMoneyColumn moves a float column into minor units through a staging column named after it. Every
+// step checks what the previous run left behind, so it is safe to run again after any of them failed.
+func migrateMoneyColumn(columns moneyColumns, table string, column string) error {
+	staging := column + "_minor"
+	columnType, err := columns.Type(table, column)
+	if err != nil {
+		return err
+	}
+	stagingType, err := columns.Type(table, staging)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case isFloatColumn(columnType):
+		if stagingType == "" {
+			if err := columns.AddInteger(table, staging); err != nil {
+				return err
+			}
+		}
+		if err := columns.CopyScaled(table, column, staging); err != nil {
+			return err
+		}
+		if err := columns.Drop(table, column); err != nil {
+			return err
+		}
+	case columnType == "":
+		// Dropped by a run that stopped before the rename, the staging column holds the minor units
+		if stagingType == "" {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	if err := columns.Rename(table, staging, column); err != nil {
+		return err
+	}
+	log.Printf("Converted %s.%s to minor units", table, column)
+	return nil
+}
+
+func isFloatColumn(columnType string) bool {
+	return columnType == "double" || columnType == "float" || columnType == "real"
+}
+
+type gormMoneyColumns struct {
+	db *gorm.DB
+}
+
+func (columns gormMoneyColumns) Type(table string, column string) (string, error) {
+	columnTypes, err := columns.db.Migrator().ColumnTypes(table)
+	if err != nil {
+		return "", err
+	}
+	for _, columnType := range columnTypes {
+		if columnType.Name() == column {
+			return strings.ToLower(columnType.DatabaseTypeName()), nil
+		}
+	}
+	return "", nil
+}
+
+func (columns gormMoneyColumns) AddInteger(table string, column string) error {
+	return columns.db.Exec("ALTER TABLE ? ADD COLUMN ? BIGINT", clause.Table{Name: table}, clause.Column{Name: column}).Error
+}
+
+func (columns gormMoneyColumns) CopyScaled(table string, from string, to string) error {
+	return columns.db.Exec("UPDATE ? SET ? = ROUND(? * ?)", clause.Table{Name: table}, clause.Column{Name: to},
+		clause.Column{Name: from}, money.Scale).Error
+}
+
+func (columns gormMoneyColumns) Drop(table string, column string) error {
+	return columns.db.Exec("ALTER TABLE ? DROP COLUMN ?", clause.Table{Name: table}, clause.Column{Name: column}).Error
+}
+
+func (columns gormMoneyColumns) Rename(table string, from string, to string) error {
+	return columns.db.Exec("ALTER TABLE ? CHANGE ? ? BIGINT", clause.Table{Name: table}, clause.Column{Name: from},
+		clause.Column{Name: to}).Error
+}
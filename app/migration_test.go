@@ -0,0 +1,107 @@
+package app
+
+import (
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// fakeMoneyColumns is a single table held in memory, failing the step named in failOn once
+type fakeMoneyColumns struct {
+	types  map[string]string
+	values map[string][]float64
+	failOn string
+}
+
+func newFakeMoneyColumns(amounts ...float64) *fakeMoneyColumns {
+	return &fakeMoneyColumns{
+		types:  map[string]string{"id": "bigint", "amount": "double"},
+		values: map[string][]float64{"amount": amounts},
+	}
+}
+
+func (columns *fakeMoneyColumns) fail(step string) error {
+	if columns.failOn == step {
+		columns.failOn = ""
+		return errors.New("connection lost during " + step)
+	}
+	return nil
+}
+
+func (columns *fakeMoneyColumns) Type(table string, column string) (string, error) {
+	return columns.types[column], nil
+}
+
+func (columns *fakeMoneyColumns) AddInteger(table string, column string) error {
+	if err := columns.fail("AddInteger"); err != nil {
+		return err
+	}
+	columns.types[column] = "bigint"
+	columns.values[column] = make([]float64, len(columns.values["amount"]))
+	return nil
+}
+
+func (columns *fakeMoneyColumns) CopyScaled(table string, from string, to string) error {
+	if err := columns.fail("CopyScaled"); err != nil {
+		return err
+	}
+	for i, value := range columns.values[from] {
+		columns.values[to][i] = math.Round(value * money.Scale)
+	}
+	return nil
+}
+
+func (columns *fakeMoneyColumns) Drop(table string, column string) error {
+	if err := columns.fail("Drop"); err != nil {
+		return err
+	}
+	delete(columns.types, column)
+	delete(columns.values, column)
+	return nil
+}
+
+func (columns *fakeMoneyColumns) Rename(table string, from string, to string) error {
+	if err := columns.fail("Rename"); err != nil {
+		return err
+	}
+	columns.types[to], columns.values[to] = columns.types[from], columns.values[from]
+	delete(columns.types, from)
+	delete(columns.values, from)
+	return nil
+}
+
+func TestMigrateMoneyColumnTwice(t *testing.T) {
+	columns := newFakeMoneyColumns(12.5, 0.1, 3, 19999.99)
+
+	assert.NoError(t, migrateMoneyColumn(columns, "payments", "amount"))
+	assert.NoError(t, migrateMoneyColumn(columns, "payments", "amount"))
+
+	assert.Equal(t, map[string]string{"id": "bigint", "amount": "bigint"}, columns.types)
+	assert.Equal(t, []float64{1250, 10, 300, 1999999}, columns.values["amount"])
+}
+
+func TestMigrateMoneyColumnAfterFailure(t *testing.T) {
+	for _, step := range []string{"AddInteger", "CopyScaled", "Drop", "Rename"} {
+		t.Run(step, func(t *testing.T) {
+			columns := newFakeMoneyColumns(12.5, 0.1, 3, 19999.99)
+			columns.failOn = step
+
+			assert.Error(t, migrateMoneyColumn(columns, "payments", "amount"))
+			assert.NoError(t, migrateMoneyColumn(columns, "payments", "amount"))
+			assert.NoError(t, migrateMoneyColumn(columns, "payments", "amount"))
+
+			assert.Equal(t, map[string]string{"id": "bigint", "amount": "bigint"}, columns.types)
+			assert.Equal(t, []float64{1250, 10, 300, 1999999}, columns.values["amount"])
+		})
+	}
+}
+
+func TestMigrateMoneyColumnMissing(t *testing.T) {
+	columns := newFakeMoneyColumns()
+	delete(columns.types, "amount")
+
+	assert.NoError(t, migrateMoneyColumn(columns, "payments", "amount"))
+	assert.Equal(t, map[string]string{"id": "bigint"}, columns.types)
+}
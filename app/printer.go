@@ -0,0 +1,22 @@
+package app
+
+import "github.com/Kahffi/go-rest-api-test/render"
+
+// NewPrinterProfiles returns the printer profiles receipts can be printed with, keyed by name
+func NewPrinterProfiles() map[string]render.PrinterProfile {
+	return map[string]render.PrinterProfile{
+		render.Profile58mm.Name: render.Profile58mm,
+		render.Profile80mm.Name: render.Profile80mm,
+	}
+}
+
+// NewStoreHeader returns the store details printed on top of every receipt
+func NewStoreHeader() render.StoreHeader {
+	return render.StoreHeader{
+		Name:    "Go REST Store",
+		Address: "Jl. Merdeka No. 1, Jakarta",
+		Phone:   "021-1234567",
+		TaxID:   "NPWP 00.000.000.0-000.000",
+		Footer:  "Thank you for shopping with us",
+	}
+}
@@ -8,7 +8,16 @@ import (
 
 func NewRouter(app *fiber.App, categoryController controller.CategoryController,
 	customerController controller.CustomerController, employeeController controller.EmployeeController,
-	productController controller.ProductController) {
+	productController controller.ProductController, orderController controller.OrderController,
+	paymentController controller.PaymentController, receiptController controller.ReceiptController,
+	invoiceController controller.InvoiceController, discountController controller.DiscountController,
+	promotionController controller.PromotionController, taxController controller.TaxController,
+	inventoryController controller.InventoryController, supplierController controller.SupplierController,
+	purchaseOrderController controller.PurchaseOrderController, stocktakeController controller.StocktakeController,
+	orderReturnController controller.OrderReturnController, loyaltyController controller.LoyaltyController,
+	giftCardController controller.GiftCardController, shiftController controller.ShiftController,
+	reportController controller.ReportController, exchangeRateController controller.ExchangeRateController,
+	storeController controller.StoreController, stockTransferController controller.StockTransferController) {
 	authMiddleware := middleware.NewAuthMiddleware()
 
 	api := app.Group("/api", authMiddleware)
@@ -16,6 +25,23 @@ func NewRouter(app *fiber.App, categoryController controller.CategoryController,
 	customers := api.Group("/customers")
 	products := api.Group("/products")
 	employees := api.Group("/employees")
+	orders := api.Group("/orders")
+	receipts := api.Group("/receipts")
+	discounts := api.Group("/discounts")
+	promotions := api.Group("/promotions")
+	taxes := api.Group("/taxes")
+	settings := api.Group("/settings")
+	inventory := api.Group("/inventory")
+	suppliers := api.Group("/suppliers")
+	purchaseOrders := api.Group("/purchase-orders")
+	stocktakes := api.Group("/stocktakes")
+	loyalty := api.Group("/loyalty")
+	giftCards := api.Group("/gift-cards")
+	shifts := api.Group("/shifts")
+	reports := api.Group("/reports")
+	exchangeRates := api.Group("/exchange-rates")
+	stores := api.Group("/stores")
+	stockTransfers := api.Group("/stock-transfers")
 
 	categories.Get("/", categoryController.FindAll)
 	categories.Get("/:categoryId", categoryController.FindById)
@@ -28,11 +54,14 @@ func NewRouter(app *fiber.App, categoryController controller.CategoryController,
 	customers.Post("/", customerController.Create)
 	customers.Put("/:customerId", customerController.Update)
 	customers.Delete("/:customerId", customerController.Delete)
+	customers.Get("/:customerId/points", loyaltyController.FindLedger)
+	customers.Post("/:customerId/points/adjustments", loyaltyController.Adjust)
 
 	products.Get("/", productController.FindAll)
 	products.Get("/:productId", productController.FindById)
 	products.Post("/", productController.Create)
 	products.Put("/:productId", productController.Update)
+	products.Put("/:productId/variants/:variantId", productController.UpdateVariant)
 	products.Delete("/:productId", productController.Delete)
 
 	employees.Get("/", employeeController.FindAll)
@@ -41,4 +70,127 @@ func NewRouter(app *fiber.App, categoryController controller.CategoryController,
 	employees.Put("/:employeeId", employeeController.Update)
 	employees.Delete("/:employeeId", employeeController.Delete)
 
+	orders.Get("/", orderController.FindAll)
+	orders.Get("/:orderId", orderController.FindById)
+	orders.Post("/", orderController.Create)
+	orders.Post("/:orderId/checkout", orderController.Checkout)
+	orders.Post("/:orderId/cancel", orderController.Cancel)
+
+	orders.Get("/:orderId/payments", paymentController.FindByOrderId)
+	orders.Post("/:orderId/payments", paymentController.Create)
+	orders.Post("/:orderId/payments/:paymentId/complete", paymentController.Complete)
+	orders.Post("/:orderId/payments/:paymentId/refund", paymentController.Refund)
+	orders.Post("/:orderId/payments/:paymentId/void", paymentController.Void)
+	orders.Get("/:orderId/returns", orderReturnController.FindByOrderId)
+	orders.Post("/:orderId/returns", orderReturnController.Create)
+	orders.Get("/:orderId/receipt", receiptController.FindByOrderId)
+	orders.Get("/:orderId/invoice.pdf", invoiceController.OrderInvoice)
+
+	// Registered before /:receiptId, which would otherwise swallow "12.pdf"
+	receipts.Get("/:receiptId.pdf", invoiceController.ReceiptInvoice)
+	receipts.Get("/:receiptId", receiptController.FindById)
+	receipts.Get("/:receiptId/print", receiptController.Print)
+
+	discounts.Get("/", discountController.FindAll)
+	discounts.Get("/:discountId", discountController.FindById)
+	discounts.Post("/", discountController.Create)
+	discounts.Put("/:discountId", discountController.Update)
+	discounts.Delete("/:discountId", discountController.Delete)
+
+	promotions.Get("/", promotionController.FindAll)
+	promotions.Get("/:promotionId", promotionController.FindById)
+	promotions.Post("/", promotionController.Create)
+	promotions.Put("/:promotionId", promotionController.Update)
+	promotions.Delete("/:promotionId", promotionController.Delete)
+
+	taxes.Get("/", taxController.FindAll)
+	taxes.Get("/:taxId", taxController.FindById)
+	taxes.Post("/", taxController.Create)
+	taxes.Put("/:taxId", taxController.Update)
+	taxes.Delete("/:taxId", taxController.Delete)
+
+	settings.Get("/tax", taxController.FindSettings)
+	settings.Put("/tax", taxController.UpdateSettings)
+	settings.Get("/money", taxController.FindMoneySettings)
+	settings.Put("/money", taxController.UpdateMoneySettings)
+	settings.Get("/loyalty", loyaltyController.FindSettings)
+	settings.Put("/loyalty", loyaltyController.UpdateSettings)
+
+	inventory.Get("/", inventoryController.FindAll)
+	// Registered before /:productId, which would otherwise take "low-stock" for an id
+	inventory.Get("/low-stock", inventoryController.FindLowStock)
+	inventory.Get("/:productId", inventoryController.FindByProductId)
+	inventory.Put("/:productId", inventoryController.Update)
+	inventory.Post("/:productId/restock", inventoryController.Restock)
+	inventory.Post("/:productId/adjustments", inventoryController.Adjust)
+	inventory.Get("/:productId/movements", inventoryController.FindMovements)
+	inventory.Get("/:productId/audit", inventoryController.Audit)
+
+	suppliers.Get("/", supplierController.FindAll)
+	suppliers.Get("/:supplierId", supplierController.FindById)
+	suppliers.Post("/", supplierController.Create)
+	suppliers.Put("/:supplierId", supplierController.Update)
+	suppliers.Delete("/:supplierId", supplierController.Delete)
+
+	purchaseOrders.Get("/", purchaseOrderController.FindAll)
+	purchaseOrders.Get("/:purchaseOrderId", purchaseOrderController.FindById)
+	purchaseOrders.Post("/", purchaseOrderController.Create)
+	purchaseOrders.Put("/:purchaseOrderId", purchaseOrderController.Update)
+	purchaseOrders.Delete("/:purchaseOrderId", purchaseOrderController.Delete)
+	purchaseOrders.Post("/:purchaseOrderId/send", purchaseOrderController.Send)
+	purchaseOrders.Post("/:purchaseOrderId/receive", purchaseOrderController.Receive)
+
+	stocktakes.Get("/", stocktakeController.FindAll)
+	stocktakes.Get("/:stocktakeId", stocktakeController.FindById)
+	stocktakes.Post("/", stocktakeController.Create)
+	stocktakes.Post("/:stocktakeId/counts", stocktakeController.RecordCounts)
+	stocktakes.Get("/:stocktakeId/variances", stocktakeController.FindVariances)
+	stocktakes.Post("/:stocktakeId/commit", stocktakeController.Commit)
+	stocktakes.Post("/:stocktakeId/cancel", stocktakeController.Cancel)
+
+	loyalty.Get("/rules", loyaltyController.FindRules)
+	loyalty.Get("/rules/:ruleId", loyaltyController.FindRuleById)
+	loyalty.Post("/rules", loyaltyController.CreateRule)
+	loyalty.Put("/rules/:ruleId", loyaltyController.UpdateRule)
+	loyalty.Delete("/rules/:ruleId", loyaltyController.DeleteRule)
+	loyalty.Get("/tiers", loyaltyController.FindTiers)
+	loyalty.Get("/tiers/:tierId", loyaltyController.FindTierById)
+	loyalty.Post("/tiers", loyaltyController.CreateTier)
+	loyalty.Post("/tiers/review", loyaltyController.ReviewTiers)
+	loyalty.Put("/tiers/:tierId", loyaltyController.UpdateTier)
+	loyalty.Delete("/tiers/:tierId", loyaltyController.DeleteTier)
+
+	giftCards.Get("/", giftCardController.FindAll)
+	giftCards.Get("/:code", giftCardController.FindByCode)
+	giftCards.Post("/", giftCardController.Issue)
+	giftCards.Post("/:code/top-ups", giftCardController.TopUp)
+	giftCards.Get("/:code/transactions", giftCardController.FindLedger)
+
+	shifts.Get("/", shiftController.FindAll)
+	shifts.Get("/:shiftId", shiftController.FindById)
+	shifts.Post("/", shiftController.Open)
+	shifts.Post("/:shiftId/close", shiftController.Close)
+	shifts.Post("/:shiftId/cash-movements", shiftController.RecordCashMovement)
+	shifts.Get("/:shiftId/report", shiftController.FindReport)
+
+	reports.Get("/sales", reportController.SalesReport)
+
+	exchangeRates.Get("/", exchangeRateController.FindAll)
+	exchangeRates.Get("/:exchangeRateId", exchangeRateController.FindById)
+	exchangeRates.Post("/", exchangeRateController.Create)
+	exchangeRates.Delete("/:exchangeRateId", exchangeRateController.Delete)
+
+	stores.Get("/", storeController.FindAll)
+	stores.Get("/:storeId", storeController.FindById)
+	stores.Post("/", storeController.Create)
+	stores.Put("/:storeId", storeController.Update)
+	stores.Put("/:storeId/prices/:productId", storeController.SetPrice)
+	stores.Delete("/:storeId/prices/:productId", storeController.ClearPrice)
+
+	stockTransfers.Get("/", stockTransferController.FindAll)
+	stockTransfers.Get("/:stockTransferId", stockTransferController.FindById)
+	stockTransfers.Post("/", stockTransferController.Create)
+	stockTransfers.Delete("/:stockTransferId", stockTransferController.Delete)
+	stockTransfers.Post("/:stockTransferId/ship", stockTransferController.Ship)
+	stockTransfers.Post("/:stockTransferId/receive", stockTransferController.Receive)
 }
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunDaily runs job once a day, starting a day from now. A failing run is logged and retried the next day.
+func RunDaily(name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := job(context.Background()); err != nil {
+			log.Printf("Scheduled %s failed: %v", name, err)
+		}
+	}
+}
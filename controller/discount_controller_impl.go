@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type DiscountControllerImpl struct {
+	DiscountService service.DiscountService
+}
+
+func NewDiscountController(discountService service.DiscountService) DiscountController {
+	return &DiscountControllerImpl{
+		DiscountService: discountService,
+	}
+}
+
+// Create Discount
+func (controller *DiscountControllerImpl) Create(c *fiber.Ctx) error {
+	discountCreateRequest := new(web.DiscountCreateRequest)
+	if err := c.BodyParser(discountCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	discountResponse, err := controller.DiscountService.Create(c.Context(), *discountCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   discountResponse,
+	})
+}
+
+// Update Discount
+func (controller *DiscountControllerImpl) Update(c *fiber.Ctx) error {
+	discountUpdateRequest := new(web.DiscountUpdateRequest)
+	if err := c.BodyParser(discountUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("discountId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Discount ID",
+			Data:   err.Error(),
+		})
+	}
+	discountUpdateRequest.Id = id
+
+	discountResponse, err := controller.DiscountService.Update(c.Context(), *discountUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   discountResponse,
+	})
+}
+
+// Delete Discount
+func (controller *DiscountControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("discountId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Discount ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.DiscountService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Find Discount By ID
+func (controller *DiscountControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("discountId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Discount ID",
+			Data:   err.Error(),
+		})
+	}
+
+	discountResponse, err := controller.DiscountService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   discountResponse,
+	})
+}
+
+// Find All Discounts
+func (controller *DiscountControllerImpl) FindAll(c *fiber.Ctx) error {
+	discountResponses, err := controller.DiscountService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   discountResponses,
+	})
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppDiscount(mockService *mocks.MockDiscountService) *fiber.App {
+	app := fiber.New()
+	discountController := NewDiscountController(mockService)
+
+	api := app.Group("/api")
+	discounts := api.Group("/discounts")
+	discounts.Get("/", discountController.FindAll)
+	discounts.Get("/:discountId", discountController.FindById)
+	discounts.Post("/", discountController.Create)
+	discounts.Put("/:discountId", discountController.Update)
+	discounts.Delete("/:discountId", discountController.Delete)
+
+	return app
+}
+
+func TestDiscountController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockDiscountService(ctrl)
+	app := setupTestAppDiscount(mockService)
+
+	discountBody := `{"description":"Weekend","discount_pct":10,"scope":"Order",` +
+		`"valid_from":"2024-01-01T00:00:00Z","valid_until":"2024-01-31T00:00:00Z"}`
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create discount - success",
+			method: "POST",
+			url:    "/api/discounts",
+			body:   strings.NewReader(discountBody),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.DiscountResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create discount - validation error",
+			method: "POST",
+			url:    "/api/discounts",
+			body:   strings.NewReader(`{"description":"Weekend"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.DiscountResponse{}, validator.ValidationErrors{})
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Update discount - not found",
+			method: "PUT",
+			url:    "/api/discounts/2",
+			body:   strings.NewReader(discountBody),
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(web.DiscountResponse{}, exception.NewNotFoundError("Discount not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Delete discount - success",
+			method: "DELETE",
+			url:    "/api/discounts/1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(1)).Return(nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "Deleted Successfully",
+		},
+		{
+			name:               "Find discount - invalid id",
+			method:             "GET",
+			url:                "/api/discounts/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Discount ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -156,9 +156,14 @@ func (controller *EmployeeControllerImpl) FindById(c *fiber.Ctx) error {
 	})
 }
 
-// Find All Categories
+// Find All Employees, ?store_id= narrows them to the ones assigned to a store
 func (controller *EmployeeControllerImpl) FindAll(c *fiber.Ctx) error {
-	employeeResponses, err := controller.EmployeeService.FindAll(c.Context())
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	employeeResponses, err := controller.EmployeeService.FindAll(c.Context(), storeId)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
 			Code:   fiber.StatusInternalServerError,
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// errorResponse maps a service error onto the matching HTTP status
+func errorResponse(c *fiber.Ctx, err error) error {
+	var notFoundError exception.NotFoundError
+	var conflictError exception.ConflictError
+	var insufficientStockError exception.InsufficientStockError
+	var badRequestError exception.BadRequestError
+	var validationErrors validator.ValidationErrors
+
+	switch {
+	case errors.As(err, &notFoundError):
+		return c.Status(fiber.StatusNotFound).JSON(web.WebResponse{
+			Code:   fiber.StatusNotFound,
+			Status: "Not Found",
+			Data:   err.Error(),
+		})
+	case errors.As(err, &validationErrors), errors.As(err, &badRequestError):
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	case errors.As(err, &insufficientStockError):
+		return c.Status(fiber.StatusConflict).JSON(web.WebResponse{
+			Code:   fiber.StatusConflict,
+			Status: err.Error(),
+			Data:   insufficientStockError.Items,
+		})
+	case errors.As(err, &conflictError):
+		return c.Status(fiber.StatusConflict).JSON(web.WebResponse{
+			Code:   fiber.StatusConflict,
+			Status: "Conflict",
+			Data:   err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
+			Code:   fiber.StatusInternalServerError,
+			Status: "Internal Server Error",
+			Data:   err.Error(),
+		})
+	}
+}
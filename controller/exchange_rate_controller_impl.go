@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type ExchangeRateControllerImpl struct {
+	ExchangeRateService service.ExchangeRateService
+}
+
+func NewExchangeRateController(exchangeRateService service.ExchangeRateService) ExchangeRateController {
+	return &ExchangeRateControllerImpl{
+		ExchangeRateService: exchangeRateService,
+	}
+}
+
+// Create Exchange rate
+func (controller *ExchangeRateControllerImpl) Create(c *fiber.Ctx) error {
+	rateCreateRequest := new(web.ExchangeRateCreateRequest)
+	if err := c.BodyParser(rateCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	rateResponse, err := controller.ExchangeRateService.Create(c.Context(), *rateCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   rateResponse,
+	})
+}
+
+// Delete Exchange rate
+func (controller *ExchangeRateControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("exchangeRateId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Exchange Rate ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.ExchangeRateService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Find Exchange rate By ID
+func (controller *ExchangeRateControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("exchangeRateId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Exchange Rate ID",
+			Data:   err.Error(),
+		})
+	}
+
+	rateResponse, err := controller.ExchangeRateService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   rateResponse,
+	})
+}
+
+// Find All Exchange rates
+func (controller *ExchangeRateControllerImpl) FindAll(c *fiber.Ctx) error {
+	rateResponses, err := controller.ExchangeRateService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   rateResponses,
+	})
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppExchangeRate(mockService *mocks.MockExchangeRateService) *fiber.App {
+	app := fiber.New()
+	exchangeRateController := NewExchangeRateController(mockService)
+
+	exchangeRates := app.Group("/api/exchange-rates")
+	exchangeRates.Get("/", exchangeRateController.FindAll)
+	exchangeRates.Get("/:exchangeRateId", exchangeRateController.FindById)
+	exchangeRates.Post("/", exchangeRateController.Create)
+	exchangeRates.Delete("/:exchangeRateId", exchangeRateController.Delete)
+
+	return app
+}
+
+func TestExchangeRateController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockExchangeRateService(ctrl)
+	app := setupTestAppExchangeRate(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create - success",
+			method: "POST",
+			url:    "/api/exchange-rates",
+			body:   strings.NewReader(`{"currency":"USD","rate":15500}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), web.ExchangeRateCreateRequest{Currency: "USD", Rate: 15500}).
+					Return(web.ExchangeRateResponse{Id: 1, Currency: "USD", BaseCurrency: "IDR", Rate: 15500}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create - store currency",
+			method: "POST",
+			url:    "/api/exchange-rates",
+			body:   strings.NewReader(`{"currency":"IDR","rate":1}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(web.ExchangeRateResponse{}, exception.NewBadRequestError("IDR is the store currency, its rate is always 1"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Find all - success",
+			method: "GET",
+			url:    "/api/exchange-rates",
+			setupMock: func() {
+				mockService.EXPECT().FindAll(gomock.Any()).Return([]web.ExchangeRateResponse{{Id: 1, Currency: "USD"}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find by id - not found",
+			method: "GET",
+			url:    "/api/exchange-rates/9",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(9)).Return(web.ExchangeRateResponse{}, exception.NewNotFoundError("Exchange rate not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Delete - success",
+			method: "DELETE",
+			url:    "/api/exchange-rates/1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(1)).Return(nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "Deleted Successfully",
+		},
+		{
+			name:               "Delete - invalid id",
+			method:             "DELETE",
+			url:                "/api/exchange-rates/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Exchange Rate ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type GiftCardController interface {
+	Issue(c *fiber.Ctx) error
+	TopUp(c *fiber.Ctx) error
+	FindByCode(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindLedger(c *fiber.Ctx) error
+}
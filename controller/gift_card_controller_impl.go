@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GiftCardControllerImpl struct {
+	GiftCardService service.GiftCardService
+}
+
+func NewGiftCardController(giftCardService service.GiftCardService) GiftCardController {
+	return &GiftCardControllerImpl{
+		GiftCardService: giftCardService,
+	}
+}
+
+// Issue - Issue a gift card or store credit
+func (controller *GiftCardControllerImpl) Issue(c *fiber.Ctx) error {
+	issueRequest := new(web.GiftCardIssueRequest)
+	if err := c.BodyParser(issueRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	cardResponse, err := controller.GiftCardService.Issue(c.Context(), *issueRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   cardResponse,
+	})
+}
+
+// TopUp - Add money to a gift card
+func (controller *GiftCardControllerImpl) TopUp(c *fiber.Ctx) error {
+	topUpRequest := new(web.GiftCardTopUpRequest)
+	if err := c.BodyParser(topUpRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+	topUpRequest.Code = c.Params("code")
+
+	cardResponse, err := controller.GiftCardService.TopUp(c.Context(), *topUpRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   cardResponse,
+	})
+}
+
+// FindByCode - Check the balance of a gift card
+func (controller *GiftCardControllerImpl) FindByCode(c *fiber.Ctx) error {
+	cardResponse, err := controller.GiftCardService.FindByCode(c.Context(), c.Params("code"))
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   cardResponse,
+	})
+}
+
+// FindAll - Find all gift cards and store credit
+func (controller *GiftCardControllerImpl) FindAll(c *fiber.Ctx) error {
+	cardResponses, err := controller.GiftCardService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   cardResponses,
+	})
+}
+
+// FindLedger - Get the balance of a gift card with every transaction behind it
+func (controller *GiftCardControllerImpl) FindLedger(c *fiber.Ctx) error {
+	ledgerResponse, err := controller.GiftCardService.FindLedger(c.Context(), c.Params("code"))
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   ledgerResponse,
+	})
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppGiftCard(mockService *mocks.MockGiftCardService) *fiber.App {
+	app := fiber.New()
+	giftCardController := NewGiftCardController(mockService)
+
+	giftCards := app.Group("/api/gift-cards")
+	giftCards.Get("/", giftCardController.FindAll)
+	giftCards.Get("/:code", giftCardController.FindByCode)
+	giftCards.Post("/", giftCardController.Issue)
+	giftCards.Post("/:code/top-ups", giftCardController.TopUp)
+	giftCards.Get("/:code/transactions", giftCardController.FindLedger)
+
+	return app
+}
+
+func TestGiftCardController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockGiftCardService(ctrl)
+	app := setupTestAppGiftCard(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Issue - success",
+			method: "POST",
+			url:    "/api/gift-cards",
+			body:   strings.NewReader(`{"kind":"GiftCard","amount":250000}`),
+			setupMock: func() {
+				mockService.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(web.GiftCardResponse{Id: 1, Balance: money.New(250000)}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Top up - expired",
+			method: "POST",
+			url:    "/api/gift-cards/ABCD-EFGH-JKLM-NPQR/top-ups",
+			body:   strings.NewReader(`{"amount":50000}`),
+			setupMock: func() {
+				mockService.EXPECT().TopUp(gomock.Any(), web.GiftCardTopUpRequest{Code: "ABCD-EFGH-JKLM-NPQR", Amount: money.New(50000)}).
+					Return(web.GiftCardResponse{}, exception.NewConflictError("Gift card has expired"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:   "Find by code - not found",
+			method: "GET",
+			url:    "/api/gift-cards/ABCD-EFGH-JKLM-NPQR",
+			setupMock: func() {
+				mockService.EXPECT().FindByCode(gomock.Any(), "ABCD-EFGH-JKLM-NPQR").
+					Return(web.GiftCardResponse{}, exception.NewNotFoundError("Gift card not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Find ledger - success",
+			method: "GET",
+			url:    "/api/gift-cards/ABCD-EFGH-JKLM-NPQR/transactions",
+			setupMock: func() {
+				mockService.EXPECT().FindLedger(gomock.Any(), "ABCD-EFGH-JKLM-NPQR").Return(web.GiftCardLedgerResponse{Consistent: true}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
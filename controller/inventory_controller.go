@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type InventoryController interface {
+	Update(c *fiber.Ctx) error
+	Restock(c *fiber.Ctx) error
+	FindByProductId(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindLowStock(c *fiber.Ctx) error
+	Adjust(c *fiber.Ctx) error
+	FindMovements(c *fiber.Ctx) error
+	Audit(c *fiber.Ctx) error
+}
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type InventoryControllerImpl struct {
+	InventoryService service.InventoryService
+}
+
+func NewInventoryController(inventoryService service.InventoryService) InventoryController {
+	return &InventoryControllerImpl{
+		InventoryService: inventoryService,
+	}
+}
+
+// Update the restock level of a product
+func (controller *InventoryControllerImpl) Update(c *fiber.Ctx) error {
+	inventoryUpdateRequest := new(web.InventoryUpdateRequest)
+	if err := c.BodyParser(inventoryUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+	inventoryUpdateRequest.ProductID = id
+
+	inventoryResponse, err := controller.InventoryService.Update(c.Context(), *inventoryUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   inventoryResponse,
+	})
+}
+
+// Restock a product with a delivered quantity
+func (controller *InventoryControllerImpl) Restock(c *fiber.Ctx) error {
+	restockRequest := new(web.RestockRequest)
+	if err := c.BodyParser(restockRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+	restockRequest.ProductID = id
+
+	inventoryResponse, err := controller.InventoryService.Restock(c.Context(), *restockRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   inventoryResponse,
+	})
+}
+
+// Find Inventory By Product ID, ?store_id= gives the stock of one store
+func (controller *InventoryControllerImpl) FindByProductId(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	inventoryResponse, err := controller.InventoryService.FindByProductId(c.Context(), id, storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   inventoryResponse,
+	})
+}
+
+// Find All Inventory, ?store_id= gives the stock of one store
+func (controller *InventoryControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	inventoryResponses, err := controller.InventoryService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   inventoryResponses,
+	})
+}
+
+// FindLowStock - List the products that need reordering, ?store_id= at one store
+func (controller *InventoryControllerImpl) FindLowStock(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	inventoryResponses, err := controller.InventoryService.FindLowStock(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   inventoryResponses,
+	})
+}
+
+// Adjust the stock of a product by hand
+func (controller *InventoryControllerImpl) Adjust(c *fiber.Ctx) error {
+	adjustmentRequest := new(web.StockAdjustmentRequest)
+	if err := c.BodyParser(adjustmentRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+	adjustmentRequest.ProductID = id
+
+	movementResponse, err := controller.InventoryService.Adjust(c.Context(), *adjustmentRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   movementResponse,
+	})
+}
+
+// FindMovements - Get the stock movement history of a product, ?store_id= at one store
+func (controller *InventoryControllerImpl) FindMovements(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	movementResponses, err := controller.InventoryService.FindMovements(c.Context(), id, storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   movementResponses,
+	})
+}
+
+// Audit - Compare the stock of a product with the stock rebuilt from its movements
+func (controller *InventoryControllerImpl) Audit(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+
+	auditResponse, err := controller.InventoryService.Audit(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   auditResponse,
+	})
+}
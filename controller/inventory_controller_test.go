@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppInventory(mockService *mocks.MockInventoryService) *fiber.App {
+	app := fiber.New()
+	inventoryController := NewInventoryController(mockService)
+
+	api := app.Group("/api")
+	inventory := api.Group("/inventory")
+	inventory.Get("/", inventoryController.FindAll)
+	inventory.Get("/low-stock", inventoryController.FindLowStock)
+	inventory.Get("/:productId", inventoryController.FindByProductId)
+	inventory.Put("/:productId", inventoryController.Update)
+	inventory.Post("/:productId/restock", inventoryController.Restock)
+	inventory.Post("/:productId/adjustments", inventoryController.Adjust)
+	inventory.Get("/:productId/movements", inventoryController.FindMovements)
+	inventory.Get("/:productId/audit", inventoryController.Audit)
+
+	return app
+}
+
+func TestInventoryController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockInventoryService(ctrl)
+	app := setupTestAppInventory(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Low stock - success",
+			method: "GET",
+			url:    "/api/inventory/low-stock",
+			setupMock: func() {
+				mockService.EXPECT().FindLowStock(gomock.Any(), uint64(0)).Return([]web.InventoryResponse{{ProductID: 1, LowStock: true}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Low stock at store - success",
+			method: "GET",
+			url:    "/api/inventory/low-stock?store_id=2",
+			setupMock: func() {
+				mockService.EXPECT().FindLowStock(gomock.Any(), uint64(2)).Return([]web.InventoryResponse{{ProductID: 1, StoreID: 2, LowStock: true}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:               "Low stock at store - invalid store id",
+			method:             "GET",
+			url:                "/api/inventory/low-stock?store_id=-1",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Restock - success",
+			method: "POST",
+			url:    "/api/inventory/1/restock",
+			body:   strings.NewReader(`{"store_id":2,"quantity":24}`),
+			setupMock: func() {
+				mockService.EXPECT().Restock(gomock.Any(), web.RestockRequest{ProductID: 1, StoreID: 2, Quantity: 24}).
+					Return(web.InventoryResponse{ProductID: 1, StockQty: 124}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Restock - product not found",
+			method: "POST",
+			url:    "/api/inventory/9/restock",
+			body:   strings.NewReader(`{"quantity":24}`),
+			setupMock: func() {
+				mockService.EXPECT().Restock(gomock.Any(), gomock.Any()).Return(web.InventoryResponse{}, exception.NewNotFoundError("Product not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Update restock level - success",
+			method: "PUT",
+			url:    "/api/inventory/1",
+			body:   strings.NewReader(`{"restock_level":15}`),
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), web.InventoryUpdateRequest{ProductID: 1, RestockLevel: 15}).
+					Return(web.InventoryResponse{ProductID: 1, RestockLevel: 15}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Adjust - below zero",
+			method: "POST",
+			url:    "/api/inventory/1/adjustments",
+			body:   strings.NewReader(`{"store_id":1,"delta":-500,"reason":"Waste","employee_id":2}`),
+			setupMock: func() {
+				mockService.EXPECT().Adjust(gomock.Any(), web.StockAdjustmentRequest{ProductID: 1, StoreID: 1, Delta: -500, Reason: "Waste", EmployeeID: 2}).
+					Return(web.StockMovementResponse{}, exception.NewConflictError("Adjustment would take stock below zero"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:   "Adjust - success",
+			method: "POST",
+			url:    "/api/inventory/1/adjustments",
+			body:   strings.NewReader(`{"delta":-2,"reason":"Waste","employee_id":2}`),
+			setupMock: func() {
+				mockService.EXPECT().Adjust(gomock.Any(), gomock.Any()).Return(web.StockMovementResponse{Id: 7, Delta: -2}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Movements - success",
+			method: "GET",
+			url:    "/api/inventory/1/movements",
+			setupMock: func() {
+				mockService.EXPECT().FindMovements(gomock.Any(), uint64(1), uint64(0)).Return([]web.StockMovementResponse{{Id: 1, Delta: 100}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Audit - product not found",
+			method: "GET",
+			url:    "/api/inventory/9/audit",
+			setupMock: func() {
+				mockService.EXPECT().Audit(gomock.Any(), uint64(9)).Return(web.StockAuditResponse{}, exception.NewNotFoundError("Product not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:               "Find inventory - invalid id",
+			method:             "GET",
+			url:                "/api/inventory/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Product ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
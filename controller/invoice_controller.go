@@ -0,0 +1,10 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type InvoiceController interface {
+	ReceiptInvoice(c *fiber.Ctx) error
+	OrderInvoice(c *fiber.Ctx) error
+}
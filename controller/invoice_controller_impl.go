@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+const pdfMIME = "application/pdf"
+
+type InvoiceControllerImpl struct {
+	InvoiceService service.InvoiceService
+}
+
+func NewInvoiceController(invoiceService service.InvoiceService) InvoiceController {
+	return &InvoiceControllerImpl{
+		InvoiceService: invoiceService,
+	}
+}
+
+// Receipt Invoice PDF
+func (controller *InvoiceControllerImpl) ReceiptInvoice(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("receiptId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Receipt ID",
+			Data:   err.Error(),
+		})
+	}
+
+	output, err := controller.InvoiceService.ReceiptInvoice(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, pdfMIME)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="receipt-%d.pdf"`, id))
+	return c.Status(fiber.StatusOK).Send(output)
+}
+
+// Order Invoice PDF
+func (controller *InvoiceControllerImpl) OrderInvoice(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	output, err := controller.InvoiceService.OrderInvoice(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, pdfMIME)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="order-%d.pdf"`, id))
+	return c.Status(fiber.StatusOK).Send(output)
+}
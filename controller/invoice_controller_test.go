@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTestAppInvoice(mockInvoiceService *mocks.MockInvoiceService, mockReceiptService *mocks.MockReceiptService) *fiber.App {
+	app := fiber.New()
+	invoiceController := NewInvoiceController(mockInvoiceService)
+	receiptController := NewReceiptController(mockReceiptService)
+
+	api := app.Group("/api")
+	api.Get("/orders/:orderId/invoice.pdf", invoiceController.OrderInvoice)
+	api.Get("/receipts/:receiptId.pdf", invoiceController.ReceiptInvoice)
+	api.Get("/receipts/:receiptId", receiptController.FindById)
+
+	return app
+}
+
+func TestInvoiceController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvoiceService := mocks.NewMockInvoiceService(ctrl)
+	app := setupTestAppInvoice(mockInvoiceService, mocks.NewMockReceiptService(ctrl))
+
+	tests := []struct {
+		name                string
+		url                 string
+		setupMock           func()
+		expectedStatus      int
+		expectedContentType string
+	}{
+		{
+			name: "Receipt invoice - success",
+			url:  "/api/receipts/12.pdf",
+			setupMock: func() {
+				mockInvoiceService.EXPECT().ReceiptInvoice(gomock.Any(), uint64(12)).Return([]byte("%PDF-1.3"), nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: pdfMIME,
+		},
+		{
+			name: "Order invoice - not found",
+			url:  "/api/orders/3/invoice.pdf",
+			setupMock: func() {
+				mockInvoiceService.EXPECT().OrderInvoice(gomock.Any(), uint64(3)).Return(nil, exception.NewNotFoundError("Order not found"))
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: fiber.MIMEApplicationJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest("GET", tt.url, nil)
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedContentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type LoyaltyController interface {
+	CreateRule(c *fiber.Ctx) error
+	UpdateRule(c *fiber.Ctx) error
+	DeleteRule(c *fiber.Ctx) error
+	FindRuleById(c *fiber.Ctx) error
+	FindRules(c *fiber.Ctx) error
+	CreateTier(c *fiber.Ctx) error
+	UpdateTier(c *fiber.Ctx) error
+	DeleteTier(c *fiber.Ctx) error
+	FindTierById(c *fiber.Ctx) error
+	FindTiers(c *fiber.Ctx) error
+	ReviewTiers(c *fiber.Ctx) error
+	FindSettings(c *fiber.Ctx) error
+	UpdateSettings(c *fiber.Ctx) error
+	FindLedger(c *fiber.Ctx) error
+	Adjust(c *fiber.Ctx) error
+}
@@ -0,0 +1,353 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type LoyaltyControllerImpl struct {
+	LoyaltyService service.LoyaltyService
+}
+
+func NewLoyaltyController(loyaltyService service.LoyaltyService) LoyaltyController {
+	return &LoyaltyControllerImpl{
+		LoyaltyService: loyaltyService,
+	}
+}
+
+// CreateRule - Create loyalty earn rule
+func (controller *LoyaltyControllerImpl) CreateRule(c *fiber.Ctx) error {
+	ruleCreateRequest := new(web.LoyaltyRuleCreateRequest)
+	if err := c.BodyParser(ruleCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	ruleResponse, err := controller.LoyaltyService.CreateRule(c.Context(), *ruleCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   ruleResponse,
+	})
+}
+
+// UpdateRule - Update loyalty earn rule
+func (controller *LoyaltyControllerImpl) UpdateRule(c *fiber.Ctx) error {
+	ruleUpdateRequest := new(web.LoyaltyRuleUpdateRequest)
+	if err := c.BodyParser(ruleUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("ruleId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Rule ID",
+			Data:   err.Error(),
+		})
+	}
+	ruleUpdateRequest.Id = id
+
+	ruleResponse, err := controller.LoyaltyService.UpdateRule(c.Context(), *ruleUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   ruleResponse,
+	})
+}
+
+// DeleteRule - Delete loyalty earn rule
+func (controller *LoyaltyControllerImpl) DeleteRule(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("ruleId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Rule ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.LoyaltyService.DeleteRule(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// FindRuleById - Find loyalty earn rule By ID
+func (controller *LoyaltyControllerImpl) FindRuleById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("ruleId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Rule ID",
+			Data:   err.Error(),
+		})
+	}
+
+	ruleResponse, err := controller.LoyaltyService.FindRuleById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   ruleResponse,
+	})
+}
+
+// FindRules - Find all loyalty earn rules
+func (controller *LoyaltyControllerImpl) FindRules(c *fiber.Ctx) error {
+	ruleResponses, err := controller.LoyaltyService.FindRules(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   ruleResponses,
+	})
+}
+
+// CreateTier - Create loyalty tier
+func (controller *LoyaltyControllerImpl) CreateTier(c *fiber.Ctx) error {
+	tierCreateRequest := new(web.LoyaltyTierCreateRequest)
+	if err := c.BodyParser(tierCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	tierResponse, err := controller.LoyaltyService.CreateTier(c.Context(), *tierCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   tierResponse,
+	})
+}
+
+// UpdateTier - Update loyalty tier
+func (controller *LoyaltyControllerImpl) UpdateTier(c *fiber.Ctx) error {
+	tierUpdateRequest := new(web.LoyaltyTierUpdateRequest)
+	if err := c.BodyParser(tierUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("tierId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Tier ID",
+			Data:   err.Error(),
+		})
+	}
+	tierUpdateRequest.Id = id
+
+	tierResponse, err := controller.LoyaltyService.UpdateTier(c.Context(), *tierUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   tierResponse,
+	})
+}
+
+// DeleteTier - Delete loyalty tier
+func (controller *LoyaltyControllerImpl) DeleteTier(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("tierId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Tier ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.LoyaltyService.DeleteTier(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// FindTierById - Find loyalty tier By ID
+func (controller *LoyaltyControllerImpl) FindTierById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("tierId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Loyalty Tier ID",
+			Data:   err.Error(),
+		})
+	}
+
+	tierResponse, err := controller.LoyaltyService.FindTierById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   tierResponse,
+	})
+}
+
+// FindTiers - Find all loyalty tiers
+func (controller *LoyaltyControllerImpl) FindTiers(c *fiber.Ctx) error {
+	tierResponses, err := controller.LoyaltyService.FindTiers(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   tierResponses,
+	})
+}
+
+// ReviewTiers - Move every customer into the tier they reach now
+func (controller *LoyaltyControllerImpl) ReviewTiers(c *fiber.Ctx) error {
+	reviewResponse, err := controller.LoyaltyService.ReviewTiers(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   reviewResponse,
+	})
+}
+
+// FindSettings - Get the loyalty settings
+func (controller *LoyaltyControllerImpl) FindSettings(c *fiber.Ctx) error {
+	settingsResponse, err := controller.LoyaltyService.FindSettings(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
+
+// UpdateSettings - Set what a point is worth when redeemed and what tiers are measured on
+func (controller *LoyaltyControllerImpl) UpdateSettings(c *fiber.Ctx) error {
+	settingsUpdateRequest := new(web.LoyaltySettingsUpdateRequest)
+	if err := c.BodyParser(settingsUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	settingsResponse, err := controller.LoyaltyService.UpdateSettings(c.Context(), *settingsUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
+
+// FindLedger - Get the points of a customer with every transaction behind them
+func (controller *LoyaltyControllerImpl) FindLedger(c *fiber.Ctx) error {
+	customerId, err := strconv.ParseUint(c.Params("customerId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Customer ID",
+			Data:   err.Error(),
+		})
+	}
+
+	ledgerResponse, err := controller.LoyaltyService.FindLedger(c.Context(), customerId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   ledgerResponse,
+	})
+}
+
+// Adjust - Correct the points of a customer by hand
+func (controller *LoyaltyControllerImpl) Adjust(c *fiber.Ctx) error {
+	adjustmentRequest := new(web.PointsAdjustmentRequest)
+	if err := c.BodyParser(adjustmentRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	customerId, err := strconv.ParseUint(c.Params("customerId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Customer ID",
+			Data:   err.Error(),
+		})
+	}
+	adjustmentRequest.CustomerID = customerId
+
+	transactionResponse, err := controller.LoyaltyService.Adjust(c.Context(), *adjustmentRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   transactionResponse,
+	})
+}
@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppLoyalty(mockService *mocks.MockLoyaltyService) *fiber.App {
+	app := fiber.New()
+	loyaltyController := NewLoyaltyController(mockService)
+
+	api := app.Group("/api")
+	loyalty := api.Group("/loyalty")
+	loyalty.Get("/rules", loyaltyController.FindRules)
+	loyalty.Get("/rules/:ruleId", loyaltyController.FindRuleById)
+	loyalty.Post("/rules", loyaltyController.CreateRule)
+	loyalty.Put("/rules/:ruleId", loyaltyController.UpdateRule)
+	loyalty.Delete("/rules/:ruleId", loyaltyController.DeleteRule)
+	loyalty.Get("/tiers", loyaltyController.FindTiers)
+	loyalty.Get("/tiers/:tierId", loyaltyController.FindTierById)
+	loyalty.Post("/tiers", loyaltyController.CreateTier)
+	loyalty.Post("/tiers/review", loyaltyController.ReviewTiers)
+	loyalty.Put("/tiers/:tierId", loyaltyController.UpdateTier)
+	loyalty.Delete("/tiers/:tierId", loyaltyController.DeleteTier)
+	api.Get("/settings/loyalty", loyaltyController.FindSettings)
+	api.Put("/settings/loyalty", loyaltyController.UpdateSettings)
+	api.Get("/customers/:customerId/points", loyaltyController.FindLedger)
+	api.Post("/customers/:customerId/points/adjustments", loyaltyController.Adjust)
+
+	return app
+}
+
+func TestLoyaltyController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockLoyaltyService(ctrl)
+	app := setupTestAppLoyalty(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create rule - success",
+			method: "POST",
+			url:    "/api/loyalty/rules",
+			body:   strings.NewReader(`{"name":"Groceries","category_id":32,"spend_amount":1000,"points":1,"active":true}`),
+			setupMock: func() {
+				mockService.EXPECT().CreateRule(gomock.Any(), gomock.Any()).Return(web.LoyaltyRuleResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create rule - category already has one",
+			method: "POST",
+			url:    "/api/loyalty/rules",
+			body:   strings.NewReader(`{"name":"Groceries","category_id":32,"spend_amount":1000,"points":1}`),
+			setupMock: func() {
+				mockService.EXPECT().CreateRule(gomock.Any(), gomock.Any()).
+					Return(web.LoyaltyRuleResponse{}, exception.NewConflictError("There already is a rule for category 32"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:               "Update rule - invalid id",
+			method:             "PUT",
+			url:                "/api/loyalty/rules/abc",
+			body:               strings.NewReader(`{"name":"Groceries","spend_amount":1000,"points":1}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Loyalty Rule ID",
+		},
+		{
+			name:   "Delete rule - not found",
+			method: "DELETE",
+			url:    "/api/loyalty/rules/9",
+			setupMock: func() {
+				mockService.EXPECT().DeleteRule(gomock.Any(), uint64(9)).Return(exception.NewNotFoundError("Loyalty rule not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Create tier - success",
+			method: "POST",
+			url:    "/api/loyalty/tiers",
+			body:   strings.NewReader(`{"name":"Gold","spend_threshold":5000000,"points_threshold":500,"discount_pct":5,"points_multiplier":2}`),
+			setupMock: func() {
+				mockService.EXPECT().CreateTier(gomock.Any(), gomock.Any()).Return(web.LoyaltyTierResponse{Id: 1, Name: "Gold"}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:               "Update tier - invalid id",
+			method:             "PUT",
+			url:                "/api/loyalty/tiers/abc",
+			body:               strings.NewReader(`{"name":"Gold","spend_threshold":5000000,"points_multiplier":2}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Loyalty Tier ID",
+		},
+		{
+			name:   "Review tiers - success",
+			method: "POST",
+			url:    "/api/loyalty/tiers/review",
+			setupMock: func() {
+				mockService.EXPECT().ReviewTiers(gomock.Any()).Return(web.TierReviewResponse{Reviewed: 3, Promoted: 1}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Update settings - success",
+			method: "PUT",
+			url:    "/api/settings/loyalty",
+			body:   strings.NewReader(`{"point_value":100}`),
+			setupMock: func() {
+				mockService.EXPECT().UpdateSettings(gomock.Any(), gomock.Any()).Return(web.LoyaltySettingsResponse{PointValue: money.New(100)}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find ledger - success",
+			method: "GET",
+			url:    "/api/customers/1/points",
+			setupMock: func() {
+				mockService.EXPECT().FindLedger(gomock.Any(), uint64(1)).Return(web.LoyaltyLedgerResponse{CustomerID: 1, Consistent: true}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Adjust points - below zero",
+			method: "POST",
+			url:    "/api/customers/1/points/adjustments",
+			body:   strings.NewReader(`{"points":-500,"reference":"Goodwill correction"}`),
+			setupMock: func() {
+				mockService.EXPECT().Adjust(gomock.Any(), web.PointsAdjustmentRequest{CustomerID: 1, Points: -500, Reference: "Goodwill correction"}).
+					Return(web.LoyaltyTransactionResponse{}, exception.NewConflictError("Adjustment would take points below zero"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:               "Adjust points - invalid customer id",
+			method:             "POST",
+			url:                "/api/customers/abc/points/adjustments",
+			body:               strings.NewReader(`{"points":10,"reference":"Goodwill"}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Customer ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
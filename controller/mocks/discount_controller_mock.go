@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/discount_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDiscountController is a mock of DiscountController interface.
+type MockDiscountController struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiscountControllerMockRecorder
+}
+
+// MockDiscountControllerMockRecorder is the mock recorder for MockDiscountController.
+type MockDiscountControllerMockRecorder struct {
+	mock *MockDiscountController
+}
+
+// NewMockDiscountController creates a new mock instance.
+func NewMockDiscountController(ctrl *gomock.Controller) *MockDiscountController {
+	mock := &MockDiscountController{ctrl: ctrl}
+	mock.recorder = &MockDiscountControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiscountController) EXPECT() *MockDiscountControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDiscountController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDiscountControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDiscountController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockDiscountController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiscountControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDiscountController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockDiscountController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDiscountControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDiscountController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockDiscountController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockDiscountControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDiscountController)(nil).FindById), c)
+}
+
+// Update mocks base method.
+func (m *MockDiscountController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDiscountControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDiscountController)(nil).Update), c)
+}
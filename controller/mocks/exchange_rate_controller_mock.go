@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/exchange_rate_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeRateController is a mock of ExchangeRateController interface.
+type MockExchangeRateController struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateControllerMockRecorder
+}
+
+// MockExchangeRateControllerMockRecorder is the mock recorder for MockExchangeRateController.
+type MockExchangeRateControllerMockRecorder struct {
+	mock *MockExchangeRateController
+}
+
+// NewMockExchangeRateController creates a new mock instance.
+func NewMockExchangeRateController(ctrl *gomock.Controller) *MockExchangeRateController {
+	mock := &MockExchangeRateController{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateController) EXPECT() *MockExchangeRateControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExchangeRateController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExchangeRateControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExchangeRateController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRateController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRateControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRateController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockExchangeRateController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockExchangeRateControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockExchangeRateController)(nil).FindById), c)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/gift_card_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGiftCardController is a mock of GiftCardController interface.
+type MockGiftCardController struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardControllerMockRecorder
+}
+
+// MockGiftCardControllerMockRecorder is the mock recorder for MockGiftCardController.
+type MockGiftCardControllerMockRecorder struct {
+	mock *MockGiftCardController
+}
+
+// NewMockGiftCardController creates a new mock instance.
+func NewMockGiftCardController(ctrl *gomock.Controller) *MockGiftCardController {
+	mock := &MockGiftCardController{ctrl: ctrl}
+	mock.recorder = &MockGiftCardControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardController) EXPECT() *MockGiftCardControllerMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockGiftCardController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGiftCardControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGiftCardController)(nil).FindAll), c)
+}
+
+// FindByCode mocks base method.
+func (m *MockGiftCardController) FindByCode(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockGiftCardControllerMockRecorder) FindByCode(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockGiftCardController)(nil).FindByCode), c)
+}
+
+// FindLedger mocks base method.
+func (m *MockGiftCardController) FindLedger(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLedger", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindLedger indicates an expected call of FindLedger.
+func (mr *MockGiftCardControllerMockRecorder) FindLedger(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLedger", reflect.TypeOf((*MockGiftCardController)(nil).FindLedger), c)
+}
+
+// Issue mocks base method.
+func (m *MockGiftCardController) Issue(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockGiftCardControllerMockRecorder) Issue(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockGiftCardController)(nil).Issue), c)
+}
+
+// TopUp mocks base method.
+func (m *MockGiftCardController) TopUp(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUp", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TopUp indicates an expected call of TopUp.
+func (mr *MockGiftCardControllerMockRecorder) TopUp(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUp", reflect.TypeOf((*MockGiftCardController)(nil).TopUp), c)
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/inventory_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInventoryController is a mock of InventoryController interface.
+type MockInventoryController struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryControllerMockRecorder
+}
+
+// MockInventoryControllerMockRecorder is the mock recorder for MockInventoryController.
+type MockInventoryControllerMockRecorder struct {
+	mock *MockInventoryController
+}
+
+// NewMockInventoryController creates a new mock instance.
+func NewMockInventoryController(ctrl *gomock.Controller) *MockInventoryController {
+	mock := &MockInventoryController{ctrl: ctrl}
+	mock.recorder = &MockInventoryControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryController) EXPECT() *MockInventoryControllerMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockInventoryController) Adjust(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockInventoryControllerMockRecorder) Adjust(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockInventoryController)(nil).Adjust), c)
+}
+
+// Audit mocks base method.
+func (m *MockInventoryController) Audit(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockInventoryControllerMockRecorder) Audit(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockInventoryController)(nil).Audit), c)
+}
+
+// FindAll mocks base method.
+func (m *MockInventoryController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockInventoryControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInventoryController)(nil).FindAll), c)
+}
+
+// FindByProductId mocks base method.
+func (m *MockInventoryController) FindByProductId(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductId", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByProductId indicates an expected call of FindByProductId.
+func (mr *MockInventoryControllerMockRecorder) FindByProductId(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductId", reflect.TypeOf((*MockInventoryController)(nil).FindByProductId), c)
+}
+
+// FindLowStock mocks base method.
+func (m *MockInventoryController) FindLowStock(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLowStock", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindLowStock indicates an expected call of FindLowStock.
+func (mr *MockInventoryControllerMockRecorder) FindLowStock(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLowStock", reflect.TypeOf((*MockInventoryController)(nil).FindLowStock), c)
+}
+
+// FindMovements mocks base method.
+func (m *MockInventoryController) FindMovements(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovements", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMovements indicates an expected call of FindMovements.
+func (mr *MockInventoryControllerMockRecorder) FindMovements(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovements", reflect.TypeOf((*MockInventoryController)(nil).FindMovements), c)
+}
+
+// Restock mocks base method.
+func (m *MockInventoryController) Restock(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restock", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restock indicates an expected call of Restock.
+func (mr *MockInventoryControllerMockRecorder) Restock(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restock", reflect.TypeOf((*MockInventoryController)(nil).Restock), c)
+}
+
+// Update mocks base method.
+func (m *MockInventoryController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInventoryControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInventoryController)(nil).Update), c)
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/invoice_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceController is a mock of InvoiceController interface.
+type MockInvoiceController struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceControllerMockRecorder
+}
+
+// MockInvoiceControllerMockRecorder is the mock recorder for MockInvoiceController.
+type MockInvoiceControllerMockRecorder struct {
+	mock *MockInvoiceController
+}
+
+// NewMockInvoiceController creates a new mock instance.
+func NewMockInvoiceController(ctrl *gomock.Controller) *MockInvoiceController {
+	mock := &MockInvoiceController{ctrl: ctrl}
+	mock.recorder = &MockInvoiceControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceController) EXPECT() *MockInvoiceControllerMockRecorder {
+	return m.recorder
+}
+
+// OrderInvoice mocks base method.
+func (m *MockInvoiceController) OrderInvoice(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderInvoice", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderInvoice indicates an expected call of OrderInvoice.
+func (mr *MockInvoiceControllerMockRecorder) OrderInvoice(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderInvoice", reflect.TypeOf((*MockInvoiceController)(nil).OrderInvoice), c)
+}
+
+// ReceiptInvoice mocks base method.
+func (m *MockInvoiceController) ReceiptInvoice(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiptInvoice", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReceiptInvoice indicates an expected call of ReceiptInvoice.
+func (mr *MockInvoiceControllerMockRecorder) ReceiptInvoice(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiptInvoice", reflect.TypeOf((*MockInvoiceController)(nil).ReceiptInvoice), c)
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/loyalty_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoyaltyController is a mock of LoyaltyController interface.
+type MockLoyaltyController struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyControllerMockRecorder
+}
+
+// MockLoyaltyControllerMockRecorder is the mock recorder for MockLoyaltyController.
+type MockLoyaltyControllerMockRecorder struct {
+	mock *MockLoyaltyController
+}
+
+// NewMockLoyaltyController creates a new mock instance.
+func NewMockLoyaltyController(ctrl *gomock.Controller) *MockLoyaltyController {
+	mock := &MockLoyaltyController{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyController) EXPECT() *MockLoyaltyControllerMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockLoyaltyController) Adjust(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockLoyaltyControllerMockRecorder) Adjust(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockLoyaltyController)(nil).Adjust), c)
+}
+
+// CreateRule mocks base method.
+func (m *MockLoyaltyController) CreateRule(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockLoyaltyControllerMockRecorder) CreateRule(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockLoyaltyController)(nil).CreateRule), c)
+}
+
+// CreateTier mocks base method.
+func (m *MockLoyaltyController) CreateTier(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTier", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTier indicates an expected call of CreateTier.
+func (mr *MockLoyaltyControllerMockRecorder) CreateTier(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTier", reflect.TypeOf((*MockLoyaltyController)(nil).CreateTier), c)
+}
+
+// DeleteRule mocks base method.
+func (m *MockLoyaltyController) DeleteRule(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockLoyaltyControllerMockRecorder) DeleteRule(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockLoyaltyController)(nil).DeleteRule), c)
+}
+
+// DeleteTier mocks base method.
+func (m *MockLoyaltyController) DeleteTier(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTier", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTier indicates an expected call of DeleteTier.
+func (mr *MockLoyaltyControllerMockRecorder) DeleteTier(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTier", reflect.TypeOf((*MockLoyaltyController)(nil).DeleteTier), c)
+}
+
+// FindLedger mocks base method.
+func (m *MockLoyaltyController) FindLedger(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLedger", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindLedger indicates an expected call of FindLedger.
+func (mr *MockLoyaltyControllerMockRecorder) FindLedger(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLedger", reflect.TypeOf((*MockLoyaltyController)(nil).FindLedger), c)
+}
+
+// FindRuleById mocks base method.
+func (m *MockLoyaltyController) FindRuleById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuleById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindRuleById indicates an expected call of FindRuleById.
+func (mr *MockLoyaltyControllerMockRecorder) FindRuleById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuleById", reflect.TypeOf((*MockLoyaltyController)(nil).FindRuleById), c)
+}
+
+// FindRules mocks base method.
+func (m *MockLoyaltyController) FindRules(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRules", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindRules indicates an expected call of FindRules.
+func (mr *MockLoyaltyControllerMockRecorder) FindRules(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRules", reflect.TypeOf((*MockLoyaltyController)(nil).FindRules), c)
+}
+
+// FindSettings mocks base method.
+func (m *MockLoyaltyController) FindSettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindSettings indicates an expected call of FindSettings.
+func (mr *MockLoyaltyControllerMockRecorder) FindSettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettings", reflect.TypeOf((*MockLoyaltyController)(nil).FindSettings), c)
+}
+
+// FindTierById mocks base method.
+func (m *MockLoyaltyController) FindTierById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTierById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindTierById indicates an expected call of FindTierById.
+func (mr *MockLoyaltyControllerMockRecorder) FindTierById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTierById", reflect.TypeOf((*MockLoyaltyController)(nil).FindTierById), c)
+}
+
+// FindTiers mocks base method.
+func (m *MockLoyaltyController) FindTiers(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTiers", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindTiers indicates an expected call of FindTiers.
+func (mr *MockLoyaltyControllerMockRecorder) FindTiers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTiers", reflect.TypeOf((*MockLoyaltyController)(nil).FindTiers), c)
+}
+
+// ReviewTiers mocks base method.
+func (m *MockLoyaltyController) ReviewTiers(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewTiers", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewTiers indicates an expected call of ReviewTiers.
+func (mr *MockLoyaltyControllerMockRecorder) ReviewTiers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTiers", reflect.TypeOf((*MockLoyaltyController)(nil).ReviewTiers), c)
+}
+
+// UpdateRule mocks base method.
+func (m *MockLoyaltyController) UpdateRule(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockLoyaltyControllerMockRecorder) UpdateRule(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockLoyaltyController)(nil).UpdateRule), c)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockLoyaltyController) UpdateSettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockLoyaltyControllerMockRecorder) UpdateSettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockLoyaltyController)(nil).UpdateSettings), c)
+}
+
+// UpdateTier mocks base method.
+func (m *MockLoyaltyController) UpdateTier(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTier", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTier indicates an expected call of UpdateTier.
+func (mr *MockLoyaltyControllerMockRecorder) UpdateTier(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTier", reflect.TypeOf((*MockLoyaltyController)(nil).UpdateTier), c)
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/order_return_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderReturnController is a mock of OrderReturnController interface.
+type MockOrderReturnController struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderReturnControllerMockRecorder
+}
+
+// MockOrderReturnControllerMockRecorder is the mock recorder for MockOrderReturnController.
+type MockOrderReturnControllerMockRecorder struct {
+	mock *MockOrderReturnController
+}
+
+// NewMockOrderReturnController creates a new mock instance.
+func NewMockOrderReturnController(ctrl *gomock.Controller) *MockOrderReturnController {
+	mock := &MockOrderReturnController{ctrl: ctrl}
+	mock.recorder = &MockOrderReturnControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderReturnController) EXPECT() *MockOrderReturnControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrderReturnController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrderReturnControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderReturnController)(nil).Create), c)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockOrderReturnController) FindByOrderId(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockOrderReturnControllerMockRecorder) FindByOrderId(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockOrderReturnController)(nil).FindByOrderId), c)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/payment_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentController is a mock of PaymentController interface.
+type MockPaymentController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentControllerMockRecorder
+}
+
+// MockPaymentControllerMockRecorder is the mock recorder for MockPaymentController.
+type MockPaymentControllerMockRecorder struct {
+	mock *MockPaymentController
+}
+
+// NewMockPaymentController creates a new mock instance.
+func NewMockPaymentController(ctrl *gomock.Controller) *MockPaymentController {
+	mock := &MockPaymentController{ctrl: ctrl}
+	mock.recorder = &MockPaymentControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentController) EXPECT() *MockPaymentControllerMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockPaymentController) Complete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockPaymentControllerMockRecorder) Complete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockPaymentController)(nil).Complete), c)
+}
+
+// Create mocks base method.
+func (m *MockPaymentController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentController)(nil).Create), c)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockPaymentController) FindByOrderId(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockPaymentControllerMockRecorder) FindByOrderId(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockPaymentController)(nil).FindByOrderId), c)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentController) Refund(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentControllerMockRecorder) Refund(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentController)(nil).Refund), c)
+}
+
+// Void mocks base method.
+func (m *MockPaymentController) Void(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentControllerMockRecorder) Void(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentController)(nil).Void), c)
+}
@@ -103,3 +103,17 @@ func (mr *MockProductControllerMockRecorder) Update(c interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductController)(nil).Update), c)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockProductController) UpdateVariant(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductControllerMockRecorder) UpdateVariant(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductController)(nil).UpdateVariant), c)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/promotion_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromotionController is a mock of PromotionController interface.
+type MockPromotionController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionControllerMockRecorder
+}
+
+// MockPromotionControllerMockRecorder is the mock recorder for MockPromotionController.
+type MockPromotionControllerMockRecorder struct {
+	mock *MockPromotionController
+}
+
+// NewMockPromotionController creates a new mock instance.
+func NewMockPromotionController(ctrl *gomock.Controller) *MockPromotionController {
+	mock := &MockPromotionController{ctrl: ctrl}
+	mock.recorder = &MockPromotionControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionController) EXPECT() *MockPromotionControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPromotionController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockPromotionController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromotionControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromotionController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockPromotionController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPromotionControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPromotionController)(nil).FindById), c)
+}
+
+// Update mocks base method.
+func (m *MockPromotionController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionController)(nil).Update), c)
+}
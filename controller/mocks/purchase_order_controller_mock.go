@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/purchase_order_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchaseOrderController is a mock of PurchaseOrderController interface.
+type MockPurchaseOrderController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseOrderControllerMockRecorder
+}
+
+// MockPurchaseOrderControllerMockRecorder is the mock recorder for MockPurchaseOrderController.
+type MockPurchaseOrderControllerMockRecorder struct {
+	mock *MockPurchaseOrderController
+}
+
+// NewMockPurchaseOrderController creates a new mock instance.
+func NewMockPurchaseOrderController(ctrl *gomock.Controller) *MockPurchaseOrderController {
+	mock := &MockPurchaseOrderController{ctrl: ctrl}
+	mock.recorder = &MockPurchaseOrderControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseOrderController) EXPECT() *MockPurchaseOrderControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPurchaseOrderController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPurchaseOrderControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPurchaseOrderController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockPurchaseOrderController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPurchaseOrderControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPurchaseOrderController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockPurchaseOrderController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPurchaseOrderControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPurchaseOrderController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockPurchaseOrderController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPurchaseOrderControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPurchaseOrderController)(nil).FindById), c)
+}
+
+// Receive mocks base method.
+func (m *MockPurchaseOrderController) Receive(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockPurchaseOrderControllerMockRecorder) Receive(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockPurchaseOrderController)(nil).Receive), c)
+}
+
+// Send mocks base method.
+func (m *MockPurchaseOrderController) Send(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPurchaseOrderControllerMockRecorder) Send(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPurchaseOrderController)(nil).Send), c)
+}
+
+// Update mocks base method.
+func (m *MockPurchaseOrderController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPurchaseOrderControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPurchaseOrderController)(nil).Update), c)
+}
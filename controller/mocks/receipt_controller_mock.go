@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/receipt_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptController is a mock of ReceiptController interface.
+type MockReceiptController struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptControllerMockRecorder
+}
+
+// MockReceiptControllerMockRecorder is the mock recorder for MockReceiptController.
+type MockReceiptControllerMockRecorder struct {
+	mock *MockReceiptController
+}
+
+// NewMockReceiptController creates a new mock instance.
+func NewMockReceiptController(ctrl *gomock.Controller) *MockReceiptController {
+	mock := &MockReceiptController{ctrl: ctrl}
+	mock.recorder = &MockReceiptControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptController) EXPECT() *MockReceiptControllerMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockReceiptController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReceiptControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReceiptController)(nil).FindById), c)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockReceiptController) FindByOrderId(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockReceiptControllerMockRecorder) FindByOrderId(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockReceiptController)(nil).FindByOrderId), c)
+}
+
+// Print mocks base method.
+func (m *MockReceiptController) Print(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Print indicates an expected call of Print.
+func (mr *MockReceiptControllerMockRecorder) Print(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockReceiptController)(nil).Print), c)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/report_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportController is a mock of ReportController interface.
+type MockReportController struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportControllerMockRecorder
+}
+
+// MockReportControllerMockRecorder is the mock recorder for MockReportController.
+type MockReportControllerMockRecorder struct {
+	mock *MockReportController
+}
+
+// NewMockReportController creates a new mock instance.
+func NewMockReportController(ctrl *gomock.Controller) *MockReportController {
+	mock := &MockReportController{ctrl: ctrl}
+	mock.recorder = &MockReportControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportController) EXPECT() *MockReportControllerMockRecorder {
+	return m.recorder
+}
+
+// SalesReport mocks base method.
+func (m *MockReportController) SalesReport(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesReport", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SalesReport indicates an expected call of SalesReport.
+func (mr *MockReportControllerMockRecorder) SalesReport(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesReport", reflect.TypeOf((*MockReportController)(nil).SalesReport), c)
+}
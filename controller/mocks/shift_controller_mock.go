@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/shift_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShiftController is a mock of ShiftController interface.
+type MockShiftController struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftControllerMockRecorder
+}
+
+// MockShiftControllerMockRecorder is the mock recorder for MockShiftController.
+type MockShiftControllerMockRecorder struct {
+	mock *MockShiftController
+}
+
+// NewMockShiftController creates a new mock instance.
+func NewMockShiftController(ctrl *gomock.Controller) *MockShiftController {
+	mock := &MockShiftController{ctrl: ctrl}
+	mock.recorder = &MockShiftControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftController) EXPECT() *MockShiftControllerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShiftController) Close(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShiftControllerMockRecorder) Close(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShiftController)(nil).Close), c)
+}
+
+// FindAll mocks base method.
+func (m *MockShiftController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockShiftControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockShiftController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockShiftController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockShiftControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockShiftController)(nil).FindById), c)
+}
+
+// FindReport mocks base method.
+func (m *MockShiftController) FindReport(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReport", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindReport indicates an expected call of FindReport.
+func (mr *MockShiftControllerMockRecorder) FindReport(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReport", reflect.TypeOf((*MockShiftController)(nil).FindReport), c)
+}
+
+// Open mocks base method.
+func (m *MockShiftController) Open(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockShiftControllerMockRecorder) Open(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockShiftController)(nil).Open), c)
+}
+
+// RecordCashMovement mocks base method.
+func (m *MockShiftController) RecordCashMovement(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCashMovement", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCashMovement indicates an expected call of RecordCashMovement.
+func (mr *MockShiftControllerMockRecorder) RecordCashMovement(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCashMovement", reflect.TypeOf((*MockShiftController)(nil).RecordCashMovement), c)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/stock_transfer_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStockTransferController is a mock of StockTransferController interface.
+type MockStockTransferController struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockTransferControllerMockRecorder
+}
+
+// MockStockTransferControllerMockRecorder is the mock recorder for MockStockTransferController.
+type MockStockTransferControllerMockRecorder struct {
+	mock *MockStockTransferController
+}
+
+// NewMockStockTransferController creates a new mock instance.
+func NewMockStockTransferController(ctrl *gomock.Controller) *MockStockTransferController {
+	mock := &MockStockTransferController{ctrl: ctrl}
+	mock.recorder = &MockStockTransferControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockTransferController) EXPECT() *MockStockTransferControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStockTransferController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStockTransferControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStockTransferController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockStockTransferController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStockTransferControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStockTransferController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockStockTransferController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStockTransferControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStockTransferController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockStockTransferController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStockTransferControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStockTransferController)(nil).FindById), c)
+}
+
+// Receive mocks base method.
+func (m *MockStockTransferController) Receive(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockStockTransferControllerMockRecorder) Receive(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockStockTransferController)(nil).Receive), c)
+}
+
+// Ship mocks base method.
+func (m *MockStockTransferController) Ship(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ship", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ship indicates an expected call of Ship.
+func (mr *MockStockTransferControllerMockRecorder) Ship(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ship", reflect.TypeOf((*MockStockTransferController)(nil).Ship), c)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/stocktake_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStocktakeController is a mock of StocktakeController interface.
+type MockStocktakeController struct {
+	ctrl     *gomock.Controller
+	recorder *MockStocktakeControllerMockRecorder
+}
+
+// MockStocktakeControllerMockRecorder is the mock recorder for MockStocktakeController.
+type MockStocktakeControllerMockRecorder struct {
+	mock *MockStocktakeController
+}
+
+// NewMockStocktakeController creates a new mock instance.
+func NewMockStocktakeController(ctrl *gomock.Controller) *MockStocktakeController {
+	mock := &MockStocktakeController{ctrl: ctrl}
+	mock.recorder = &MockStocktakeControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStocktakeController) EXPECT() *MockStocktakeControllerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockStocktakeController) Cancel(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStocktakeControllerMockRecorder) Cancel(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStocktakeController)(nil).Cancel), c)
+}
+
+// Commit mocks base method.
+func (m *MockStocktakeController) Commit(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockStocktakeControllerMockRecorder) Commit(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStocktakeController)(nil).Commit), c)
+}
+
+// Create mocks base method.
+func (m *MockStocktakeController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStocktakeControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStocktakeController)(nil).Create), c)
+}
+
+// FindAll mocks base method.
+func (m *MockStocktakeController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStocktakeControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStocktakeController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockStocktakeController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStocktakeControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStocktakeController)(nil).FindById), c)
+}
+
+// FindVariances mocks base method.
+func (m *MockStocktakeController) FindVariances(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVariances", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindVariances indicates an expected call of FindVariances.
+func (mr *MockStocktakeControllerMockRecorder) FindVariances(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVariances", reflect.TypeOf((*MockStocktakeController)(nil).FindVariances), c)
+}
+
+// RecordCounts mocks base method.
+func (m *MockStocktakeController) RecordCounts(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCounts", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCounts indicates an expected call of RecordCounts.
+func (mr *MockStocktakeControllerMockRecorder) RecordCounts(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCounts", reflect.TypeOf((*MockStocktakeController)(nil).RecordCounts), c)
+}
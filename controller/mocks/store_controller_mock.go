@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/store_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStoreController is a mock of StoreController interface.
+type MockStoreController struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreControllerMockRecorder
+}
+
+// MockStoreControllerMockRecorder is the mock recorder for MockStoreController.
+type MockStoreControllerMockRecorder struct {
+	mock *MockStoreController
+}
+
+// NewMockStoreController creates a new mock instance.
+func NewMockStoreController(ctrl *gomock.Controller) *MockStoreController {
+	mock := &MockStoreController{ctrl: ctrl}
+	mock.recorder = &MockStoreControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreController) EXPECT() *MockStoreControllerMockRecorder {
+	return m.recorder
+}
+
+// ClearPrice mocks base method.
+func (m *MockStoreController) ClearPrice(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPrice", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPrice indicates an expected call of ClearPrice.
+func (mr *MockStoreControllerMockRecorder) ClearPrice(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPrice", reflect.TypeOf((*MockStoreController)(nil).ClearPrice), c)
+}
+
+// Create mocks base method.
+func (m *MockStoreController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStoreControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStoreController)(nil).Create), c)
+}
+
+// FindAll mocks base method.
+func (m *MockStoreController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStoreControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStoreController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockStoreController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStoreControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStoreController)(nil).FindById), c)
+}
+
+// SetPrice mocks base method.
+func (m *MockStoreController) SetPrice(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrice", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrice indicates an expected call of SetPrice.
+func (mr *MockStoreControllerMockRecorder) SetPrice(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrice", reflect.TypeOf((*MockStoreController)(nil).SetPrice), c)
+}
+
+// Update mocks base method.
+func (m *MockStoreController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStoreControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStoreController)(nil).Update), c)
+}
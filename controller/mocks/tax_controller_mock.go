@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller/tax_controller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	fiber "github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxController is a mock of TaxController interface.
+type MockTaxController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxControllerMockRecorder
+}
+
+// MockTaxControllerMockRecorder is the mock recorder for MockTaxController.
+type MockTaxControllerMockRecorder struct {
+	mock *MockTaxController
+}
+
+// NewMockTaxController creates a new mock instance.
+func NewMockTaxController(ctrl *gomock.Controller) *MockTaxController {
+	mock := &MockTaxController{ctrl: ctrl}
+	mock.recorder = &MockTaxControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxController) EXPECT() *MockTaxControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaxController) Create(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaxControllerMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaxController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockTaxController) Delete(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxControllerMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxController)(nil).Delete), c)
+}
+
+// FindAll mocks base method.
+func (m *MockTaxController) FindAll(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTaxControllerMockRecorder) FindAll(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTaxController)(nil).FindAll), c)
+}
+
+// FindById mocks base method.
+func (m *MockTaxController) FindById(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTaxControllerMockRecorder) FindById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTaxController)(nil).FindById), c)
+}
+
+// FindMoneySettings mocks base method.
+func (m *MockTaxController) FindMoneySettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoneySettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMoneySettings indicates an expected call of FindMoneySettings.
+func (mr *MockTaxControllerMockRecorder) FindMoneySettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoneySettings", reflect.TypeOf((*MockTaxController)(nil).FindMoneySettings), c)
+}
+
+// FindSettings mocks base method.
+func (m *MockTaxController) FindSettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindSettings indicates an expected call of FindSettings.
+func (mr *MockTaxControllerMockRecorder) FindSettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettings", reflect.TypeOf((*MockTaxController)(nil).FindSettings), c)
+}
+
+// Update mocks base method.
+func (m *MockTaxController) Update(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxControllerMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxController)(nil).Update), c)
+}
+
+// UpdateMoneySettings mocks base method.
+func (m *MockTaxController) UpdateMoneySettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMoneySettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMoneySettings indicates an expected call of UpdateMoneySettings.
+func (mr *MockTaxControllerMockRecorder) UpdateMoneySettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMoneySettings", reflect.TypeOf((*MockTaxController)(nil).UpdateMoneySettings), c)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockTaxController) UpdateSettings(c *fiber.Ctx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockTaxControllerMockRecorder) UpdateSettings(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockTaxController)(nil).UpdateSettings), c)
+}
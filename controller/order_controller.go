@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrderController interface {
+	Create(c *fiber.Ctx) error
+	Checkout(c *fiber.Ctx) error
+	Cancel(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type OrderControllerImpl struct {
+	OrderService service.OrderService
+}
+
+func NewOrderController(orderService service.OrderService) OrderController {
+	return &OrderControllerImpl{
+		OrderService: orderService,
+	}
+}
+
+// Create Order
+func (controller *OrderControllerImpl) Create(c *fiber.Ctx) error {
+	orderCreateRequest := new(web.OrderCreateRequest)
+	if err := c.BodyParser(orderCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	orderResponse, err := controller.OrderService.Create(c.Context(), *orderCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   orderResponse,
+	})
+}
+
+// Checkout Order
+func (controller *OrderControllerImpl) Checkout(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	orderResponse, err := controller.OrderService.Checkout(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   orderResponse,
+	})
+}
+
+// Cancel Order
+func (controller *OrderControllerImpl) Cancel(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	orderResponse, err := controller.OrderService.Cancel(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   orderResponse,
+	})
+}
+
+// Find Order By ID
+func (controller *OrderControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	orderResponse, err := controller.OrderService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   orderResponse,
+	})
+}
+
+// Find All Orders, ?store_id= narrows them to one store
+func (controller *OrderControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	orderResponses, err := controller.OrderService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   orderResponses,
+	})
+}
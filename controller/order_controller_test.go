@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTestAppOrder(mockService *mocks.MockOrderService) *fiber.App {
+	app := fiber.New()
+	orderController := NewOrderController(mockService)
+
+	api := app.Group("/api")
+	orders := api.Group("/orders")
+	orders.Get("/", orderController.FindAll)
+	orders.Get("/:orderId", orderController.FindById)
+	orders.Post("/", orderController.Create)
+	orders.Post("/:orderId/checkout", orderController.Checkout)
+	orders.Post("/:orderId/cancel", orderController.Cancel)
+
+	return app
+}
+
+func TestOrderController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOrderService(ctrl)
+	app := setupTestAppOrder(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Find order - success",
+			method: "GET",
+			url:    "/api/orders/1",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(1)).Return(web.OrderResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find order - not found",
+			method: "GET",
+			url:    "/api/orders/2",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(2)).Return(web.OrderResponse{}, exception.NewNotFoundError("Order not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Checkout order - insufficient stock",
+			method: "POST",
+			url:    "/api/orders/1/checkout",
+			setupMock: func() {
+				mockService.EXPECT().Checkout(gomock.Any(), uint64(1)).Return(web.OrderResponse{}, exception.NewInsufficientStockError(
+					[]web.StockShortageResponse{{ProductID: 1, Requested: 2, Available: 1}}))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Insufficient stock",
+		},
+		{
+			name:   "Cancel order - already cancelled",
+			method: "POST",
+			url:    "/api/orders/1/cancel",
+			setupMock: func() {
+				mockService.EXPECT().Cancel(gomock.Any(), uint64(1)).Return(web.OrderResponse{}, exception.NewConflictError("Order is already cancelled"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, nil)
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
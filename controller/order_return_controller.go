@@ -0,0 +1,10 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrderReturnController interface {
+	Create(c *fiber.Ctx) error
+	FindByOrderId(c *fiber.Ctx) error
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type OrderReturnControllerImpl struct {
+	OrderReturnService service.OrderReturnService
+}
+
+func NewOrderReturnController(orderReturnService service.OrderReturnService) OrderReturnController {
+	return &OrderReturnControllerImpl{
+		OrderReturnService: orderReturnService,
+	}
+}
+
+// Create Return against an order, refunding the returned lines
+func (controller *OrderReturnControllerImpl) Create(c *fiber.Ctx) error {
+	orderReturnCreateRequest := new(web.OrderReturnCreateRequest)
+	if err := c.BodyParser(orderReturnCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+	orderReturnCreateRequest.OrderID = orderId
+
+	orderReturnResponse, err := controller.OrderReturnService.Create(c.Context(), *orderReturnCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   orderReturnResponse,
+	})
+}
+
+// Find Returns By Order ID
+func (controller *OrderReturnControllerImpl) FindByOrderId(c *fiber.Ctx) error {
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	orderReturnResponses, err := controller.OrderReturnService.FindByOrderId(c.Context(), orderId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   orderReturnResponses,
+	})
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppOrderReturn(mockService *mocks.MockOrderReturnService) *fiber.App {
+	app := fiber.New()
+	orderReturnController := NewOrderReturnController(mockService)
+
+	api := app.Group("/api")
+	orders := api.Group("/orders")
+	orders.Get("/:orderId/returns", orderReturnController.FindByOrderId)
+	orders.Post("/:orderId/returns", orderReturnController.Create)
+
+	return app
+}
+
+func TestOrderReturnController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOrderReturnService(ctrl)
+	app := setupTestAppOrderReturn(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create return - success",
+			method: "POST",
+			url:    "/api/orders/7/returns",
+			body:   strings.NewReader(`{"lines":[{"order_item_id":11,"quantity":1}],"payment_type":"Cash","restock":true}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, request web.OrderReturnCreateRequest) (web.OrderReturnResponse, error) {
+						assert.Equal(t, uint64(7), request.OrderID)
+						assert.True(t, request.Restock)
+						return web.OrderReturnResponse{Id: 3, OrderID: 7}, nil
+					})
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create return - more than sold",
+			method: "POST",
+			url:    "/api/orders/7/returns",
+			body:   strings.NewReader(`{"lines":[{"order_item_id":11,"quantity":5}],"payment_type":"Cash"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(web.OrderReturnResponse{}, exception.NewBadRequestError("Only 3 unit(s) of order item 11 can still be returned"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Find returns - order not found",
+			method: "GET",
+			url:    "/api/orders/99/returns",
+			setupMock: func() {
+				mockService.EXPECT().FindByOrderId(gomock.Any(), uint64(99)).Return(nil, exception.NewNotFoundError("Order not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:               "Create return - invalid order id",
+			method:             "POST",
+			url:                "/api/orders/abc/returns",
+			body:               strings.NewReader(`{}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Order ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
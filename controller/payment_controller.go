@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type PaymentController interface {
+	Create(c *fiber.Ctx) error
+	Complete(c *fiber.Ctx) error
+	Refund(c *fiber.Ctx) error
+	Void(c *fiber.Ctx) error
+	FindByOrderId(c *fiber.Ctx) error
+}
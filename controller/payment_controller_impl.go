@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type PaymentControllerImpl struct {
+	PaymentService service.PaymentService
+}
+
+func NewPaymentController(paymentService service.PaymentService) PaymentController {
+	return &PaymentControllerImpl{
+		PaymentService: paymentService,
+	}
+}
+
+// Create Payment
+func (controller *PaymentControllerImpl) Create(c *fiber.Ctx) error {
+	paymentCreateRequest := new(web.PaymentCreateRequest)
+	if err := c.BodyParser(paymentCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+	paymentCreateRequest.OrderID = orderId
+
+	paymentResponse, err := controller.PaymentService.Create(c.Context(), *paymentCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   paymentResponse,
+	})
+}
+
+// Complete Payment
+func (controller *PaymentControllerImpl) Complete(c *fiber.Ctx) error {
+	return controller.transition(c, controller.PaymentService.Complete)
+}
+
+// Refund Payment
+func (controller *PaymentControllerImpl) Refund(c *fiber.Ctx) error {
+	return controller.transition(c, controller.PaymentService.Refund)
+}
+
+// Void Payment
+func (controller *PaymentControllerImpl) Void(c *fiber.Ctx) error {
+	return controller.transition(c, controller.PaymentService.Void)
+}
+
+// Find Payments By Order ID
+func (controller *PaymentControllerImpl) FindByOrderId(c *fiber.Ctx) error {
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	paymentResponses, err := controller.PaymentService.FindByOrderId(c.Context(), orderId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   paymentResponses,
+	})
+}
+
+func (controller *PaymentControllerImpl) transition(c *fiber.Ctx,
+	action func(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error)) error {
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	paymentId, err := strconv.ParseUint(c.Params("paymentId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Payment ID",
+			Data:   err.Error(),
+		})
+	}
+
+	paymentResponse, err := action(c.Context(), orderId, paymentId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   paymentResponse,
+	})
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTestAppPayment(mockService *mocks.MockPaymentService) *fiber.App {
+	app := fiber.New()
+	paymentController := NewPaymentController(mockService)
+
+	api := app.Group("/api")
+	orders := api.Group("/orders")
+	orders.Get("/:orderId/payments", paymentController.FindByOrderId)
+	orders.Post("/:orderId/payments", paymentController.Create)
+	orders.Post("/:orderId/payments/:paymentId/complete", paymentController.Complete)
+	orders.Post("/:orderId/payments/:paymentId/refund", paymentController.Refund)
+	orders.Post("/:orderId/payments/:paymentId/void", paymentController.Void)
+
+	return app
+}
+
+func TestPaymentController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPaymentService(ctrl)
+	app := setupTestAppPayment(mockService)
+
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		body           interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:   "Create payment - order id from path",
+			method: "POST",
+			url:    "/api/orders/3/payments",
+			body:   web.PaymentCreateRequest{Amount: money.New(1000), PaymentType: "Cash"},
+			setupMock: func() {
+				mockService.EXPECT().
+					Create(gomock.Any(), web.PaymentCreateRequest{OrderID: 3, Amount: money.New(1000), PaymentType: "Cash"}).
+					Return(web.PaymentResponse{Id: 1, OrderID: 3}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:   "Refund payment - invalid transition",
+			method: "POST",
+			url:    "/api/orders/3/payments/1/refund",
+			setupMock: func() {
+				mockService.EXPECT().
+					Refund(gomock.Any(), uint64(3), uint64(1)).
+					Return(web.PaymentResponse{}, exception.NewConflictError("Payment cannot move from Pending to Refunded"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Complete payment - invalid payment id",
+			method:         "POST",
+			url:            "/api/orders/3/payments/abc/complete",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var reqBody []byte
+			if tt.body != nil {
+				reqBody, _ = json.Marshal(tt.body)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.url, bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 type ProductController interface {
 	Create(c *fiber.Ctx) error
 	Update(c *fiber.Ctx) error
+	UpdateVariant(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
 	FindById(c *fiber.Ctx) error
 	FindAll(c *fiber.Ctx) error
@@ -6,6 +6,7 @@ import (
 	"github.com/Kahffi/go-rest-api-test/service"
 	"github.com/gofiber/fiber/v2"
 	"strconv"
+	"strings"
 )
 
 type ProductControllerImpl struct {
@@ -31,11 +32,7 @@ func (controller *ProductControllerImpl) Create(c *fiber.Ctx) error {
 
 	productResponse, err := controller.ProductService.Create(c.Context(), *productCreateRequest)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
-			Code:   fiber.StatusInternalServerError,
-			Status: "Internal Server Error",
-			Data:   err.Error(),
-		})
+		return errorResponse(c, err)
 	}
 
 	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
@@ -68,20 +65,51 @@ func (controller *ProductControllerImpl) Update(c *fiber.Ctx) error {
 
 	productResponse, err := controller.ProductService.Update(c.Context(), *productUpdateRequest)
 	if err != nil {
-		if _, ok := err.(exception.NotFoundError); ok {
-			return c.Status(fiber.StatusNotFound).JSON(web.WebResponse{
-				Code:   fiber.StatusNotFound,
-				Status: "Not Found",
-				Data:   err.Error(),
-			})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
-			Code:   fiber.StatusInternalServerError,
-			Status: "Internal Server Error",
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   productResponse,
+	})
+}
+
+// Update Variant of a Product
+func (controller *ProductControllerImpl) UpdateVariant(c *fiber.Ctx) error {
+	variantUpdateRequest := new(web.ProductVariantUpdateRequest)
+	if err := c.BodyParser(variantUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
 			Data:   err.Error(),
 		})
 	}
 
+	productId, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+	variantId, err := strconv.ParseUint(c.Params("variantId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Variant ID",
+			Data:   err.Error(),
+		})
+	}
+	variantUpdateRequest.ProductID = productId
+	variantUpdateRequest.VariantID = variantId
+
+	productResponse, err := controller.ProductService.UpdateVariant(c.Context(), *variantUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
 		Code:   fiber.StatusOK,
 		Status: "OK",
@@ -122,7 +150,8 @@ func (controller *ProductControllerImpl) Delete(c *fiber.Ctx) error {
 	})
 }
 
-// Find Product By ID
+// Find Product By ID, ?store_id= gives the price and stock of a store and ?currency= adds the price in that
+// currency
 func (controller *ProductControllerImpl) FindById(c *fiber.Ctx) error {
 	id, err := strconv.ParseUint(c.Params("productId"), 10, 64)
 	if err != nil {
@@ -133,20 +162,15 @@ func (controller *ProductControllerImpl) FindById(c *fiber.Ctx) error {
 		})
 	}
 
-	productResponse, err := controller.ProductService.FindById(c.Context(), id)
+	storeId, err := storeIdQuery(c)
 	if err != nil {
-		if _, ok := err.(exception.NotFoundError); ok {
-			return c.Status(fiber.StatusNotFound).JSON(web.WebResponse{
-				Code:   fiber.StatusNotFound,
-				Status: "Not Found",
-				Data:   err.Error(),
-			})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
-			Code:   fiber.StatusInternalServerError,
-			Status: "Internal Server Error",
-			Data:   err.Error(),
-		})
+		return errorResponse(c, err)
+	}
+
+	query := web.ProductQuery{Currency: strings.ToUpper(c.Query("currency")), StoreID: storeId}
+	productResponse, err := controller.ProductService.FindById(c.Context(), id, query)
+	if err != nil {
+		return errorResponse(c, err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
@@ -156,15 +180,18 @@ func (controller *ProductControllerImpl) FindById(c *fiber.Ctx) error {
 	})
 }
 
-// Find All Categories
+// Find All Products, ?store_id= gives the prices and stock of a store and ?currency= adds the prices in that
+// currency
 func (controller *ProductControllerImpl) FindAll(c *fiber.Ctx) error {
-	productResponses, err := controller.ProductService.FindAll(c.Context())
+	storeId, err := storeIdQuery(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(web.WebResponse{
-			Code:   fiber.StatusInternalServerError,
-			Status: "Internal Server Error",
-			Data:   err.Error(),
-		})
+		return errorResponse(c, err)
+	}
+
+	query := web.ProductQuery{Currency: strings.ToUpper(c.Query("currency")), StoreID: storeId}
+	productResponses, err := controller.ProductService.FindAll(c.Context(), query)
+	if err != nil {
+		return errorResponse(c, err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
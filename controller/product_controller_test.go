@@ -3,6 +3,7 @@ package controller
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
 	"github.com/Kahffi/go-rest-api-test/model/web"
 	"github.com/Kahffi/go-rest-api-test/service/mocks"
 	"github.com/gofiber/fiber/v2"
@@ -21,6 +22,7 @@ func setupTestAppProduct(mockService *mocks.MockProductService) *fiber.App {
 	products := api.Group("/products")
 	products.Post("/", productController.Create)
 	products.Put("/:productId", productController.Update)
+	products.Put("/:productId/variants/:variantId", productController.UpdateVariant)
 	products.Delete("/:productId", productController.Delete)
 	products.Get("/:productId", productController.FindById)
 	products.Get("/", productController.FindAll)
@@ -61,6 +63,113 @@ func TestProductController(t *testing.T) {
 				Data:   web.ProductResponse{Id: 1, Name: "Updated"},
 			},
 		},
+		{
+			name:   "Find product in currency - success",
+			method: "GET",
+			url:    "/api/products/1?currency=usd",
+			setupMock: func() {
+				mockService.EXPECT().
+					FindById(gomock.Any(), uint64(1), web.ProductQuery{Currency: "USD"}).
+					Return(web.ProductResponse{Id: 1, Name: "Kopi Susu", Currency: "USD"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusOK,
+				Status: "OK",
+				Data:   web.ProductResponse{Id: 1, Name: "Kopi Susu"},
+			},
+		},
+		{
+			name:   "Find products in currency without rate - bad request",
+			method: "GET",
+			url:    "/api/products?currency=SGD",
+			setupMock: func() {
+				mockService.EXPECT().
+					FindAll(gomock.Any(), web.ProductQuery{Currency: "SGD"}).
+					Return(nil, exception.NewBadRequestError("No SGD exchange rate in effect on 2026-10-16"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusBadRequest,
+				Status: "Bad Request",
+				Data:   "No SGD exchange rate in effect on 2026-10-16",
+			},
+		},
+		{
+			name:   "Find product at store - success",
+			method: "GET",
+			url:    "/api/products/1?store_id=2",
+			setupMock: func() {
+				mockService.EXPECT().
+					FindById(gomock.Any(), uint64(1), web.ProductQuery{StoreID: 2}).
+					Return(web.ProductResponse{Id: 1, Name: "Kopi Susu", StoreID: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusOK,
+				Status: "OK",
+				Data:   web.ProductResponse{Id: 1, Name: "Kopi Susu"},
+			},
+		},
+		{
+			name:   "Update variant - success",
+			method: "PUT",
+			url:    "/api/products/10/variants/11",
+			body:   web.ProductVariantUpdateRequest{SKU: "KAOS-S2"},
+			setupMock: func() {
+				mockService.EXPECT().
+					UpdateVariant(gomock.Any(), web.ProductVariantUpdateRequest{ProductID: 10, VariantID: 11, SKU: "KAOS-S2"}).
+					Return(web.ProductResponse{Id: 10, Name: "Kaos polos hitam"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusOK,
+				Status: "OK",
+				Data:   web.ProductResponse{Id: 10, Name: "Kaos polos hitam"},
+			},
+		},
+		{
+			name:           "Update variant - invalid variant id",
+			method:         "PUT",
+			url:            "/api/products/10/variants/abc",
+			body:           web.ProductVariantUpdateRequest{SKU: "KAOS-S2"},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusBadRequest,
+				Status: "Invalid Variant ID",
+				Data:   "strconv.ParseUint: parsing \"abc\": invalid syntax",
+			},
+		},
+		{
+			name:   "Update variant - SKU taken",
+			method: "PUT",
+			url:    "/api/products/10/variants/11",
+			body:   web.ProductVariantUpdateRequest{SKU: "KAOS-M"},
+			setupMock: func() {
+				mockService.EXPECT().
+					UpdateVariant(gomock.Any(), gomock.Any()).
+					Return(web.ProductResponse{}, exception.NewConflictError("SKU KAOS-M is already used by variant 12"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusConflict,
+				Status: "Conflict",
+				Data:   "SKU KAOS-M is already used by variant 12",
+			},
+		},
+		{
+			name:           "Find products at store - invalid store id",
+			method:         "GET",
+			url:            "/api/products?store_id=abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: web.WebResponse{
+				Code:   http.StatusBadRequest,
+				Status: "Bad Request",
+				Data:   "Invalid Store ID",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type PromotionControllerImpl struct {
+	PromotionService service.PromotionService
+}
+
+func NewPromotionController(promotionService service.PromotionService) PromotionController {
+	return &PromotionControllerImpl{
+		PromotionService: promotionService,
+	}
+}
+
+// Create Promotion
+func (controller *PromotionControllerImpl) Create(c *fiber.Ctx) error {
+	promotionCreateRequest := new(web.PromotionCreateRequest)
+	if err := c.BodyParser(promotionCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	promotionResponse, err := controller.PromotionService.Create(c.Context(), *promotionCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   promotionResponse,
+	})
+}
+
+// Update Promotion
+func (controller *PromotionControllerImpl) Update(c *fiber.Ctx) error {
+	promotionUpdateRequest := new(web.PromotionUpdateRequest)
+	if err := c.BodyParser(promotionUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("promotionId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Promotion ID",
+			Data:   err.Error(),
+		})
+	}
+	promotionUpdateRequest.Id = id
+
+	promotionResponse, err := controller.PromotionService.Update(c.Context(), *promotionUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   promotionResponse,
+	})
+}
+
+// Delete Promotion
+func (controller *PromotionControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("promotionId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Promotion ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.PromotionService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Find Promotion By ID
+func (controller *PromotionControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("promotionId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Promotion ID",
+			Data:   err.Error(),
+		})
+	}
+
+	promotionResponse, err := controller.PromotionService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   promotionResponse,
+	})
+}
+
+// Find All Promotions
+func (controller *PromotionControllerImpl) FindAll(c *fiber.Ctx) error {
+	promotionResponses, err := controller.PromotionService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   promotionResponses,
+	})
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppPromotion(mockService *mocks.MockPromotionService) *fiber.App {
+	app := fiber.New()
+	promotionController := NewPromotionController(mockService)
+
+	api := app.Group("/api")
+	promotions := api.Group("/promotions")
+	promotions.Get("/", promotionController.FindAll)
+	promotions.Get("/:promotionId", promotionController.FindById)
+	promotions.Post("/", promotionController.Create)
+	promotions.Put("/:promotionId", promotionController.Update)
+	promotions.Delete("/:promotionId", promotionController.Delete)
+
+	return app
+}
+
+func TestPromotionController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPromotionService(ctrl)
+	app := setupTestAppPromotion(mockService)
+
+	promotionBody := `{"name":"Buy 2 get 1","type":"BuyXGetY","buy_qty":2,"free_qty":1,"product_ids":[1],` +
+		`"valid_from":"2024-01-01T00:00:00Z","valid_until":"2024-01-31T00:00:00Z"}`
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create promotion - success",
+			method: "POST",
+			url:    "/api/promotions",
+			body:   strings.NewReader(promotionBody),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.PromotionResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create promotion - validation error",
+			method: "POST",
+			url:    "/api/promotions",
+			body:   strings.NewReader(`{"name":"Buy 2 get 1"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.PromotionResponse{}, validator.ValidationErrors{})
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Update promotion - not found",
+			method: "PUT",
+			url:    "/api/promotions/2",
+			body:   strings.NewReader(promotionBody),
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(web.PromotionResponse{}, exception.NewNotFoundError("Promotion not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Create promotion - lonely bundle",
+			method: "POST",
+			url:    "/api/promotions",
+			body:   strings.NewReader(promotionBody),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.PromotionResponse{},
+					exception.NewBadRequestError("A bundle needs at least two different products"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Delete promotion - success",
+			method: "DELETE",
+			url:    "/api/promotions/1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(1)).Return(nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "Deleted Successfully",
+		},
+		{
+			name:               "Find promotion - invalid id",
+			method:             "GET",
+			url:                "/api/promotions/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Promotion ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type PurchaseOrderController interface {
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Send(c *fiber.Ctx) error
+	Receive(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type PurchaseOrderControllerImpl struct {
+	PurchaseOrderService service.PurchaseOrderService
+}
+
+func NewPurchaseOrderController(purchaseOrderService service.PurchaseOrderService) PurchaseOrderController {
+	return &PurchaseOrderControllerImpl{
+		PurchaseOrderService: purchaseOrderService,
+	}
+}
+
+// Create Purchase Order
+func (controller *PurchaseOrderControllerImpl) Create(c *fiber.Ctx) error {
+	purchaseOrderCreateRequest := new(web.PurchaseOrderCreateRequest)
+	if err := c.BodyParser(purchaseOrderCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	purchaseOrderResponse, err := controller.PurchaseOrderService.Create(c.Context(), *purchaseOrderCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   purchaseOrderResponse,
+	})
+}
+
+// Update Purchase Order
+func (controller *PurchaseOrderControllerImpl) Update(c *fiber.Ctx) error {
+	purchaseOrderUpdateRequest := new(web.PurchaseOrderUpdateRequest)
+	if err := c.BodyParser(purchaseOrderUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("purchaseOrderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Purchase Order ID",
+			Data:   err.Error(),
+		})
+	}
+	purchaseOrderUpdateRequest.Id = id
+
+	purchaseOrderResponse, err := controller.PurchaseOrderService.Update(c.Context(), *purchaseOrderUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   purchaseOrderResponse,
+	})
+}
+
+// Delete Purchase Order
+func (controller *PurchaseOrderControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("purchaseOrderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Purchase Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.PurchaseOrderService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Find Purchase Order By ID
+func (controller *PurchaseOrderControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("purchaseOrderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Purchase Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	purchaseOrderResponse, err := controller.PurchaseOrderService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   purchaseOrderResponse,
+	})
+}
+
+// Find All Purchase Orders, ?store_id= narrows them to the ones delivered to a store
+func (controller *PurchaseOrderControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	purchaseOrderResponses, err := controller.PurchaseOrderService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   purchaseOrderResponses,
+	})
+}
+
+// Send Purchase Order to its supplier
+func (controller *PurchaseOrderControllerImpl) Send(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("purchaseOrderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Purchase Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	purchaseOrderResponse, err := controller.PurchaseOrderService.Send(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   purchaseOrderResponse,
+	})
+}
+
+// Receive a delivery against a Purchase Order
+func (controller *PurchaseOrderControllerImpl) Receive(c *fiber.Ctx) error {
+	receiveRequest := new(web.PurchaseOrderReceiveRequest)
+	if err := c.BodyParser(receiveRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("purchaseOrderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Purchase Order ID",
+			Data:   err.Error(),
+		})
+	}
+	receiveRequest.PurchaseOrderID = id
+
+	purchaseOrderResponse, err := controller.PurchaseOrderService.Receive(c.Context(), *receiveRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   purchaseOrderResponse,
+	})
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppPurchaseOrder(mockService *mocks.MockPurchaseOrderService) *fiber.App {
+	app := fiber.New()
+	purchaseOrderController := NewPurchaseOrderController(mockService)
+
+	api := app.Group("/api")
+	purchaseOrders := api.Group("/purchase-orders")
+	purchaseOrders.Get("/", purchaseOrderController.FindAll)
+	purchaseOrders.Get("/:purchaseOrderId", purchaseOrderController.FindById)
+	purchaseOrders.Post("/", purchaseOrderController.Create)
+	purchaseOrders.Put("/:purchaseOrderId", purchaseOrderController.Update)
+	purchaseOrders.Delete("/:purchaseOrderId", purchaseOrderController.Delete)
+	purchaseOrders.Post("/:purchaseOrderId/send", purchaseOrderController.Send)
+	purchaseOrders.Post("/:purchaseOrderId/receive", purchaseOrderController.Receive)
+
+	return app
+}
+
+func TestPurchaseOrderController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPurchaseOrderService(ctrl)
+	app := setupTestAppPurchaseOrder(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create purchase order - success",
+			method: "POST",
+			url:    "/api/purchase-orders",
+			body:   strings.NewReader(`{"supplier_id":1,"lines":[{"product_id":1,"ordered_qty":10,"unit_cost":7000}]}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.PurchaseOrderResponse{Id: 4}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Send purchase order - already sent",
+			method: "POST",
+			url:    "/api/purchase-orders/4/send",
+			setupMock: func() {
+				mockService.EXPECT().Send(gomock.Any(), uint64(4)).
+					Return(web.PurchaseOrderResponse{}, exception.NewConflictError("Purchase order cannot be sent while Sent"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:   "Receive purchase order - success",
+			method: "POST",
+			url:    "/api/purchase-orders/4/receive",
+			body:   strings.NewReader(`{"lines":[{"product_id":1,"quantity":6}],"reference":"DN-17"}`),
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, request web.PurchaseOrderReceiveRequest) (web.PurchaseOrderResponse, error) {
+						assert.Equal(t, uint64(4), request.PurchaseOrderID)
+						return web.PurchaseOrderResponse{Id: 4, Status: domain.PurchaseOrderStatusPartiallyReceived}, nil
+					})
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Receive purchase order - product not on order",
+			method: "POST",
+			url:    "/api/purchase-orders/4/receive",
+			body:   strings.NewReader(`{"lines":[{"product_id":3,"quantity":1}]}`),
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), gomock.Any()).
+					Return(web.PurchaseOrderResponse{}, exception.NewBadRequestError("Product 3 is not on purchase order #4"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:               "Receive purchase order - invalid id",
+			method:             "POST",
+			url:                "/api/purchase-orders/abc/receive",
+			body:               strings.NewReader(`{"close":true}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Purchase Order ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
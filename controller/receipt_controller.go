@@ -0,0 +1,11 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReceiptController interface {
+	FindById(c *fiber.Ctx) error
+	FindByOrderId(c *fiber.Ctx) error
+	Print(c *fiber.Ctx) error
+}
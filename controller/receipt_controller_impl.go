@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+const escPosMIME = "application/vnd.escpos"
+
+type ReceiptControllerImpl struct {
+	ReceiptService service.ReceiptService
+}
+
+func NewReceiptController(receiptService service.ReceiptService) ReceiptController {
+	return &ReceiptControllerImpl{
+		ReceiptService: receiptService,
+	}
+}
+
+// Find Receipt By ID
+func (controller *ReceiptControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("receiptId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Receipt ID",
+			Data:   err.Error(),
+		})
+	}
+
+	receiptResponse, err := controller.ReceiptService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   receiptResponse,
+	})
+}
+
+// Find Receipt By Order ID
+func (controller *ReceiptControllerImpl) FindByOrderId(c *fiber.Ctx) error {
+	orderId, err := strconv.ParseUint(c.Params("orderId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Order ID",
+			Data:   err.Error(),
+		})
+	}
+
+	receiptResponse, err := controller.ReceiptService.FindByOrderId(c.Context(), orderId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   receiptResponse,
+	})
+}
+
+// Print Receipt. The format comes from ?format=text|escpos, or from the Accept header when the query is absent.
+func (controller *ReceiptControllerImpl) Print(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("receiptId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Receipt ID",
+			Data:   err.Error(),
+		})
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		switch c.Accepts(fiber.MIMETextPlain, escPosMIME, fiber.MIMEOctetStream) {
+		case escPosMIME, fiber.MIMEOctetStream:
+			format = render.FormatEscPos
+		default:
+			format = render.FormatText
+		}
+	}
+
+	output, err := controller.ReceiptService.Print(c.Context(), id, format, c.Query("profile", render.Profile80mm.Name))
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	if format == render.FormatEscPos {
+		c.Set(fiber.HeaderContentType, fiber.MIMEOctetStream)
+	} else {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	}
+	return c.Status(fiber.StatusOK).Send(output)
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTestAppReceipt(mockService *mocks.MockReceiptService) *fiber.App {
+	app := fiber.New()
+	receiptController := NewReceiptController(mockService)
+
+	api := app.Group("/api")
+	api.Get("/orders/:orderId/receipt", receiptController.FindByOrderId)
+	api.Get("/receipts/:receiptId", receiptController.FindById)
+	api.Get("/receipts/:receiptId/print", receiptController.Print)
+
+	return app
+}
+
+func TestReceiptController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceiptService(ctrl)
+	app := setupTestAppReceipt(mockService)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "Find receipt - success",
+			url:  "/api/receipts/1",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(1)).Return(web.ReceiptResponse{Id: 1, OrderID: 4}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Find order receipt - not issued yet",
+			url:  "/api/orders/4/receipt",
+			setupMock: func() {
+				mockService.EXPECT().FindByOrderId(gomock.Any(), uint64(4)).Return(web.ReceiptResponse{}, exception.NewNotFoundError("Receipt not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest("GET", tt.url, nil)
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+		})
+	}
+}
+
+func TestReceiptControllerPrint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceiptService(ctrl)
+	app := setupTestAppReceipt(mockService)
+
+	tests := []struct {
+		name                string
+		url                 string
+		accept              string
+		setupMock           func()
+		expectedStatus      int
+		expectedContentType string
+	}{
+		{
+			name: "Print text with default profile",
+			url:  "/api/receipts/1/print",
+			setupMock: func() {
+				mockService.EXPECT().Print(gomock.Any(), uint64(1), render.FormatText, render.Profile80mm.Name).Return([]byte("text"), nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: fiber.MIMETextPlainCharsetUTF8,
+		},
+		{
+			name:   "Print escpos selected by accept header",
+			url:    "/api/receipts/1/print?profile=58mm",
+			accept: "application/vnd.escpos",
+			setupMock: func() {
+				mockService.EXPECT().Print(gomock.Any(), uint64(1), render.FormatEscPos, render.Profile58mm.Name).Return([]byte{0x1B, 0x40}, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: fiber.MIMEOctetStream,
+		},
+		{
+			name: "Print unknown profile",
+			url:  "/api/receipts/1/print?format=text&profile=99mm",
+			setupMock: func() {
+				mockService.EXPECT().Print(gomock.Any(), uint64(1), render.FormatText, "99mm").Return(nil, exception.NewBadRequestError("Unknown printer profile \"99mm\""))
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: fiber.MIMEApplicationJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest("GET", tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedContentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
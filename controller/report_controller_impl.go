@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReportControllerImpl struct {
+	ReportService service.ReportService
+}
+
+func NewReportController(reportService service.ReportService) ReportController {
+	return &ReportControllerImpl{
+		ReportService: reportService,
+	}
+}
+
+// SalesReport - Sales between ?from and ?to, grouped by ?group_by, of one store with ?store_id
+func (controller *ReportControllerImpl) SalesReport(c *fiber.Ctx) error {
+	reportRequest := new(web.SalesReportRequest)
+	if err := c.QueryParser(reportRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	reportResponse, err := controller.ReportService.SalesReport(c.Context(), *reportRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   reportResponse,
+	})
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTestAppReport(mockService *mocks.MockReportService) *fiber.App {
+	app := fiber.New()
+	reportController := NewReportController(mockService)
+
+	reports := app.Group("/api/reports")
+	reports.Get("/sales", reportController.SalesReport)
+
+	return app
+}
+
+func TestReportController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	app := setupTestAppReport(mockService)
+
+	tests := []struct {
+		name               string
+		url                string
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name: "Sales by product - success",
+			url:  "/api/reports/sales?from=2026-03-01&to=2026-03-31&group_by=product",
+			setupMock: func() {
+				mockService.EXPECT().SalesReport(gomock.Any(), web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31", GroupBy: "product"}).
+					Return(web.SalesReportResponse{GroupBy: "product"}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name: "Sales - range backwards",
+			url:  "/api/reports/sales?from=2026-03-31&to=2026-03-01",
+			setupMock: func() {
+				mockService.EXPECT().SalesReport(gomock.Any(), web.SalesReportRequest{From: "2026-03-31", To: "2026-03-01"}).
+					Return(web.SalesReportResponse{}, exception.NewBadRequestError("From must not be after to"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest("GET", tt.url, nil)
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
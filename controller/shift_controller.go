@@ -0,0 +1,14 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShiftController interface {
+	Open(c *fiber.Ctx) error
+	Close(c *fiber.Ctx) error
+	RecordCashMovement(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindReport(c *fiber.Ctx) error
+}
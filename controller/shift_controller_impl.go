@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type ShiftControllerImpl struct {
+	ShiftService service.ShiftService
+}
+
+func NewShiftController(shiftService service.ShiftService) ShiftController {
+	return &ShiftControllerImpl{
+		ShiftService: shiftService,
+	}
+}
+
+// Open Shift on a register
+func (controller *ShiftControllerImpl) Open(c *fiber.Ctx) error {
+	openRequest := new(web.ShiftOpenRequest)
+	if err := c.BodyParser(openRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	shiftResponse, err := controller.ShiftService.Open(c.Context(), *openRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   shiftResponse,
+	})
+}
+
+// Close Shift with the counted drawer, answering with the Z-report
+func (controller *ShiftControllerImpl) Close(c *fiber.Ctx) error {
+	closeRequest := new(web.ShiftCloseRequest)
+	if err := c.BodyParser(closeRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("shiftId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Shift ID",
+			Data:   err.Error(),
+		})
+	}
+	closeRequest.ShiftID = id
+
+	reportResponse, err := controller.ShiftService.Close(c.Context(), *closeRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   reportResponse,
+	})
+}
+
+// Record Cash Movement, a pay-in or pay-out of the drawer
+func (controller *ShiftControllerImpl) RecordCashMovement(c *fiber.Ctx) error {
+	movementRequest := new(web.CashMovementRequest)
+	if err := c.BodyParser(movementRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("shiftId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Shift ID",
+			Data:   err.Error(),
+		})
+	}
+	movementRequest.ShiftID = id
+
+	shiftResponse, err := controller.ShiftService.RecordCashMovement(c.Context(), *movementRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   shiftResponse,
+	})
+}
+
+// Find Shift By ID
+func (controller *ShiftControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("shiftId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Shift ID",
+			Data:   err.Error(),
+		})
+	}
+
+	shiftResponse, err := controller.ShiftService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   shiftResponse,
+	})
+}
+
+// Find All Shifts, ?store_id= narrows them to one store
+func (controller *ShiftControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	shiftResponses, err := controller.ShiftService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   shiftResponses,
+	})
+}
+
+// Find Report of the shift, the Z-report once it is closed
+func (controller *ShiftControllerImpl) FindReport(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("shiftId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Shift ID",
+			Data:   err.Error(),
+		})
+	}
+
+	reportResponse, err := controller.ShiftService.FindReport(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   reportResponse,
+	})
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppShift(mockService *mocks.MockShiftService) *fiber.App {
+	app := fiber.New()
+	shiftController := NewShiftController(mockService)
+
+	shifts := app.Group("/api/shifts")
+	shifts.Get("/", shiftController.FindAll)
+	shifts.Get("/:shiftId", shiftController.FindById)
+	shifts.Post("/", shiftController.Open)
+	shifts.Post("/:shiftId/close", shiftController.Close)
+	shifts.Post("/:shiftId/cash-movements", shiftController.RecordCashMovement)
+	shifts.Get("/:shiftId/report", shiftController.FindReport)
+
+	return app
+}
+
+func TestShiftController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockShiftService(ctrl)
+	app := setupTestAppShift(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Open - success",
+			method: "POST",
+			url:    "/api/shifts",
+			body:   strings.NewReader(`{"employee_id":1,"register":"Till 1","opening_float":200000}`),
+			setupMock: func() {
+				mockService.EXPECT().Open(gomock.Any(), web.ShiftOpenRequest{EmployeeID: 1, Register: "Till 1", OpeningFloat: money.New(200000)}).
+					Return(web.ShiftResponse{Id: 3}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Open - register busy",
+			method: "POST",
+			url:    "/api/shifts",
+			body:   strings.NewReader(`{"employee_id":2,"register":"Till 1"}`),
+			setupMock: func() {
+				mockService.EXPECT().Open(gomock.Any(), gomock.Any()).
+					Return(web.ShiftResponse{}, exception.NewConflictError("Register Till 1 already has shift #3 open"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:   "Close - success",
+			method: "POST",
+			url:    "/api/shifts/3/close",
+			body:   strings.NewReader(`{"counted_cash":214000}`),
+			setupMock: func() {
+				mockService.EXPECT().Close(gomock.Any(), web.ShiftCloseRequest{ShiftID: 3, CountedCash: money.New(214000)}).
+					Return(web.ShiftReportResponse{}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Pay-out - success",
+			method: "POST",
+			url:    "/api/shifts/3/cash-movements",
+			body:   strings.NewReader(`{"type":"PayOut","amount":50000,"reason":"Cash drop to the safe"}`),
+			setupMock: func() {
+				mockService.EXPECT().RecordCashMovement(gomock.Any(), web.CashMovementRequest{ShiftID: 3, Type: "PayOut", Amount: money.New(50000), Reason: "Cash drop to the safe"}).
+					Return(web.ShiftResponse{Id: 3}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Report - not found",
+			method: "GET",
+			url:    "/api/shifts/9/report",
+			setupMock: func() {
+				mockService.EXPECT().FindReport(gomock.Any(), uint64(9)).Return(web.ShiftReportResponse{}, exception.NewNotFoundError("Shift not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:               "Close - invalid shift id",
+			method:             "POST",
+			url:                "/api/shifts/abc/close",
+			body:               strings.NewReader(`{"counted_cash":0}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Shift ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
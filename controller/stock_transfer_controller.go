@@ -0,0 +1,14 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type StockTransferController interface {
+	Create(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Ship(c *fiber.Ctx) error
+	Receive(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+}
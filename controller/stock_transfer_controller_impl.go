@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type StockTransferControllerImpl struct {
+	StockTransferService service.StockTransferService
+}
+
+func NewStockTransferController(stockTransferService service.StockTransferService) StockTransferController {
+	return &StockTransferControllerImpl{
+		StockTransferService: stockTransferService,
+	}
+}
+
+// Create Stock Transfer
+func (controller *StockTransferControllerImpl) Create(c *fiber.Ctx) error {
+	stockTransferCreateRequest := new(web.StockTransferCreateRequest)
+	if err := c.BodyParser(stockTransferCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	stockTransferResponse, err := controller.StockTransferService.Create(c.Context(), *stockTransferCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   stockTransferResponse,
+	})
+}
+
+// Delete Stock Transfer
+func (controller *StockTransferControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("stockTransferId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stock Transfer ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.StockTransferService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Ship Stock Transfer from its source store
+func (controller *StockTransferControllerImpl) Ship(c *fiber.Ctx) error {
+	shipRequest := new(web.StockTransferShipRequest)
+	if err := c.BodyParser(shipRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("stockTransferId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stock Transfer ID",
+			Data:   err.Error(),
+		})
+	}
+	shipRequest.StockTransferID = id
+
+	stockTransferResponse, err := controller.StockTransferService.Ship(c.Context(), *shipRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stockTransferResponse,
+	})
+}
+
+// Receive Stock Transfer at its destination store
+func (controller *StockTransferControllerImpl) Receive(c *fiber.Ctx) error {
+	receiveRequest := new(web.StockTransferReceiveRequest)
+	if err := c.BodyParser(receiveRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("stockTransferId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stock Transfer ID",
+			Data:   err.Error(),
+		})
+	}
+	receiveRequest.StockTransferID = id
+
+	stockTransferResponse, err := controller.StockTransferService.Receive(c.Context(), *receiveRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stockTransferResponse,
+	})
+}
+
+// Find Stock Transfer By ID
+func (controller *StockTransferControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("stockTransferId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stock Transfer ID",
+			Data:   err.Error(),
+		})
+	}
+
+	stockTransferResponse, err := controller.StockTransferService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stockTransferResponse,
+	})
+}
+
+// Find All Stock Transfers, ?store_id= narrows them to the ones leaving or reaching a store
+func (controller *StockTransferControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	stockTransferResponses, err := controller.StockTransferService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stockTransferResponses,
+	})
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppStockTransfer(mockService *mocks.MockStockTransferService) *fiber.App {
+	app := fiber.New()
+	stockTransferController := NewStockTransferController(mockService)
+
+	api := app.Group("/api")
+	stockTransfers := api.Group("/stock-transfers")
+	stockTransfers.Get("/", stockTransferController.FindAll)
+	stockTransfers.Get("/:stockTransferId", stockTransferController.FindById)
+	stockTransfers.Post("/", stockTransferController.Create)
+	stockTransfers.Delete("/:stockTransferId", stockTransferController.Delete)
+	stockTransfers.Post("/:stockTransferId/ship", stockTransferController.Ship)
+	stockTransfers.Post("/:stockTransferId/receive", stockTransferController.Receive)
+
+	return app
+}
+
+func TestStockTransferController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStockTransferService(ctrl)
+	app := setupTestAppStockTransfer(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create stock transfer - success",
+			method: "POST",
+			url:    "/api/stock-transfers",
+			body:   strings.NewReader(`{"from_store_id":1,"to_store_id":2,"lines":[{"product_id":1,"quantity":10}]}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.StockTransferResponse{Id: 6}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Find stock transfers of a store",
+			method: "GET",
+			url:    "/api/stock-transfers?store_id=2",
+			setupMock: func() {
+				mockService.EXPECT().FindAll(gomock.Any(), uint64(2)).Return([]web.StockTransferResponse{{Id: 6}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Ship stock transfer - source store short",
+			method: "POST",
+			url:    "/api/stock-transfers/6/ship",
+			body:   strings.NewReader(`{}`),
+			setupMock: func() {
+				mockService.EXPECT().Ship(gomock.Any(), gomock.Any()).Return(web.StockTransferResponse{},
+					exception.NewInsufficientStockError([]web.StockShortageResponse{{ProductID: 1, Requested: 10, Available: 4}}))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Insufficient stock",
+		},
+		{
+			name:   "Receive stock transfer - success",
+			method: "POST",
+			url:    "/api/stock-transfers/6/receive",
+			body:   strings.NewReader(`{"lines":[{"product_id":1,"quantity":7,"note":"One broken in transit"}]}`),
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, request web.StockTransferReceiveRequest) (web.StockTransferResponse, error) {
+						assert.Equal(t, uint64(6), request.StockTransferID)
+						assert.Equal(t, "One broken in transit", request.Lines[0].Note)
+						return web.StockTransferResponse{Id: 6, Status: domain.StockTransferStatusReceived}, nil
+					})
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:               "Receive stock transfer - invalid id",
+			method:             "POST",
+			url:                "/api/stock-transfers/abc/receive",
+			body:               strings.NewReader(`{}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Stock Transfer ID",
+		},
+		{
+			name:   "Delete stock transfer - already shipped",
+			method: "DELETE",
+			url:    "/api/stock-transfers/6",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(6)).
+					Return(exception.NewConflictError("Stock transfer cannot be deleted while Shipped"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
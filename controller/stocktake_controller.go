@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type StocktakeController interface {
+	Create(c *fiber.Ctx) error
+	RecordCounts(c *fiber.Ctx) error
+	FindVariances(c *fiber.Ctx) error
+	Commit(c *fiber.Ctx) error
+	Cancel(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type StocktakeControllerImpl struct {
+	StocktakeService service.StocktakeService
+}
+
+func NewStocktakeController(stocktakeService service.StocktakeService) StocktakeController {
+	return &StocktakeControllerImpl{
+		StocktakeService: stocktakeService,
+	}
+}
+
+// Create Stocktake, snapshotting the stock to count
+func (controller *StocktakeControllerImpl) Create(c *fiber.Ctx) error {
+	stocktakeCreateRequest := new(web.StocktakeCreateRequest)
+	if err := c.BodyParser(stocktakeCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	stocktakeResponse, err := controller.StocktakeService.Create(c.Context(), *stocktakeCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   stocktakeResponse,
+	})
+}
+
+// Record Counts of an employee
+func (controller *StocktakeControllerImpl) RecordCounts(c *fiber.Ctx) error {
+	countRequest := new(web.StocktakeCountRequest)
+	if err := c.BodyParser(countRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("stocktakeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stocktake ID",
+			Data:   err.Error(),
+		})
+	}
+	countRequest.StocktakeID = id
+
+	stocktakeResponse, err := controller.StocktakeService.RecordCounts(c.Context(), *countRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stocktakeResponse,
+	})
+}
+
+// Find Variances the commit would post
+func (controller *StocktakeControllerImpl) FindVariances(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("stocktakeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stocktake ID",
+			Data:   err.Error(),
+		})
+	}
+
+	varianceResponse, err := controller.StocktakeService.FindVariances(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   varianceResponse,
+	})
+}
+
+// Commit Stocktake, adjusting stock to the counts
+func (controller *StocktakeControllerImpl) Commit(c *fiber.Ctx) error {
+	commitRequest := new(web.StocktakeCommitRequest)
+	if err := c.BodyParser(commitRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("stocktakeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stocktake ID",
+			Data:   err.Error(),
+		})
+	}
+	commitRequest.StocktakeID = id
+
+	stocktakeResponse, err := controller.StocktakeService.Commit(c.Context(), *commitRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stocktakeResponse,
+	})
+}
+
+// Cancel Stocktake
+func (controller *StocktakeControllerImpl) Cancel(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("stocktakeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stocktake ID",
+			Data:   err.Error(),
+		})
+	}
+
+	stocktakeResponse, err := controller.StocktakeService.Cancel(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stocktakeResponse,
+	})
+}
+
+// Find Stocktake By ID
+func (controller *StocktakeControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("stocktakeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Stocktake ID",
+			Data:   err.Error(),
+		})
+	}
+
+	stocktakeResponse, err := controller.StocktakeService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stocktakeResponse,
+	})
+}
+
+// Find All Stocktakes, ?store_id= narrows them to one store
+func (controller *StocktakeControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeId, err := storeIdQuery(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	stocktakeResponses, err := controller.StocktakeService.FindAll(c.Context(), storeId)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   stocktakeResponses,
+	})
+}
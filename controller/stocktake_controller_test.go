@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppStocktake(mockService *mocks.MockStocktakeService) *fiber.App {
+	app := fiber.New()
+	stocktakeController := NewStocktakeController(mockService)
+
+	api := app.Group("/api")
+	stocktakes := api.Group("/stocktakes")
+	stocktakes.Get("/", stocktakeController.FindAll)
+	stocktakes.Get("/:stocktakeId", stocktakeController.FindById)
+	stocktakes.Post("/", stocktakeController.Create)
+	stocktakes.Post("/:stocktakeId/counts", stocktakeController.RecordCounts)
+	stocktakes.Get("/:stocktakeId/variances", stocktakeController.FindVariances)
+	stocktakes.Post("/:stocktakeId/commit", stocktakeController.Commit)
+	stocktakes.Post("/:stocktakeId/cancel", stocktakeController.Cancel)
+
+	return app
+}
+
+func TestStocktakeController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStocktakeService(ctrl)
+	app := setupTestAppStocktake(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create stocktake - success",
+			method: "POST",
+			url:    "/api/stocktakes",
+			body:   strings.NewReader(`{"category_id":32,"note":"October count"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.StocktakeResponse{Id: 6}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Record counts - success",
+			method: "POST",
+			url:    "/api/stocktakes/6/counts",
+			body:   strings.NewReader(`{"employee_id":2,"counts":[{"product_id":1,"quantity":37}]}`),
+			setupMock: func() {
+				mockService.EXPECT().RecordCounts(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, request web.StocktakeCountRequest) (web.StocktakeResponse, error) {
+						assert.Equal(t, uint64(6), request.StocktakeID)
+						return web.StocktakeResponse{Id: 6}, nil
+					})
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find variances - success",
+			method: "GET",
+			url:    "/api/stocktakes/6/variances",
+			setupMock: func() {
+				mockService.EXPECT().FindVariances(gomock.Any(), uint64(6)).Return(web.StocktakeVarianceResponse{StocktakeID: 6}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Commit stocktake - missing reason code",
+			method: "POST",
+			url:    "/api/stocktakes/6/commit",
+			body:   strings.NewReader(`{"employee_id":2}`),
+			setupMock: func() {
+				mockService.EXPECT().Commit(gomock.Any(), gomock.Any()).
+					Return(web.StocktakeResponse{}, exception.NewBadRequestError("Product 1 is off by -3 and needs a reason code"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Cancel stocktake - already committed",
+			method: "POST",
+			url:    "/api/stocktakes/6/cancel",
+			setupMock: func() {
+				mockService.EXPECT().Cancel(gomock.Any(), uint64(6)).
+					Return(web.StocktakeResponse{}, exception.NewConflictError("Stocktake cannot be cancelled while Committed"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:               "Find stocktake - invalid id",
+			method:             "GET",
+			url:                "/api/stocktakes/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Stocktake ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type StoreController interface {
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	SetPrice(c *fiber.Ctx) error
+	ClearPrice(c *fiber.Ctx) error
+}
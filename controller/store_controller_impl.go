@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type StoreControllerImpl struct {
+	StoreService service.StoreService
+}
+
+func NewStoreController(storeService service.StoreService) StoreController {
+	return &StoreControllerImpl{
+		StoreService: storeService,
+	}
+}
+
+// Create Store
+func (controller *StoreControllerImpl) Create(c *fiber.Ctx) error {
+	storeCreateRequest := new(web.StoreCreateRequest)
+	if err := c.BodyParser(storeCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	storeResponse, err := controller.StoreService.Create(c.Context(), *storeCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   storeResponse,
+	})
+}
+
+// Update Store
+func (controller *StoreControllerImpl) Update(c *fiber.Ctx) error {
+	storeUpdateRequest := new(web.StoreUpdateRequest)
+	if err := c.BodyParser(storeUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("storeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Store ID",
+			Data:   err.Error(),
+		})
+	}
+	storeUpdateRequest.Id = id
+
+	storeResponse, err := controller.StoreService.Update(c.Context(), *storeUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   storeResponse,
+	})
+}
+
+// Find Store By ID
+func (controller *StoreControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("storeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Store ID",
+			Data:   err.Error(),
+		})
+	}
+
+	storeResponse, err := controller.StoreService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   storeResponse,
+	})
+}
+
+// Find All Stores
+func (controller *StoreControllerImpl) FindAll(c *fiber.Ctx) error {
+	storeResponses, err := controller.StoreService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   storeResponses,
+	})
+}
+
+// SetPrice - Override the price of a product at a store
+func (controller *StoreControllerImpl) SetPrice(c *fiber.Ctx) error {
+	priceRequest := new(web.StorePriceRequest)
+	if err := c.BodyParser(priceRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	return controller.savePrice(c, priceRequest)
+}
+
+// ClearPrice - Sell a product at its catalogue price again at a store
+func (controller *StoreControllerImpl) ClearPrice(c *fiber.Ctx) error {
+	return controller.savePrice(c, new(web.StorePriceRequest))
+}
+
+func (controller *StoreControllerImpl) savePrice(c *fiber.Ctx, priceRequest *web.StorePriceRequest) error {
+	storeId, err := strconv.ParseUint(c.Params("storeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Store ID",
+			Data:   err.Error(),
+		})
+	}
+	productId, err := strconv.ParseUint(c.Params("productId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Product ID",
+			Data:   err.Error(),
+		})
+	}
+	priceRequest.StoreID, priceRequest.ProductID = storeId, productId
+
+	priceResponse, err := controller.StoreService.SetPrice(c.Context(), *priceRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   priceResponse,
+	})
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppStore(mockService *mocks.MockStoreService) *fiber.App {
+	app := fiber.New()
+	storeController := NewStoreController(mockService)
+
+	stores := app.Group("/api/stores")
+	stores.Get("/", storeController.FindAll)
+	stores.Get("/:storeId", storeController.FindById)
+	stores.Post("/", storeController.Create)
+	stores.Put("/:storeId", storeController.Update)
+	stores.Put("/:storeId/prices/:productId", storeController.SetPrice)
+	stores.Delete("/:storeId/prices/:productId", storeController.ClearPrice)
+
+	return app
+}
+
+func TestStoreController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStoreService(ctrl)
+	app := setupTestAppStore(mockService)
+
+	price := money.New(18000)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create - success",
+			method: "POST",
+			url:    "/api/stores",
+			body:   strings.NewReader(`{"code":"JKT01","name":"Jakarta Kemang"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), web.StoreCreateRequest{Code: "JKT01", Name: "Jakarta Kemang"}).
+					Return(web.StoreResponse{Id: 2, Code: "JKT01", Name: "Jakarta Kemang", Active: true}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create - duplicate code",
+			method: "POST",
+			url:    "/api/stores",
+			body:   strings.NewReader(`{"code":"MAIN","name":"Main store"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(web.StoreResponse{}, exception.NewConflictError("There already is a store with code MAIN"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:   "Update - success",
+			method: "PUT",
+			url:    "/api/stores/2",
+			body:   strings.NewReader(`{"code":"JKT01","name":"Jakarta Kemang","active":false}`),
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), web.StoreUpdateRequest{Id: 2, Code: "JKT01", Name: "Jakarta Kemang"}).
+					Return(web.StoreResponse{Id: 2, Code: "JKT01"}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find all - success",
+			method: "GET",
+			url:    "/api/stores",
+			setupMock: func() {
+				mockService.EXPECT().FindAll(gomock.Any()).Return([]web.StoreResponse{{Id: 1, Code: "MAIN"}}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find by id - not found",
+			method: "GET",
+			url:    "/api/stores/9",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(9)).Return(web.StoreResponse{}, exception.NewNotFoundError("Store not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Set price - success",
+			method: "PUT",
+			url:    "/api/stores/2/prices/1",
+			body:   strings.NewReader(`{"price":18000}`),
+			setupMock: func() {
+				mockService.EXPECT().SetPrice(gomock.Any(), web.StorePriceRequest{StoreID: 2, ProductID: 1, Price: &price}).
+					Return(web.StoreProductResponse{StoreID: 2, ProductID: 1, PriceOverride: &price, Price: price}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:               "Set price - invalid product id",
+			method:             "PUT",
+			url:                "/api/stores/2/prices/abc",
+			body:               strings.NewReader(`{"price":18000}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Product ID",
+		},
+		{
+			name:   "Clear price - success",
+			method: "DELETE",
+			url:    "/api/stores/2/prices/1",
+			setupMock: func() {
+				mockService.EXPECT().SetPrice(gomock.Any(), web.StorePriceRequest{StoreID: 2, ProductID: 1}).
+					Return(web.StoreProductResponse{StoreID: 2, ProductID: 1}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// storeIdQuery reads the store_id query parameter lists and reports are narrowed to a store with, 0 when it
+// is left out
+func storeIdQuery(c *fiber.Ctx) (uint64, error) {
+	if c.Query("store_id") == "" {
+		return 0, nil
+	}
+	storeId, err := strconv.ParseUint(c.Query("store_id"), 10, 64)
+	if err != nil {
+		return 0, exception.NewBadRequestError("Invalid Store ID")
+	}
+	return storeId, nil
+}
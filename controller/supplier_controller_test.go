@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppSupplier(mockService *mocks.MockSupplierService) *fiber.App {
+	app := fiber.New()
+	supplierController := NewSupplierController(mockService)
+
+	api := app.Group("/api")
+	suppliers := api.Group("/suppliers")
+	suppliers.Get("/", supplierController.FindAll)
+	suppliers.Get("/:supplierId", supplierController.FindById)
+	suppliers.Post("/", supplierController.Create)
+	suppliers.Put("/:supplierId", supplierController.Update)
+	suppliers.Delete("/:supplierId", supplierController.Delete)
+
+	return app
+}
+
+func TestSupplierController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockSupplierService(ctrl)
+	app := setupTestAppSupplier(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create supplier - success",
+			method: "POST",
+			url:    "/api/suppliers",
+			body:   strings.NewReader(`{"name":"PT Sumber Makmur","email":"sales@sumbermakmur.co.id"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.SupplierResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Find supplier - not found",
+			method: "GET",
+			url:    "/api/suppliers/9",
+			setupMock: func() {
+				mockService.EXPECT().FindById(gomock.Any(), uint64(9)).Return(web.SupplierResponse{}, exception.NewNotFoundError("Supplier not found"))
+			},
+			expectedStatus:     http.StatusNotFound,
+			expectedStatusText: "Not Found",
+		},
+		{
+			name:   "Delete supplier - has purchase orders",
+			method: "DELETE",
+			url:    "/api/suppliers/1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(1)).Return(exception.NewConflictError("Supplier still has 2 purchase order(s)"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:               "Update supplier - invalid id",
+			method:             "PUT",
+			url:                "/api/suppliers/abc",
+			body:               strings.NewReader(`{"name":"PT Sumber Makmur"}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Supplier ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type TaxController interface {
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	FindById(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindSettings(c *fiber.Ctx) error
+	UpdateSettings(c *fiber.Ctx) error
+	FindMoneySettings(c *fiber.Ctx) error
+	UpdateMoneySettings(c *fiber.Ctx) error
+}
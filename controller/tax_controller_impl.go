@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/service"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+type TaxControllerImpl struct {
+	TaxService service.TaxService
+}
+
+func NewTaxController(taxService service.TaxService) TaxController {
+	return &TaxControllerImpl{
+		TaxService: taxService,
+	}
+}
+
+// Create Tax class
+func (controller *TaxControllerImpl) Create(c *fiber.Ctx) error {
+	taxCreateRequest := new(web.TaxCreateRequest)
+	if err := c.BodyParser(taxCreateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	taxResponse, err := controller.TaxService.Create(c.Context(), *taxCreateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(web.WebResponse{
+		Code:   fiber.StatusCreated,
+		Status: "Created",
+		Data:   taxResponse,
+	})
+}
+
+// Update Tax class
+func (controller *TaxControllerImpl) Update(c *fiber.Ctx) error {
+	taxUpdateRequest := new(web.TaxUpdateRequest)
+	if err := c.BodyParser(taxUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	id, err := strconv.ParseUint(c.Params("taxId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Tax ID",
+			Data:   err.Error(),
+		})
+	}
+	taxUpdateRequest.Id = id
+
+	taxResponse, err := controller.TaxService.Update(c.Context(), *taxUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   taxResponse,
+	})
+}
+
+// Delete Tax class
+func (controller *TaxControllerImpl) Delete(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("taxId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Tax ID",
+			Data:   err.Error(),
+		})
+	}
+
+	if err := controller.TaxService.Delete(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "Deleted Successfully",
+	})
+}
+
+// Find Tax class By ID
+func (controller *TaxControllerImpl) FindById(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("taxId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Invalid Tax ID",
+			Data:   err.Error(),
+		})
+	}
+
+	taxResponse, err := controller.TaxService.FindById(c.Context(), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   taxResponse,
+	})
+}
+
+// Find All Tax classes
+func (controller *TaxControllerImpl) FindAll(c *fiber.Ctx) error {
+	taxResponses, err := controller.TaxService.FindAll(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   taxResponses,
+	})
+}
+
+// FindSettings - Get the store tax settings
+func (controller *TaxControllerImpl) FindSettings(c *fiber.Ctx) error {
+	settingsResponse, err := controller.TaxService.FindSettings(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
+
+// UpdateSettings - Switch between tax inclusive and tax exclusive prices
+func (controller *TaxControllerImpl) UpdateSettings(c *fiber.Ctx) error {
+	settingsUpdateRequest := new(web.TaxSettingsUpdateRequest)
+	if err := c.BodyParser(settingsUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	settingsResponse, err := controller.TaxService.UpdateSettings(c.Context(), *settingsUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
+
+// FindMoneySettings - Get the store currency and rounding rule
+func (controller *TaxControllerImpl) FindMoneySettings(c *fiber.Ctx) error {
+	settingsResponse, err := controller.TaxService.FindMoneySettings(c.Context())
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
+
+// UpdateMoneySettings - Change the store currency and rounding rule
+func (controller *TaxControllerImpl) UpdateMoneySettings(c *fiber.Ctx) error {
+	settingsUpdateRequest := new(web.MoneySettingsUpdateRequest)
+	if err := c.BodyParser(settingsUpdateRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(web.WebResponse{
+			Code:   fiber.StatusBadRequest,
+			Status: "Bad Request",
+			Data:   err.Error(),
+		})
+	}
+
+	settingsResponse, err := controller.TaxService.UpdateMoneySettings(c.Context(), *settingsUpdateRequest)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(web.WebResponse{
+		Code:   fiber.StatusOK,
+		Status: "OK",
+		Data:   settingsResponse,
+	})
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupTestAppTax(mockService *mocks.MockTaxService) *fiber.App {
+	app := fiber.New()
+	taxController := NewTaxController(mockService)
+
+	api := app.Group("/api")
+	taxes := api.Group("/taxes")
+	taxes.Get("/", taxController.FindAll)
+	taxes.Get("/:taxId", taxController.FindById)
+	taxes.Post("/", taxController.Create)
+	taxes.Put("/:taxId", taxController.Update)
+	taxes.Delete("/:taxId", taxController.Delete)
+	api.Get("/settings/tax", taxController.FindSettings)
+	api.Put("/settings/tax", taxController.UpdateSettings)
+	api.Get("/settings/money", taxController.FindMoneySettings)
+	api.Put("/settings/money", taxController.UpdateMoneySettings)
+
+	return app
+}
+
+func TestTaxController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxService(ctrl)
+	app := setupTestAppTax(mockService)
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		body               io.Reader
+		setupMock          func()
+		expectedStatus     int
+		expectedStatusText string
+	}{
+		{
+			name:   "Create tax - success",
+			method: "POST",
+			url:    "/api/taxes",
+			body:   strings.NewReader(`{"name":"VAT 11%","tax_rate":11,"tax_type":"VAT"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(web.TaxResponse{Id: 1}, nil)
+			},
+			expectedStatus:     http.StatusCreated,
+			expectedStatusText: "Created",
+		},
+		{
+			name:   "Create tax - exempt with a rate",
+			method: "POST",
+			url:    "/api/taxes",
+			body:   strings.NewReader(`{"name":"Exempt","tax_rate":5,"tax_type":"Exempt"}`),
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(web.TaxResponse{}, exception.NewBadRequestError("Exempt tax classes must have a rate of 0"))
+			},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+		{
+			name:   "Delete tax - still assigned",
+			method: "DELETE",
+			url:    "/api/taxes/1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), uint64(1)).Return(exception.NewConflictError("Tax is still assigned to 2 product(s)"))
+			},
+			expectedStatus:     http.StatusConflict,
+			expectedStatusText: "Conflict",
+		},
+		{
+			name:               "Find tax - invalid id",
+			method:             "GET",
+			url:                "/api/taxes/abc",
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Invalid Tax ID",
+		},
+		{
+			name:   "Find settings - success",
+			method: "GET",
+			url:    "/api/settings/tax",
+			setupMock: func() {
+				mockService.EXPECT().FindSettings(gomock.Any()).Return(web.TaxSettingsResponse{}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Update settings - success",
+			method: "PUT",
+			url:    "/api/settings/tax",
+			body:   strings.NewReader(`{"prices_include_tax":true}`),
+			setupMock: func() {
+				mockService.EXPECT().UpdateSettings(gomock.Any(), gomock.Any()).Return(web.TaxSettingsResponse{PricesIncludeTax: true}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Find money settings - success",
+			method: "GET",
+			url:    "/api/settings/money",
+			setupMock: func() {
+				mockService.EXPECT().FindMoneySettings(gomock.Any()).Return(web.MoneySettingsResponse{Currency: "IDR"}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:   "Update money settings - success",
+			method: "PUT",
+			url:    "/api/settings/money",
+			body:   strings.NewReader(`{"currency":"IDR","rounding_mode":"HalfEven","rounding_step":100}`),
+			setupMock: func() {
+				mockService.EXPECT().UpdateMoneySettings(gomock.Any(), web.MoneySettingsUpdateRequest{
+					Currency: "IDR", RoundingMode: "HalfEven", RoundingStep: money.New(100),
+				}).Return(web.MoneySettingsResponse{Currency: "IDR", RoundingMode: "HalfEven", RoundingStep: money.New(100)}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedStatusText: "OK",
+		},
+		{
+			name:               "Update money settings - step with too many decimals",
+			method:             "PUT",
+			url:                "/api/settings/money",
+			body:               strings.NewReader(`{"currency":"IDR","rounding_mode":"HalfUp","rounding_step":0.001}`),
+			setupMock:          func() {},
+			expectedStatus:     http.StatusBadRequest,
+			expectedStatusText: "Bad Request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(tt.method, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+			resp, _ := app.Test(req)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var respBody web.WebResponse
+			json.NewDecoder(resp.Body).Decode(&respBody)
+			assert.Equal(t, tt.expectedStatus, respBody.Code)
+			assert.Equal(t, tt.expectedStatusText, respBody.Status)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package exception
+
+import "github.com/Kahffi/go-rest-api-test/model/web"
+
+type InsufficientStockError struct {
+	Items []web.StockShortageResponse
+}
+
+func (e InsufficientStockError) Error() string {
+	return "Insufficient stock"
+}
+
+func NewInsufficientStockError(items []web.StockShortageResponse) error {
+	return InsufficientStockError{Items: items}
+}
@@ -3,6 +3,7 @@ module github.com/Kahffi/go-rest-api-test
 go 1.23.2
 
 require (
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/go-playground/validator/v10 v10.9.0
 	github.com/go-sql-driver/mysql v1.9.0
 	github.com/gofiber/fiber/v2 v2.52.6
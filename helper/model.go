@@ -3,6 +3,8 @@ package helper
 import (
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
 )
 
 func ToCategoryResponse(category domain.Category) web.CategoryResponse {
@@ -47,6 +49,7 @@ func ToEmployeeResponse(employee domain.Employee) web.EmployeeResponse {
 		Email:     employee.Email,
 		Phone:     employee.Phone,
 		DateHired: employee.DateHired,
+		StoreID:   employee.StoreID,
 	}
 }
 
@@ -58,8 +61,9 @@ func ToEmployeeResponses(emails []domain.Employee) []web.EmployeeResponse {
 	return employeeResponses
 }
 
+// ToProductResponse shows a product with its variants nested, the stock of a parent being that of its variants
 func ToProductResponse(product domain.Product) web.ProductResponse {
-	return web.ProductResponse{
+	productResponse := web.ProductResponse{
 		Id:          product.ProductID,
 		Name:        product.Name,
 		Description: product.Description,
@@ -67,7 +71,43 @@ func ToProductResponse(product domain.Product) web.ProductResponse {
 		StockQty:    product.StockQty,
 		CategoryID:  int(product.CategoryId),
 		SKU:         product.SKU,
-		TaxRate:     product.TaxRate,
+		Barcode:     product.Barcode,
+		TaxID:       product.Tax.TaxID,
+		TaxRate:     product.Tax.TaxRate,
+		ParentID:    product.ParentID,
+	}
+	for _, option := range product.Options {
+		values := make([]string, 0, len(option.Values))
+		for _, value := range option.Values {
+			values = append(values, value.Value)
+		}
+		productResponse.Options = append(productResponse.Options, web.ProductOptionResponse{Name: option.Name, Values: values})
+	}
+	if product.HasVariants() {
+		productResponse.StockQty = 0
+	}
+	for _, variant := range product.Variants {
+		productResponse.Variants = append(productResponse.Variants, ToProductVariantResponse(variant))
+		productResponse.StockQty += variant.StockQty
+	}
+	return productResponse
+}
+
+func ToProductVariantResponse(variant domain.Product) web.ProductVariantResponse {
+	options := make(map[string]string, len(variant.OptionValues))
+	for _, optionValue := range variant.OptionValues {
+		options[optionValue.Option] = optionValue.Value
+	}
+
+	return web.ProductVariantResponse{
+		Id:            variant.ProductID,
+		Name:          variant.Name,
+		Options:       options,
+		SKU:           variant.SKU,
+		Barcode:       variant.Barcode,
+		Price:         variant.Price,
+		PriceOverride: variant.PriceOverride,
+		StockQty:      variant.StockQty,
 	}
 }
 
@@ -78,3 +118,749 @@ func ToProductResponses(products []domain.Product) []web.ProductResponse {
 	}
 	return productResponses
 }
+
+func ToOrderItemResponse(item domain.OrderItem) web.OrderItemResponse {
+	return web.OrderItemResponse{
+		Id:              item.OrderItemID,
+		ProductID:       item.ProductID,
+		ParentID:        item.Product.ParentID,
+		Quantity:        item.Quantity,
+		UnitPrice:       item.UnitPrice,
+		TaxName:         item.TaxName,
+		TaxRate:         item.TaxRate,
+		TaxAmount:       item.TaxAmount,
+		TotalPrice:      item.TotalPrice,
+		DiscountID:      item.DiscountID,
+		DiscountAmount:  item.DiscountAmount,
+		PromotionAmount: item.PromotionAmount,
+		TierDiscount:    item.TierDiscount,
+	}
+}
+
+func ToOrderResponse(order domain.Order) web.OrderResponse {
+	var itemResponses []web.OrderItemResponse
+	for _, item := range order.OrderItems {
+		itemResponses = append(itemResponses, ToOrderItemResponse(item))
+	}
+	var adjustmentResponses []web.OrderAdjustmentResponse
+	for _, adjustment := range order.Adjustments {
+		adjustmentResponses = append(adjustmentResponses, web.OrderAdjustmentResponse{
+			PromotionID:   adjustment.PromotionID,
+			PromotionName: adjustment.PromotionName,
+			ProductID:     adjustment.ProductID,
+			Quantity:      adjustment.Quantity,
+			Amount:        adjustment.Amount,
+			Explanation:   adjustment.Explanation,
+		})
+	}
+	return web.OrderResponse{
+		Id:               order.OrderID,
+		CustomerID:       order.CustomerID,
+		EmployeeID:       order.EmployeeID,
+		StoreID:          order.StoreID,
+		OrderDate:        order.OrderDate,
+		Status:           order.Status,
+		Subtotal:         order.Subtotal,
+		TaxAmount:        order.TaxAmount,
+		Discount:         order.Discount,
+		TotalAmount:      order.TotalAmount,
+		PricesIncludeTax: order.PricesIncludeTax,
+		Currency:         order.Currency,
+		TenderCurrency:   order.TenderCurrency,
+		ExchangeRate:     order.ExchangeRate,
+		TenderTotal:      order.TenderTotal(),
+		LoyaltyTier:      order.LoyaltyTier,
+		AmountPaid:       order.AmountPaid(),
+		BalanceDue:       order.TotalAmount - order.AmountPaid(),
+		PaymentStatus:    order.PaymentStatus(),
+		ChangeDue:        order.ChangeDue(),
+		Items:            itemResponses,
+		Payments:         ToPaymentResponses(order.Payments),
+		Adjustments:      adjustmentResponses,
+	}
+}
+
+func ToOrderResponses(orders []domain.Order) []web.OrderResponse {
+	var orderResponses []web.OrderResponse
+	for _, order := range orders {
+		orderResponses = append(orderResponses, ToOrderResponse(order))
+	}
+	return orderResponses
+}
+
+func ToPaymentResponse(payment domain.Payment) web.PaymentResponse {
+	paymentResponse := web.PaymentResponse{
+		Id:             payment.PaymentID,
+		OrderID:        payment.OrderID,
+		Amount:         payment.Amount,
+		AmountTendered: payment.AmountTendered,
+		ChangeDue:      payment.ChangeDue,
+		PaymentType:    payment.PaymentType,
+		PaymentDate:    payment.PaymentDate,
+		Status:         payment.Status,
+		ShiftID:        payment.ShiftID,
+		RefundShiftID:  payment.RefundShiftID,
+		Currency:       payment.Currency,
+		ExchangeRate:   payment.ExchangeRate,
+		ForeignAmount:  payment.ForeignAmount,
+	}
+	if payment.GiftCard != nil {
+		paymentResponse.GiftCardCode = payment.GiftCard.Code
+	}
+	return paymentResponse
+}
+
+func ToPaymentResponses(payments []domain.Payment) []web.PaymentResponse {
+	var paymentResponses []web.PaymentResponse
+	for _, payment := range payments {
+		paymentResponses = append(paymentResponses, ToPaymentResponse(payment))
+	}
+	return paymentResponses
+}
+
+func ToReceiptResponse(receipt domain.Receipt) web.ReceiptResponse {
+	var itemResponses []web.ReceiptItemResponse
+	for _, item := range receipt.Items {
+		itemResponses = append(itemResponses, web.ReceiptItemResponse{
+			ProductID:       item.ProductID,
+			ProductName:     item.ProductName,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice,
+			TaxRate:         item.TaxRate,
+			TaxAmount:       item.TaxAmount,
+			TotalPrice:      item.TotalPrice,
+			DiscountName:    item.DiscountName,
+			DiscountAmount:  item.DiscountAmount,
+			PromotionName:   item.PromotionName,
+			PromotionAmount: item.PromotionAmount,
+			TierName:        item.TierName,
+			TierDiscount:    item.TierDiscount,
+		})
+	}
+
+	var tenderResponses []web.ReceiptTenderResponse
+	for _, tender := range receipt.Tenders {
+		tenderResponses = append(tenderResponses, web.ReceiptTenderResponse{
+			PaymentID:      tender.PaymentID,
+			PaymentType:    tender.PaymentType,
+			Amount:         tender.Amount,
+			AmountTendered: tender.AmountTendered,
+			ChangeDue:      tender.ChangeDue,
+			Currency:       tender.Currency,
+			ExchangeRate:   tender.ExchangeRate,
+			ForeignAmount:  tender.ForeignAmount,
+		})
+	}
+
+	var taxResponses []web.ReceiptTaxResponse
+	for _, tax := range receipt.TaxLines {
+		taxResponses = append(taxResponses, web.ReceiptTaxResponse{
+			TaxName:       tax.TaxName,
+			TaxRate:       tax.TaxRate,
+			TaxableAmount: tax.TaxableAmount,
+			TaxAmount:     tax.TaxAmount,
+		})
+	}
+
+	return web.ReceiptResponse{
+		Id:               receipt.ReceiptID,
+		OrderID:          receipt.OrderID,
+		ReceiptDate:      receipt.ReceiptDate,
+		TotalAmount:      receipt.TotalAmount,
+		Taxes:            receipt.Taxes,
+		Discount:         receipt.Discount,
+		FinalAmount:      receipt.FinalAmount,
+		ChangeDue:        receipt.ChangeDue,
+		PricesIncludeTax: receipt.PricesIncludeTax,
+		Currency:         receipt.Currency,
+		Items:            itemResponses,
+		TaxLines:         taxResponses,
+		Tenders:          tenderResponses,
+	}
+}
+
+func ToDiscountResponse(discount domain.Discount) web.DiscountResponse {
+	var productIds []uint64
+	for _, product := range discount.Products {
+		productIds = append(productIds, product.ProductID)
+	}
+	return web.DiscountResponse{
+		Id:          discount.DiscountID,
+		Description: discount.Description,
+		DiscountPct: discount.DiscountPct,
+		Scope:       discount.Scope,
+		CategoryID:  discount.CategoryID,
+		ProductIDs:  productIds,
+		ValidFrom:   discount.ValidFrom,
+		ValidUntil:  discount.ValidUntil,
+	}
+}
+
+func ToDiscountResponses(discounts []domain.Discount) []web.DiscountResponse {
+	var discountResponses []web.DiscountResponse
+	for _, discount := range discounts {
+		discountResponses = append(discountResponses, ToDiscountResponse(discount))
+	}
+	return discountResponses
+}
+
+func ToPromotionResponse(promotion domain.Promotion) web.PromotionResponse {
+	var productIds []uint64
+	for _, product := range promotion.Products {
+		productIds = append(productIds, product.ProductID)
+	}
+	return web.PromotionResponse{
+		Id:          promotion.PromotionID,
+		Name:        promotion.Name,
+		Type:        promotion.Type,
+		BuyQty:      promotion.BuyQty,
+		FreeQty:     promotion.FreeQty,
+		BundlePrice: promotion.BundlePrice,
+		MinQty:      promotion.MinQty,
+		DiscountPct: promotion.DiscountPct,
+		ProductIDs:  productIds,
+		ValidFrom:   promotion.ValidFrom,
+		ValidUntil:  promotion.ValidUntil,
+	}
+}
+
+func ToPromotionResponses(promotions []domain.Promotion) []web.PromotionResponse {
+	var promotionResponses []web.PromotionResponse
+	for _, promotion := range promotions {
+		promotionResponses = append(promotionResponses, ToPromotionResponse(promotion))
+	}
+	return promotionResponses
+}
+
+func ToTaxResponse(tax domain.Tax) web.TaxResponse {
+	return web.TaxResponse{
+		Id:          tax.TaxID,
+		Name:        tax.Name,
+		TaxRate:     tax.TaxRate,
+		TaxType:     tax.TaxType,
+		Description: tax.Description,
+	}
+}
+
+func ToTaxResponses(taxes []domain.Tax) []web.TaxResponse {
+	var taxResponses []web.TaxResponse
+	for _, tax := range taxes {
+		taxResponses = append(taxResponses, ToTaxResponse(tax))
+	}
+	return taxResponses
+}
+
+// ToInventoryResponse takes a product with its Inventory and Stores preloaded, a missing record reads as
+// restock level 0. It gives the stock at the store, or over the chain with every store listed when storeId is 0.
+func ToInventoryResponse(product domain.Product, storeId uint64) web.InventoryResponse {
+	inventoryResponse := web.InventoryResponse{
+		ProductID:   product.ProductID,
+		ProductName: product.Name,
+		SKU:         product.SKU,
+		StockQty:    product.StockQty,
+	}
+	if storeId != 0 {
+		inventoryResponse.StoreID = storeId
+		inventoryResponse.StockQty = product.AtStore(storeId).StockQty
+	} else {
+		for _, store := range product.Stores {
+			inventoryResponse.Stores = append(inventoryResponse.Stores, web.StoreStockResponse{
+				StoreID:  store.StoreID,
+				StockQty: store.StockQty,
+			})
+		}
+	}
+	if product.Inventory != nil {
+		inventoryResponse.RestockLevel = product.Inventory.RestockLevel
+		inventoryResponse.LastRestock = product.Inventory.LastRestock
+	}
+	inventoryResponse.LowStock = inventoryResponse.StockQty <= inventoryResponse.RestockLevel
+	return inventoryResponse
+}
+
+func ToInventoryResponses(products []domain.Product, storeId uint64) []web.InventoryResponse {
+	var inventoryResponses []web.InventoryResponse
+	for _, product := range products {
+		inventoryResponses = append(inventoryResponses, ToInventoryResponse(product, storeId))
+	}
+	return inventoryResponses
+}
+
+func ToStockMovementResponse(movement domain.StockMovement) web.StockMovementResponse {
+	return web.StockMovementResponse{
+		Id:         movement.StockMovementID,
+		ProductID:  movement.ProductID,
+		StoreID:    movement.StoreID,
+		Delta:      movement.Delta,
+		Reason:     movement.Reason,
+		Reference:  movement.Reference,
+		EmployeeID: movement.EmployeeID,
+		CreatedAt:  movement.CreatedAt,
+	}
+}
+
+func ToStockMovementResponses(movements []domain.StockMovement) []web.StockMovementResponse {
+	var movementResponses []web.StockMovementResponse
+	for _, movement := range movements {
+		movementResponses = append(movementResponses, ToStockMovementResponse(movement))
+	}
+	return movementResponses
+}
+
+func ToSupplierResponse(supplier domain.Supplier) web.SupplierResponse {
+	return web.SupplierResponse{
+		Id:          supplier.SupplierID,
+		Name:        supplier.Name,
+		ContactName: supplier.ContactName,
+		Email:       supplier.Email,
+		Phone:       supplier.Phone,
+		Address:     supplier.Address,
+	}
+}
+
+func ToSupplierResponses(suppliers []domain.Supplier) []web.SupplierResponse {
+	var supplierResponses []web.SupplierResponse
+	for _, supplier := range suppliers {
+		supplierResponses = append(supplierResponses, ToSupplierResponse(supplier))
+	}
+	return supplierResponses
+}
+
+func ToPurchaseOrderResponse(purchaseOrder domain.PurchaseOrder) web.PurchaseOrderResponse {
+	var lineResponses []web.PurchaseOrderLineResponse
+	for _, line := range purchaseOrder.Lines {
+		lineResponses = append(lineResponses, web.PurchaseOrderLineResponse{
+			Id:              line.PurchaseOrderLineID,
+			ProductID:       line.ProductID,
+			ProductName:     line.Product.Name,
+			OrderedQty:      line.OrderedQty,
+			ReceivedQty:     line.ReceivedQty,
+			OutstandingQty:  line.OutstandingQty(),
+			OverReceivedQty: line.OverReceivedQty(),
+			UnitCost:        line.UnitCost,
+		})
+	}
+
+	return web.PurchaseOrderResponse{
+		Id:           purchaseOrder.PurchaseOrderID,
+		SupplierID:   purchaseOrder.SupplierID,
+		SupplierName: purchaseOrder.Supplier.Name,
+		StoreID:      purchaseOrder.StoreID,
+		Status:       purchaseOrder.Status,
+		Note:         purchaseOrder.Note,
+		CreatedAt:    purchaseOrder.CreatedAt,
+		SentAt:       purchaseOrder.SentAt,
+		ReceivedAt:   purchaseOrder.ReceivedAt,
+		TotalCost:    purchaseOrder.TotalCost(),
+		Lines:        lineResponses,
+	}
+}
+
+func ToPurchaseOrderResponses(purchaseOrders []domain.PurchaseOrder) []web.PurchaseOrderResponse {
+	var purchaseOrderResponses []web.PurchaseOrderResponse
+	for _, purchaseOrder := range purchaseOrders {
+		purchaseOrderResponses = append(purchaseOrderResponses, ToPurchaseOrderResponse(purchaseOrder))
+	}
+	return purchaseOrderResponses
+}
+
+func ToStocktakeLineResponse(line domain.StocktakeLine) web.StocktakeLineResponse {
+	var countResponses []web.StocktakeCountResponse
+	for _, count := range line.Counts {
+		countResponses = append(countResponses, web.StocktakeCountResponse{
+			EmployeeID:   count.EmployeeID,
+			EmployeeName: count.Employee.Name,
+			Quantity:     count.Quantity,
+			CountedAt:    count.CountedAt,
+		})
+	}
+
+	var countedQty *int
+	if counted, ok := line.CountedQty(); ok {
+		countedQty = &counted
+	}
+
+	return web.StocktakeLineResponse{
+		ProductID:     line.ProductID,
+		ProductName:   line.Product.Name,
+		SKU:           line.Product.SKU,
+		ExpectedQty:   line.ExpectedQty,
+		CountedQty:    countedQty,
+		Variance:      line.Variance(),
+		VarianceValue: line.Product.Price.Times(line.Variance()),
+		ReasonCode:    line.ReasonCode,
+		Counts:        countResponses,
+	}
+}
+
+func ToStocktakeResponse(stocktake domain.Stocktake) web.StocktakeResponse {
+	var lineResponses []web.StocktakeLineResponse
+	var countedCount int
+	for _, line := range stocktake.Lines {
+		lineResponses = append(lineResponses, ToStocktakeLineResponse(line))
+		if _, ok := line.CountedQty(); ok {
+			countedCount++
+		}
+	}
+
+	stocktakeResponse := web.StocktakeResponse{
+		Id:            stocktake.StocktakeID,
+		StoreID:       stocktake.StoreID,
+		CategoryID:    stocktake.CategoryID,
+		Status:        stocktake.Status,
+		Note:          stocktake.Note,
+		CreatedAt:     stocktake.CreatedAt,
+		CommittedAt:   stocktake.CommittedAt,
+		CommittedByID: stocktake.CommittedByID,
+		ProductCount:  len(stocktake.Lines),
+		CountedCount:  countedCount,
+		Lines:         lineResponses,
+	}
+	if stocktake.Category != nil {
+		stocktakeResponse.CategoryName = stocktake.Category.Name
+	}
+	if stocktake.CommittedBy != nil {
+		stocktakeResponse.CommittedBy = stocktake.CommittedBy.Name
+	}
+	return stocktakeResponse
+}
+
+func ToStocktakeResponses(stocktakes []domain.Stocktake) []web.StocktakeResponse {
+	var stocktakeResponses []web.StocktakeResponse
+	for _, stocktake := range stocktakes {
+		stocktakeResponses = append(stocktakeResponses, ToStocktakeResponse(stocktake))
+	}
+	return stocktakeResponses
+}
+
+func ToStocktakeVarianceResponse(stocktake domain.Stocktake) web.StocktakeVarianceResponse {
+	varianceResponse := web.StocktakeVarianceResponse{
+		StocktakeID:  stocktake.StocktakeID,
+		Status:       stocktake.Status,
+		ProductCount: len(stocktake.Lines),
+	}
+	for _, line := range stocktake.Lines {
+		lineResponse := ToStocktakeLineResponse(line)
+		if lineResponse.CountedQty == nil {
+			varianceResponse.Uncounted = append(varianceResponse.Uncounted, lineResponse)
+			continue
+		}
+
+		varianceResponse.CountedCount++
+		if lineResponse.Variance != 0 {
+			varianceResponse.TotalVarianceQty += lineResponse.Variance
+			varianceResponse.TotalVarianceValue += lineResponse.VarianceValue
+			varianceResponse.Variances = append(varianceResponse.Variances, lineResponse)
+		}
+	}
+	return varianceResponse
+}
+
+func ToOrderReturnResponse(orderReturn domain.OrderReturn) web.OrderReturnResponse {
+	var lineResponses []web.OrderReturnLineResponse
+	for _, line := range orderReturn.Lines {
+		lineResponses = append(lineResponses, web.OrderReturnLineResponse{
+			OrderItemID:  line.OrderItemID,
+			ProductID:    line.ProductID,
+			ProductName:  line.Product.Name,
+			Quantity:     line.Quantity,
+			Subtotal:     line.Subtotal,
+			Discount:     line.Discount,
+			TaxAmount:    line.TaxAmount,
+			RefundAmount: line.RefundAmount,
+		})
+	}
+
+	return web.OrderReturnResponse{
+		Id:           orderReturn.OrderReturnID,
+		OrderID:      orderReturn.OrderID,
+		EmployeeID:   orderReturn.EmployeeID,
+		Reason:       orderReturn.Reason,
+		Restocked:    orderReturn.Restocked,
+		Subtotal:     orderReturn.Subtotal,
+		Discount:     orderReturn.Discount,
+		TaxAmount:    orderReturn.TaxAmount,
+		RefundAmount: orderReturn.RefundAmount,
+		CreatedAt:    orderReturn.CreatedAt,
+		Refund:       ToPaymentResponse(orderReturn.Payment),
+		Lines:        lineResponses,
+	}
+}
+
+func ToOrderReturnResponses(orderReturns []domain.OrderReturn) []web.OrderReturnResponse {
+	var orderReturnResponses []web.OrderReturnResponse
+	for _, orderReturn := range orderReturns {
+		orderReturnResponses = append(orderReturnResponses, ToOrderReturnResponse(orderReturn))
+	}
+	return orderReturnResponses
+}
+
+func ToLoyaltyRuleResponse(rule domain.LoyaltyRule) web.LoyaltyRuleResponse {
+	ruleResponse := web.LoyaltyRuleResponse{
+		Id:          rule.LoyaltyRuleID,
+		Name:        rule.Name,
+		CategoryID:  rule.CategoryID,
+		SpendAmount: rule.SpendAmount,
+		Points:      rule.Points,
+		Active:      rule.Active,
+	}
+	if rule.Category != nil {
+		ruleResponse.CategoryName = rule.Category.Name
+	}
+	return ruleResponse
+}
+
+func ToLoyaltyRuleResponses(rules []domain.LoyaltyRule) []web.LoyaltyRuleResponse {
+	var ruleResponses []web.LoyaltyRuleResponse
+	for _, rule := range rules {
+		ruleResponses = append(ruleResponses, ToLoyaltyRuleResponse(rule))
+	}
+	return ruleResponses
+}
+
+func ToLoyaltyTransactionResponse(transaction domain.LoyaltyTransaction) web.LoyaltyTransactionResponse {
+	return web.LoyaltyTransactionResponse{
+		Id:        transaction.LoyaltyTransactionID,
+		Type:      transaction.Type,
+		Points:    transaction.Points,
+		OrderID:   transaction.OrderID,
+		PaymentID: transaction.PaymentID,
+		Reference: transaction.Reference,
+		CreatedAt: transaction.CreatedAt,
+	}
+}
+
+func ToLoyaltyTransactionResponses(transactions []domain.LoyaltyTransaction) []web.LoyaltyTransactionResponse {
+	var transactionResponses []web.LoyaltyTransactionResponse
+	for _, transaction := range transactions {
+		transactionResponses = append(transactionResponses, ToLoyaltyTransactionResponse(transaction))
+	}
+	return transactionResponses
+}
+
+func ToLoyaltyTierResponse(tier domain.LoyaltyTier) web.LoyaltyTierResponse {
+	return web.LoyaltyTierResponse{
+		Id:               tier.LoyaltyTierID,
+		Name:             tier.Name,
+		SpendThreshold:   tier.SpendThreshold,
+		PointsThreshold:  tier.PointsThreshold,
+		DiscountPct:      tier.DiscountPct,
+		PointsMultiplier: tier.PointsMultiplier,
+	}
+}
+
+func ToLoyaltyTierResponses(tiers []domain.LoyaltyTier) []web.LoyaltyTierResponse {
+	var tierResponses []web.LoyaltyTierResponse
+	for _, tier := range tiers {
+		tierResponses = append(tierResponses, ToLoyaltyTierResponse(tier))
+	}
+	return tierResponses
+}
+
+func ToGiftCardResponse(card domain.GiftCard) web.GiftCardResponse {
+	return web.GiftCardResponse{
+		Id:         card.GiftCardID,
+		Code:       card.Code,
+		Kind:       card.Kind,
+		CustomerID: card.CustomerID,
+		Balance:    card.Balance,
+		ExpiresAt:  card.ExpiresAt,
+		Expired:    card.IsExpiredAt(time.Now()),
+		CreatedAt:  card.CreatedAt,
+	}
+}
+
+func ToGiftCardResponses(cards []domain.GiftCard) []web.GiftCardResponse {
+	var cardResponses []web.GiftCardResponse
+	for _, card := range cards {
+		cardResponses = append(cardResponses, ToGiftCardResponse(card))
+	}
+	return cardResponses
+}
+
+func ToGiftCardTransactionResponse(transaction domain.GiftCardTransaction) web.GiftCardTransactionResponse {
+	return web.GiftCardTransactionResponse{
+		Id:        transaction.GiftCardTransactionID,
+		Type:      transaction.Type,
+		Amount:    transaction.Amount,
+		OrderID:   transaction.OrderID,
+		PaymentID: transaction.PaymentID,
+		Reference: transaction.Reference,
+		CreatedAt: transaction.CreatedAt,
+	}
+}
+
+func ToGiftCardTransactionResponses(transactions []domain.GiftCardTransaction) []web.GiftCardTransactionResponse {
+	var transactionResponses []web.GiftCardTransactionResponse
+	for _, transaction := range transactions {
+		transactionResponses = append(transactionResponses, ToGiftCardTransactionResponse(transaction))
+	}
+	return transactionResponses
+}
+
+func ToShiftResponse(shift domain.Shift) web.ShiftResponse {
+	shiftResponse := web.ShiftResponse{
+		Id:            shift.ShiftID,
+		EmployeeID:    shift.EmployeeID,
+		EmployeeName:  shift.Employee.Name,
+		StoreID:       shift.StoreID,
+		Register:      shift.Register,
+		Status:        shift.Status,
+		OpeningFloat:  shift.OpeningFloat,
+		Note:          shift.Note,
+		OpenedAt:      shift.OpenedAt,
+		ClosedAt:      shift.ClosedAt,
+		CashMovements: ToCashMovementResponses(shift.CashMovements),
+	}
+	if shift.Status == domain.ShiftStatusClosed {
+		expected, counted, variance := shift.ExpectedCash, shift.CountedCash, shift.CashVariance()
+		shiftResponse.ExpectedCash, shiftResponse.CountedCash, shiftResponse.CashVariance = &expected, &counted, &variance
+	}
+	return shiftResponse
+}
+
+func ToShiftResponses(shifts []domain.Shift) []web.ShiftResponse {
+	var shiftResponses []web.ShiftResponse
+	for _, shift := range shifts {
+		shiftResponses = append(shiftResponses, ToShiftResponse(shift))
+	}
+	return shiftResponses
+}
+
+func ToCashMovementResponse(movement domain.CashMovement) web.CashMovementResponse {
+	return web.CashMovementResponse{
+		Id:        movement.CashMovementID,
+		Type:      movement.Type,
+		Amount:    movement.Amount,
+		Reason:    movement.Reason,
+		CreatedAt: movement.CreatedAt,
+	}
+}
+
+func ToCashMovementResponses(movements []domain.CashMovement) []web.CashMovementResponse {
+	var movementResponses []web.CashMovementResponse
+	for _, movement := range movements {
+		movementResponses = append(movementResponses, ToCashMovementResponse(movement))
+	}
+	return movementResponses
+}
+
+func ToSalesReportRowResponse(row domain.SalesReportRow) web.SalesReportRowResponse {
+	return web.SalesReportRowResponse{
+		Key:        row.GroupKey,
+		Label:      row.Label,
+		OrderCount: row.OrderCount,
+		Quantity:   row.Quantity,
+		GrossSales: row.GrossSales,
+		Discounts:  row.Discounts,
+		TaxAmount:  row.TaxAmount,
+		NetSales:   row.NetSales,
+	}
+}
+
+func ToSalesReportRowResponses(rows []domain.SalesReportRow) []web.SalesReportRowResponse {
+	var rowResponses []web.SalesReportRowResponse
+	for _, row := range rows {
+		rowResponses = append(rowResponses, ToSalesReportRowResponse(row))
+	}
+	return rowResponses
+}
+
+func ToExchangeRateResponse(rate domain.ExchangeRate) web.ExchangeRateResponse {
+	return web.ExchangeRateResponse{
+		Id:            rate.ExchangeRateID,
+		Currency:      rate.Currency,
+		BaseCurrency:  money.Currency(),
+		Rate:          rate.Rate,
+		EffectiveFrom: rate.EffectiveFrom,
+		CreatedAt:     rate.CreatedAt,
+	}
+}
+
+func ToExchangeRateResponses(rates []domain.ExchangeRate) []web.ExchangeRateResponse {
+	var rateResponses []web.ExchangeRateResponse
+	for _, rate := range rates {
+		rateResponses = append(rateResponses, ToExchangeRateResponse(rate))
+	}
+	return rateResponses
+}
+
+func ToStoreResponse(store domain.Store) web.StoreResponse {
+	return web.StoreResponse{
+		Id:        store.StoreID,
+		Code:      store.Code,
+		Name:      store.Name,
+		Address:   store.Address,
+		Phone:     store.Phone,
+		Active:    store.Active,
+		CreatedAt: store.CreatedAt,
+	}
+}
+
+func ToStoreResponses(stores []domain.Store) []web.StoreResponse {
+	var storeResponses []web.StoreResponse
+	for _, store := range stores {
+		storeResponses = append(storeResponses, ToStoreResponse(store))
+	}
+	return storeResponses
+}
+
+// ToStoreProductResponse takes a product with its Stores preloaded and shows it as the store sells it
+func ToStoreProductResponse(product domain.Product, storeId uint64) web.StoreProductResponse {
+	atStore := product.AtStore(storeId)
+	storeProductResponse := web.StoreProductResponse{
+		StoreID:      storeId,
+		ProductID:    product.ProductID,
+		ProductName:  product.Name,
+		SKU:          product.SKU,
+		CatalogPrice: product.Price,
+		Price:        atStore.Price,
+		StockQty:     atStore.StockQty,
+	}
+	for _, store := range product.Stores {
+		if store.StoreID == storeId {
+			storeProductResponse.PriceOverride = store.Price
+		}
+	}
+	return storeProductResponse
+}
+
+func ToStockTransferResponse(transfer domain.StockTransfer) web.StockTransferResponse {
+	var lineResponses []web.StockTransferLineResponse
+	for _, line := range transfer.Lines {
+		lineResponses = append(lineResponses, web.StockTransferLineResponse{
+			Id:              line.StockTransferLineID,
+			ProductID:       line.ProductID,
+			ProductName:     line.Product.Name,
+			SKU:             line.Product.SKU,
+			RequestedQty:    line.RequestedQty,
+			ShippedQty:      line.ShippedQty,
+			InTransitQty:    transfer.InTransitQty(line),
+			ReceivedQty:     line.ReceivedQty,
+			DiscrepancyQty:  transfer.DiscrepancyQty(line),
+			DiscrepancyNote: line.DiscrepancyNote,
+		})
+	}
+
+	return web.StockTransferResponse{
+		Id:            transfer.StockTransferID,
+		FromStoreID:   transfer.FromStoreID,
+		FromStoreName: transfer.FromStore.Name,
+		ToStoreID:     transfer.ToStoreID,
+		ToStoreName:   transfer.ToStore.Name,
+		Status:        transfer.Status,
+		Note:          transfer.Note,
+		CreatedAt:     transfer.CreatedAt,
+		ShippedAt:     transfer.ShippedAt,
+		ReceivedAt:    transfer.ReceivedAt,
+		Lines:         lineResponses,
+	}
+}
+
+func ToStockTransferResponses(transfers []domain.StockTransfer) []web.StockTransferResponse {
+	var transferResponses []web.StockTransferResponse
+	for _, transfer := range transfers {
+		transferResponses = append(transferResponses, ToStockTransferResponse(transfer))
+	}
+	return transferResponses
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"github.com/Kahffi/go-rest-api-test/app"
 	"github.com/Kahffi/go-rest-api-test/controller"
 	"github.com/Kahffi/go-rest-api-test/helper"
@@ -21,34 +22,165 @@ func main() {
 	db := app.NewDB()
 
 	// Run Auto Migration (Opsional, bisa dihapus jika tidak diperlukan)
-	err := db.AutoMigrate(&domain.Category{})
-	err = db.AutoMigrate(&domain.Product{})
+	err := app.MigrateTierThresholds(db)
+	helper.PanicIfError(err)
+	err = app.MigrateMoneyColumns(db, &domain.StoreSetting{}, &domain.Product{}, &domain.Shift{}, &domain.CashMovement{},
+		&domain.LoyaltyRule{}, &domain.LoyaltyTier{}, &domain.LoyaltySetting{}, &domain.Promotion{}, &domain.Order{},
+		&domain.OrderItem{}, &domain.OrderAdjustment{}, &domain.GiftCard{}, &domain.GiftCardTransaction{}, &domain.Payment{},
+		&domain.OrderReturn{}, &domain.OrderReturnLine{}, &domain.PurchaseOrderLine{}, &domain.Receipt{}, &domain.ReceiptItem{},
+		&domain.ReceiptTax{}, &domain.ReceiptTender{})
+	helper.PanicIfError(err)
+	err = db.AutoMigrate(&domain.Category{})
+	err = db.AutoMigrate(&domain.Tax{}, &domain.StoreSetting{}, &domain.ExchangeRate{})
+	err = db.AutoMigrate(&domain.Store{})
+	err = db.AutoMigrate(&domain.Product{}, &domain.StoreProduct{}, &domain.Inventory{}, &domain.StockMovement{})
+	err = db.AutoMigrate(&domain.ProductOption{}, &domain.ProductOptionValue{}, &domain.VariantOptionValue{})
+	err = app.MigrateProductTaxRates(db)
+	helper.PanicIfError(err)
+	err = app.MigrateOpeningStock(db)
+	helper.PanicIfError(err)
 	err = db.AutoMigrate(&domain.Employee{})
-	err = db.AutoMigrate(&domain.Customer{})
+	err = db.AutoMigrate(&domain.Shift{}, &domain.CashMovement{})
+	err = db.AutoMigrate(&domain.LoyaltyTier{}, &domain.Customer{}, &domain.LoyaltyRule{}, &domain.LoyaltySetting{}, &domain.LoyaltyTransaction{})
+	err = app.MigrateOpeningPoints(db)
+	helper.PanicIfError(err)
+	err = db.AutoMigrate(&domain.Discount{})
+	err = db.AutoMigrate(&domain.Promotion{})
+	err = db.AutoMigrate(&domain.Order{}, &domain.OrderItem{}, &domain.OrderAdjustment{})
+	err = db.AutoMigrate(&domain.GiftCard{}, &domain.GiftCardTransaction{})
+	err = db.AutoMigrate(&domain.Payment{})
+	err = db.AutoMigrate(&domain.OrderReturn{}, &domain.OrderReturnLine{})
+	err = db.AutoMigrate(&domain.Supplier{}, &domain.PurchaseOrder{}, &domain.PurchaseOrderLine{})
+	err = db.AutoMigrate(&domain.Stocktake{}, &domain.StocktakeLine{}, &domain.StocktakeCount{})
+	err = db.AutoMigrate(&domain.Receipt{}, &domain.ReceiptItem{}, &domain.ReceiptTax{}, &domain.ReceiptTender{})
+	err = db.AutoMigrate(&domain.StockTransfer{}, &domain.StockTransferLine{})
+	err = app.MigrateDefaultStore(db)
 	helper.PanicIfError(err)
 
 	// Initialize Validator
 	validate := validator.New()
 
 	// Initialize Repository, Service, and Controller
+	txManager := repository.NewTxManager(db)
+
 	categoryRepository := repository.NewCategoryRepository(db)
 	categoryService := service.NewCategoryService(categoryRepository, validate)
 	categoryController := controller.NewCategoryController(categoryService)
 
+	productRepository := repository.NewProductRepository(db)
+
+	storeRepository := repository.NewStoreRepository(db)
+	storeService := service.NewStoreService(storeRepository, productRepository, validate)
+	storeController := controller.NewStoreController(storeService)
+
 	employeeRepository := repository.NewEmployeeRepository(db)
-	employeeService := service.NewEmployeeService(employeeRepository, validate)
+	employeeService := service.NewEmployeeService(employeeRepository, storeService, validate)
 	employeeController := controller.NewEmployeeController(employeeService)
 
-	productRepository := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepository, validate)
+	taxRepository := repository.NewTaxRepository(db)
+	taxService := service.NewTaxService(taxRepository, validate)
+	taxController := controller.NewTaxController(taxService)
+	helper.PanicIfError(taxService.ConfigureMoney(context.Background()))
+
+	exchangeRateRepository := repository.NewExchangeRateRepository(db)
+	exchangeRateService := service.NewExchangeRateService(exchangeRateRepository, validate)
+	exchangeRateController := controller.NewExchangeRateController(exchangeRateService)
+
+	productService := service.NewProductService(productRepository, taxRepository, storeService, exchangeRateService, validate)
 	productController := controller.NewProductController(productService)
 
+	inventoryRepository := repository.NewInventoryRepository(db)
+	stockMovementRepository := repository.NewStockMovementRepository(db)
+	inventoryService := service.NewInventoryService(txManager, inventoryRepository, productRepository, stockMovementRepository,
+		employeeRepository, storeService, validate)
+	inventoryController := controller.NewInventoryController(inventoryService)
+
 	customerRepository := repository.NewCustomerRepository(db)
-	customerService := service.NewCustomerService(customerRepository, validate)
+	loyaltyRepository := repository.NewLoyaltyRepository(db)
+	loyaltyService := service.NewLoyaltyService(loyaltyRepository, customerRepository, categoryRepository, validate)
+	loyaltyController := controller.NewLoyaltyController(loyaltyService)
+
+	customerService := service.NewCustomerService(customerRepository, loyaltyService, validate)
 	customerController := controller.NewCustomerController(customerService)
 
+	discountRepository := repository.NewDiscountRepository(db)
+	discountService := service.NewDiscountService(discountRepository, categoryRepository, productRepository, validate)
+	discountController := controller.NewDiscountController(discountService)
+
+	promotionRepository := repository.NewPromotionRepository(db)
+	promotionService := service.NewPromotionService(promotionRepository, productRepository, validate)
+	promotionController := controller.NewPromotionController(promotionService)
+
+	supplierRepository := repository.NewSupplierRepository(db)
+	supplierService := service.NewSupplierService(supplierRepository, validate)
+	supplierController := controller.NewSupplierController(supplierService)
+
+	purchaseOrderRepository := repository.NewPurchaseOrderRepository(db)
+	purchaseOrderService := service.NewPurchaseOrderService(txManager, purchaseOrderRepository, supplierRepository, productRepository,
+		employeeRepository, storeService, validate)
+	purchaseOrderController := controller.NewPurchaseOrderController(purchaseOrderService)
+
+	stocktakeRepository := repository.NewStocktakeRepository(db)
+	stocktakeService := service.NewStocktakeService(txManager, stocktakeRepository, productRepository, categoryRepository,
+		employeeRepository, storeService, validate)
+	stocktakeController := controller.NewStocktakeController(stocktakeService)
+
+	stockTransferRepository := repository.NewStockTransferRepository(db)
+	stockTransferService := service.NewStockTransferService(txManager, stockTransferRepository, productRepository,
+		employeeRepository, storeService, validate)
+	stockTransferController := controller.NewStockTransferController(stockTransferService)
+
+	orderRepository := repository.NewOrderRepository(db)
+	orderReturnRepository := repository.NewOrderReturnRepository(db)
+	orderService := service.NewOrderService(txManager, orderRepository, orderReturnRepository, productRepository, customerRepository, employeeRepository,
+		storeService, discountService, promotionService, taxService, exchangeRateService, validate)
+	orderController := controller.NewOrderController(orderService)
+
+	receiptRepository := repository.NewReceiptRepository(db)
+	receiptService := service.NewReceiptService(receiptRepository, orderRepository, taxService, app.NewPrinterProfiles(), app.NewStoreHeader())
+	receiptController := controller.NewReceiptController(receiptService)
+
+	invoiceService := service.NewInvoiceService(receiptRepository, orderRepository, customerRepository, taxService, app.NewStoreHeader())
+	invoiceController := controller.NewInvoiceController(invoiceService)
+
+	giftCardRepository := repository.NewGiftCardRepository(db)
+	giftCardService := service.NewGiftCardService(txManager, giftCardRepository, customerRepository, validate)
+	giftCardController := controller.NewGiftCardController(giftCardService)
+
+	shiftRepository := repository.NewShiftRepository(db)
+	shiftService := service.NewShiftService(txManager, shiftRepository, employeeRepository, storeService, validate)
+	shiftController := controller.NewShiftController(shiftService)
+
+	paymentRepository := repository.NewPaymentRepository(db)
+	paymentService := service.NewPaymentService(txManager, paymentRepository, orderRepository, orderReturnRepository,
+		receiptService, loyaltyService, giftCardService, shiftService, exchangeRateService, validate)
+	paymentController := controller.NewPaymentController(paymentService)
+
+	orderReturnService := service.NewOrderReturnService(txManager, orderReturnRepository, orderRepository, paymentRepository,
+		productRepository, employeeRepository, loyaltyService, giftCardService, shiftService, validate)
+	orderReturnController := controller.NewOrderReturnController(orderReturnService)
+
+	reportRepository := repository.NewReportRepository(db)
+	reportService := service.NewReportService(reportRepository, validate)
+	reportController := controller.NewReportController(reportService)
+
 	// Setup Routes
-	app.NewRouter(server, categoryController, customerController, productController, employeeController)
+	app.NewRouter(server, categoryController, customerController, employeeController, productController, orderController,
+		paymentController, receiptController, invoiceController, discountController, promotionController,
+		taxController, inventoryController, supplierController, purchaseOrderController, stocktakeController, orderReturnController,
+		loyaltyController, giftCardController, shiftController, reportController, exchangeRateController,
+		storeController, stockTransferController)
+
+	// Spend and points older than 12 months drop out of the tier review every day, so customers who stop
+	// buying move down without anyone asking
+	go app.RunDaily("loyalty tier review", func(ctx context.Context) error {
+		_, err := loyaltyService.ReviewTiers(ctx)
+		return err
+	})
+	go app.RunDaily("gift card expiry", func(ctx context.Context) error {
+		_, err := giftCardService.ExpireBalances(ctx)
+		return err
+	})
 
 	// Start Server
 	log.Println("Server running on port 8081")
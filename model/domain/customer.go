@@ -6,5 +6,8 @@ type Customer struct {
 	Email      string `gorm:"column:customer_email; type:varchar(255);"`
 	Phone      string `gorm:"column:customer_phone; type:varchar(20);"`
 	Address    string `gorm:"column:customer_address; type:varchar(255);"`
-	LoyaltyPts int    `gorm:"column:loyalty_pts; type:int(11);"`
+	LoyaltyPts int    `gorm:"column:loyalty_pts; type:int(11);"` // kept in step with the loyalty ledger by MovePoints
+	// LoyaltyTierID is set by the tier review, never by hand
+	LoyaltyTierID *uint64      `gorm:"column:loyalty_tier_id;index"`
+	LoyaltyTier   *LoyaltyTier `gorm:"foreignKey:LoyaltyTierID;references:LoyaltyTierID"`
 }
@@ -1,9 +1,43 @@
 package domain
 
+import "time"
+
+const (
+	DiscountScopeOrder    = "Order"
+	DiscountScopeCategory = "Category"
+	DiscountScopeProduct  = "Product"
+)
+
 type Discount struct {
-	DiscountID  string  `json:"discount_id"`
-	Description string  `json:"description"`
-	DiscountPct float64 `json:"discount_pct"` // e.g., 10 for 10%
-	ValidFrom   string  `json:"valid_from"`
-	ValidUntil  string  `json:"valid_until"`
+	DiscountID  uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	Description string    `gorm:"column:description;type:varchar(255)"`
+	DiscountPct float64   `gorm:"column:discount_pct"`           // e.g., 10 for 10%
+	Scope       string    `gorm:"column:scope;type:varchar(20)"` // e.g., Order, Category, Product
+	CategoryID  *uint64   `gorm:"column:category_id"`
+	ValidFrom   time.Time `gorm:"column:valid_from;index"`
+	ValidUntil  time.Time `gorm:"column:valid_until;index"`
+	Products    []Product `gorm:"many2many:discount_products;joinForeignKey:DiscountID;joinReferences:ProductID"`
+}
+
+// IsValidAt reports whether at falls inside the validity window, both ends inclusive
+func (discount Discount) IsValidAt(at time.Time) bool {
+	return !at.Before(discount.ValidFrom) && !at.After(discount.ValidUntil)
+}
+
+// AppliesTo reports whether the discount targets product. A discount set on a parent product reaches
+// every variant of it.
+func (discount Discount) AppliesTo(product Product) bool {
+	switch discount.Scope {
+	case DiscountScopeOrder:
+		return true
+	case DiscountScopeCategory:
+		return discount.CategoryID != nil && *discount.CategoryID == product.CategoryId
+	case DiscountScopeProduct:
+		for _, target := range discount.Products {
+			if target.ProductID == product.ProductID || (product.ParentID != nil && *product.ParentID == target.ProductID) {
+				return true
+			}
+		}
+	}
+	return false
 }
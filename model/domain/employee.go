@@ -1,10 +1,16 @@
 package domain
 
 type Employee struct {
-	EmployeeID uint64 `gorm:"column:id;primary_key"`
-	Name       string `gorm:"column:name"`
-	Role       string `gorm:"column:role"` // e.g., Cashier, Manager
-	Email      string `gorm:"column:email"`
-	Phone      string `gorm:"column:phone"`
-	DateHired  string `gorm:"column:date_hired"`
+	EmployeeID uint64  `gorm:"column:id;primary_key"`
+	Name       string  `gorm:"column:name"`
+	Role       string  `gorm:"column:role"` // e.g., Cashier, Manager
+	Email      string  `gorm:"column:email"`
+	Phone      string  `gorm:"column:phone"`
+	DateHired  string  `gorm:"column:date_hired"`
+	StoreID    *uint64 `gorm:"column:store_id;index"` // nil for staff working for the whole chain
+}
+
+// WorksAt reports whether the employee may work at the store
+func (employee Employee) WorksAt(storeId uint64) bool {
+	return employee.StoreID == nil || *employee.StoreID == storeId
 }
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// ExchangeRate is what one unit of a foreign currency is worth in the store currency from EffectiveFrom
+// on, until a later rate for the same currency takes over. Rates are never edited, a new rate is added.
+type ExchangeRate struct {
+	ExchangeRateID uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	Currency       string    `gorm:"column:currency;type:varchar(3);index"` // ISO code, e.g. USD, SGD
+	Rate           float64   `gorm:"column:rate"`                           // store currency units per unit of Currency
+	EffectiveFrom  time.Time `gorm:"column:effective_from;index"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	GiftCardKindGiftCard    = "GiftCard"
+	GiftCardKindStoreCredit = "StoreCredit"
+)
+
+const (
+	GiftCardTypeIssue  = "Issue"
+	GiftCardTypeTopUp  = "TopUp"
+	GiftCardTypeRedeem = "Redeem"
+	GiftCardTypeRefund = "Refund"
+	GiftCardTypeExpire = "Expire"
+)
+
+var ErrGiftCardTransactionImmutable = errors.New("gift card transactions cannot be changed once recorded")
+
+// GiftCard is a stored-value account, either a gift card sold over the counter or store credit issued for
+// a return. Balance is kept in step with the gift card ledger by MoveBalance.
+type GiftCard struct {
+	GiftCardID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	Code       string      `gorm:"column:code;type:varchar(20);uniqueIndex"`
+	Kind       string      `gorm:"column:kind;type:varchar(20)"` // e.g., GiftCard, StoreCredit
+	CustomerID *uint64     `gorm:"column:customer_id;index"`
+	Balance    money.Money `gorm:"column:balance"`
+	ExpiresAt  *time.Time  `gorm:"column:expires_at"` // never expires when nil
+	CreatedAt  time.Time   `gorm:"column:created_at"`
+}
+
+// IsExpiredAt reports whether the card can no longer be used at the given time
+func (card GiftCard) IsExpiredAt(at time.Time) bool {
+	return card.ExpiresAt != nil && !at.Before(*card.ExpiresAt)
+}
+
+// GiftCardTransaction is one change to the balance of a gift card. The ledger is append only, so the
+// balance of a card always equals the sum of its transactions.
+type GiftCardTransaction struct {
+	GiftCardTransactionID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	GiftCardID            uint64      `gorm:"column:gift_card_id;not null;index"`
+	Type                  string      `gorm:"column:type;type:varchar(20)"` // e.g., Issue, TopUp, Redeem, Refund, Expire
+	Amount                money.Money `gorm:"column:amount"`                // negative when money goes out
+	OrderID               *uint64     `gorm:"column:order_id;index"`
+	PaymentID             *uint64     `gorm:"column:payment_id"`
+	Reference             string      `gorm:"column:reference;type:varchar(100)"`
+	CreatedAt             time.Time   `gorm:"column:created_at"`
+}
+
+func (transaction *GiftCardTransaction) BeforeUpdate(tx *gorm.DB) error {
+	return ErrGiftCardTransactionImmutable
+}
+
+func (transaction *GiftCardTransaction) BeforeDelete(tx *gorm.DB) error {
+	return ErrGiftCardTransactionImmutable
+}
@@ -1,8 +1,12 @@
 package domain
 
+import "time"
+
+// Inventory holds the restocking details of a product. The stock itself stays on Product.StockQty, the
+// single place sales, cancellations and restocks change it.
 type Inventory struct {
-	ProductID    string `json:"product_id"`
-	StockQty     int    `json:"stock_qty"`
-	RestockLevel int    `json:"restock_level"`
-	LastRestock  string `json:"last_restock"`
+	InventoryID  uint64     `gorm:"primary_key;column:id;autoIncrement"`
+	ProductID    uint64     `gorm:"column:product_id;uniqueIndex"`
+	RestockLevel int        `gorm:"column:restock_level"` // at or below this stock the product is reordered
+	LastRestock  *time.Time `gorm:"column:last_restock"`
 }
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	LoyaltyTypeEarn       = "Earn"
+	LoyaltyTypeRedeem     = "Redeem"
+	LoyaltyTypeClawback   = "Clawback" // earned points taken back when the order is refunded
+	LoyaltyTypeRefund     = "Refund"   // redeemed points given back when the points tender is refunded
+	LoyaltyTypeAdjustment = "Adjustment"
+)
+
+const (
+	TierBasisSpend  = "Spend"
+	TierBasisPoints = "Points"
+)
+
+var ErrLoyaltyTransactionImmutable = errors.New("loyalty transactions cannot be changed once recorded")
+
+// LoyaltyRule earns Points for every full SpendAmount spent on products of CategoryID. The rule without a
+// category covers every product no category rule covers.
+type LoyaltyRule struct {
+	LoyaltyRuleID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	Name          string      `gorm:"column:name;type:varchar(100)"`
+	CategoryID    *uint64     `gorm:"column:category_id;uniqueIndex"`
+	SpendAmount   money.Money `gorm:"column:spend_amount"`
+	Points        int         `gorm:"column:points"`
+	Active        bool        `gorm:"column:active"`
+	Category      *Category   `gorm:"foreignKey:CategoryID;references:Id"`
+}
+
+// LoyaltyTier is held by customers whose spend or earned points over the last 12 months, whichever the
+// settings use, reach SpendThreshold or PointsThreshold. The highest tier reached wins.
+type LoyaltyTier struct {
+	LoyaltyTierID    uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	Name             string      `gorm:"column:name;type:varchar(50);uniqueIndex"`
+	SpendThreshold   money.Money `gorm:"column:threshold"`
+	PointsThreshold  int         `gorm:"column:points_threshold"`
+	DiscountPct      float64     `gorm:"column:discount_pct"`      // taken off every line after the other discounts
+	PointsMultiplier float64     `gorm:"column:points_multiplier"` // applied to the points an order earns, 1 changes nothing
+}
+
+// ThresholdOn is what the tier takes on basis, in minor units of spend or in points
+func (tier LoyaltyTier) ThresholdOn(basis string) int64 {
+	if basis == TierBasisPoints {
+		return int64(tier.PointsThreshold)
+	}
+	return int64(tier.SpendThreshold)
+}
+
+// LoyaltySetting holds the loyalty program configuration, there is only ever one row
+type LoyaltySetting struct {
+	LoyaltySettingID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	PointValue       money.Money `gorm:"column:point_value"`                               // what one point pays when redeemed, redemption is off at 0
+	TierBasis        string      `gorm:"column:tier_basis;type:varchar(10);default:Spend"` // e.g., Spend, Points
+}
+
+// LoyaltyTransaction is one change to the points of a customer. The ledger is append only, so the
+// points of a customer always equal the sum of their transactions.
+type LoyaltyTransaction struct {
+	LoyaltyTransactionID uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	CustomerID           uint64    `gorm:"column:customer_id;not null;index"`
+	Type                 string    `gorm:"column:type;type:varchar(20)"` // e.g., Earn, Redeem, Clawback, Refund
+	Points               int       `gorm:"column:points"`                // negative when points go out
+	OrderID              *uint64   `gorm:"column:order_id;index"`
+	PaymentID            *uint64   `gorm:"column:payment_id"`
+	Reference            string    `gorm:"column:reference;type:varchar(100)"`
+	CreatedAt            time.Time `gorm:"column:created_at"`
+}
+
+func (transaction *LoyaltyTransaction) BeforeUpdate(tx *gorm.DB) error {
+	return ErrLoyaltyTransactionImmutable
+}
+
+func (transaction *LoyaltyTransaction) BeforeDelete(tx *gorm.DB) error {
+	return ErrLoyaltyTransactionImmutable
+}
@@ -1,16 +1,117 @@
 package domain
 
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+const (
+	OrderStatusOpen      = "Open"
+	OrderStatusPlaced    = "Placed"
+	OrderStatusCancelled = "Cancelled"
+)
+
+const (
+	OrderPaymentUnpaid        = "Unpaid"
+	OrderPaymentPartiallyPaid = "Partially Paid"
+	OrderPaymentPaid          = "Paid"
+)
+
 type Order struct {
-	OrderID     string      `json:"order_id"`
-	CustomerID  string      `json:"customer_id"`
-	OrderDate   string      `json:"order_date"`
-	TotalAmount float64     `json:"total_amount"`
-	OrderItems  []OrderItem `json:"order_items"`
+	OrderID          uint64            `gorm:"primary_key;column:id;autoIncrement"`
+	CustomerID       uint64            `gorm:"column:customer_id;not null"`
+	StoreID          uint64            `gorm:"column:store_id;index"`    // store that sells and ships the stock
+	EmployeeID       *uint64           `gorm:"column:employee_id;index"` // employee who rang up the order
+	OrderDate        time.Time         `gorm:"column:order_date"`
+	Status           string            `gorm:"column:status;type:varchar(20)"` // e.g., Open, Placed, Cancelled
+	Subtotal         money.Money       `gorm:"column:subtotal"`
+	TaxAmount        money.Money       `gorm:"column:tax_amount"`
+	Discount         money.Money       `gorm:"column:discount"`     // line discounts, promotion adjustments and tier discounts
+	TotalAmount      money.Money       `gorm:"column:total_amount"` // Subtotal - Discount, plus TaxAmount when prices exclude tax
+	PricesIncludeTax bool              `gorm:"column:prices_include_tax"`
+	Currency         string            `gorm:"column:currency;type:varchar(3)"`        // store currency when the order was priced
+	TenderCurrency   string            `gorm:"column:tender_currency;type:varchar(3)"` // foreign currency the customer pays in, empty for the store currency
+	ExchangeRate     float64           `gorm:"column:exchange_rate"`                   // rate of TenderCurrency when the order was priced
+	LoyaltyTier      string            `gorm:"column:loyalty_tier;type:varchar(50)"`   // tier of the customer when the order was priced
+	Customer         Customer          `gorm:"foreignKey:CustomerID;references:CustomerID"`
+	OrderItems       []OrderItem       `gorm:"foreignKey:OrderID;references:OrderID"`
+	Payments         []Payment         `gorm:"foreignKey:OrderID;references:OrderID"`
+	Adjustments      []OrderAdjustment `gorm:"foreignKey:OrderID;references:OrderID"`
+}
+
+// TenderTotal is the order total in the tender currency at the rate the order was priced with
+func (order Order) TenderTotal() money.Money {
+	if order.TenderCurrency == "" {
+		return 0
+	}
+	return order.TotalAmount.FromBase(order.ExchangeRate)
+}
+
+// AmountPaid sums the completed payments of the order
+func (order Order) AmountPaid() money.Money {
+	var paid money.Money
+	for _, payment := range order.Payments {
+		if payment.Status == PaymentStatusCompleted {
+			paid += payment.Amount
+		}
+	}
+	return paid
+}
+
+// AmountCommitted sums the payments that still count towards the order, pending ones included
+func (order Order) AmountCommitted() money.Money {
+	var committed money.Money
+	for _, payment := range order.Payments {
+		if payment.Status == PaymentStatusCompleted || payment.Status == PaymentStatusPending {
+			committed += payment.Amount
+		}
+	}
+	return committed
+}
+
+// ChangeDue sums the change handed back on the completed cash tenders of the order
+func (order Order) ChangeDue() money.Money {
+	var change money.Money
+	for _, payment := range order.Payments {
+		if payment.Status == PaymentStatusCompleted {
+			change += payment.ChangeDue
+		}
+	}
+	return change
+}
+
+// PaymentStatus derives Unpaid, Partially Paid or Paid from the completed payments
+func (order Order) PaymentStatus() string {
+	paid := order.AmountPaid()
+	switch {
+	case paid <= 0:
+		return OrderPaymentUnpaid
+	case paid < order.TotalAmount:
+		return OrderPaymentPartiallyPaid
+	default:
+		return OrderPaymentPaid
+	}
 }
 
 type OrderItem struct {
-	ProductID  string  `json:"product_id"`
-	Quantity   int     `json:"quantity"`
-	UnitPrice  float64 `json:"unit_price"`
-	TotalPrice float64 `json:"total_price"`
+	OrderItemID     uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	OrderID         uint64      `gorm:"column:order_id;not null"`
+	ProductID       uint64      `gorm:"column:product_id;not null"`
+	Quantity        int         `gorm:"column:quantity"`
+	UnitPrice       money.Money `gorm:"column:unit_price"`
+	TaxName         string      `gorm:"column:tax_name;type:varchar(100)"`
+	TaxRate         float64     `gorm:"column:tax_rate"`
+	TaxAmount       money.Money `gorm:"column:tax_amount"`
+	TotalPrice      money.Money `gorm:"column:total_price"` // UnitPrice * Quantity
+	DiscountID      *uint64     `gorm:"column:discount_id"`
+	DiscountAmount  money.Money `gorm:"column:discount_amount"`  // taken off TotalPrice before tax
+	PromotionAmount money.Money `gorm:"column:promotion_amount"` // sum of the promotion adjustments on this line
+	TierDiscount    money.Money `gorm:"column:tier_discount"`    // the loyalty tier discount, taken after the other reductions
+	Product         Product     `gorm:"foreignKey:ProductID;references:ProductID"`
+	Discount        *Discount   `gorm:"foreignKey:DiscountID;references:DiscountID"`
+}
+
+// Reductions sums everything taken off TotalPrice before tax: the discount, promotions and the tier discount
+func (item OrderItem) Reductions() money.Money {
+	return item.DiscountAmount + item.PromotionAmount + item.TierDiscount
 }
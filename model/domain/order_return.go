@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// OrderReturn takes units of a placed order back and refunds them through PaymentID, a payment in
+// Refunded state. Amounts are the returned share of the order lines, discounts and tax included.
+type OrderReturn struct {
+	OrderReturnID uint64            `gorm:"primary_key;column:id;autoIncrement"`
+	OrderID       uint64            `gorm:"column:order_id;not null;index"`
+	PaymentID     uint64            `gorm:"column:payment_id;not null"`
+	EmployeeID    *uint64           `gorm:"column:employee_id"`
+	Reason        string            `gorm:"column:reason;type:varchar(255)"`
+	Restocked     bool              `gorm:"column:restocked"`
+	Subtotal      money.Money       `gorm:"column:subtotal"`
+	Discount      money.Money       `gorm:"column:discount"`
+	TaxAmount     money.Money       `gorm:"column:tax_amount"`
+	RefundAmount  money.Money       `gorm:"column:refund_amount"` // Subtotal - Discount, plus TaxAmount when prices exclude tax
+	CreatedAt     time.Time         `gorm:"column:created_at"`
+	Payment       Payment           `gorm:"foreignKey:PaymentID;references:PaymentID"`
+	Lines         []OrderReturnLine `gorm:"foreignKey:OrderReturnID;references:OrderReturnID"`
+}
+
+type OrderReturnLine struct {
+	OrderReturnLineID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	OrderReturnID     uint64      `gorm:"column:order_return_id;not null;index"`
+	OrderItemID       uint64      `gorm:"column:order_item_id;not null;index"`
+	ProductID         uint64      `gorm:"column:product_id;not null"`
+	Quantity          int         `gorm:"column:quantity"`
+	Subtotal          money.Money `gorm:"column:subtotal"`
+	Discount          money.Money `gorm:"column:discount"` // returned share of the line discount and promotions
+	TaxAmount         money.Money `gorm:"column:tax_amount"`
+	RefundAmount      money.Money `gorm:"column:refund_amount"`
+	Product           Product     `gorm:"foreignKey:ProductID;references:ProductID"`
+}
@@ -1,10 +1,62 @@
 package domain
 
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+const (
+	PaymentStatusPending   = "Pending"
+	PaymentStatusCompleted = "Completed"
+	PaymentStatusRefunded  = "Refunded"
+	PaymentStatusVoided    = "Voided"
+)
+
+const (
+	PaymentTypeCash        = "Cash"
+	PaymentTypeCard        = "Card"
+	PaymentTypeQRIS        = "QRIS"
+	PaymentTypeOnline      = "Online"
+	PaymentTypePoints      = "Points" // loyalty points redeemed as tender
+	PaymentTypeGiftCard    = "GiftCard"
+	PaymentTypeStoreCredit = "StoreCredit"
+)
+
+// paymentTransitions lists the statuses each payment status may move to
+var paymentTransitions = map[string][]string{
+	PaymentStatusPending:   {PaymentStatusCompleted, PaymentStatusVoided},
+	PaymentStatusCompleted: {PaymentStatusRefunded, PaymentStatusVoided},
+}
+
 type Payment struct {
-	PaymentID   string  `json:"payment_id"`
-	OrderID     string  `json:"order_id"`
-	Amount      float64 `json:"amount"`
-	PaymentType string  `json:"payment_type"` // e.g., Cash, Card, Online
-	PaymentDate string  `json:"payment_date"`
-	Status      string  `json:"status"` // e.g., Completed, Pending
+	PaymentID      uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	OrderID        uint64      `gorm:"column:order_id;not null;index"`
+	Amount         money.Money `gorm:"column:amount"` // part of the order total settled by this tender
+	AmountTendered money.Money `gorm:"column:amount_tendered"`
+	ChangeDue      money.Money `gorm:"column:change_due"`                    // only ever non-zero for cash
+	PaymentType    string      `gorm:"column:payment_type;type:varchar(20)"` // e.g., Cash, Card, QRIS, Online, Points, GiftCard
+	PaymentDate    time.Time   `gorm:"column:payment_date"`
+	Status         string      `gorm:"column:status;type:varchar(20)"` // e.g., Pending, Completed, Refunded, Voided
+	GiftCardID     *uint64     `gorm:"column:gift_card_id;index"`      // card paid from, or credited for a store credit refund
+	GiftCard       *GiftCard   `gorm:"foreignKey:GiftCardID;references:GiftCardID"`
+	ShiftID        *uint64     `gorm:"column:shift_id;index"`           // register shift that took or paid out the money
+	RefundShiftID  *uint64     `gorm:"column:refund_shift_id;index"`    // register shift that paid the refund of the payment out
+	Currency       string      `gorm:"column:currency;type:varchar(3)"` // foreign currency tendered, empty for the store currency
+	ExchangeRate   float64     `gorm:"column:exchange_rate"`            // rate the foreign tender was taken at
+	ForeignAmount  money.Money `gorm:"column:foreign_amount"`           // amount handed over in Currency, AmountTendered is its value
+}
+
+// UsesGiftCard reports whether the payment is paid from, or refunded to, a gift card or store credit
+func (payment Payment) UsesGiftCard() bool {
+	return payment.PaymentType == PaymentTypeGiftCard || payment.PaymentType == PaymentTypeStoreCredit
+}
+
+// CanTransitionTo reports whether the payment may move from its current status to status
+func (payment Payment) CanTransitionTo(status string) bool {
+	for _, next := range paymentTransitions[payment.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
 }
@@ -1,15 +1,68 @@
 package domain
 
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"strings"
+)
+
+// Product is something the chain sells. A product with options does not hold stock itself, it is the parent
+// of one variant per combination of option values, and every variant is a product of its own with its own SKU,
+// barcode, price and stock.
 type Product struct {
-	ProductID   uint64   `gorm:"primaryKey;column:id"`
-	Name        string   `gorm:"column:product_name; length:255"`
-	Description string   `gorm:"column:product_description; length:255"`
-	Price       float64  `gorm:"column:product_price"`
-	StockQty    int      `gorm:"column:stock_qty"`
-	CategoryId  uint64   `gorm:"column:category_id"`
-	SKU         string   `gorm:"column:product_sku"`
-	TaxRate     float64  `gorm:"column:tax_rate"`
-	Category    Category `gorm:"foreignKey:CategoryId;references:Id"`
+	ProductID     uint64               `gorm:"primaryKey;column:id"`
+	ParentID      *uint64              `gorm:"column:parent_id;index"` // the product this is a variant of, nil for a product of its own
+	Name          string               `gorm:"column:product_name; length:255"`
+	Description   string               `gorm:"column:product_description; length:255"`
+	Price         money.Money          `gorm:"column:product_price"`
+	PriceOverride *money.Money         `gorm:"column:price_override"` // of a variant, nil when it sells at the price of its parent
+	StockQty      int                  `gorm:"column:stock_qty"`      // over every store, the sum of Stores
+	CategoryId    uint64               `gorm:"column:category_id"`
+	SKU           string               `gorm:"column:product_sku"`
+	Barcode       string               `gorm:"column:barcode;type:varchar(64);index"`
+	TaxID         *uint64              `gorm:"column:tax_id"`
+	Category      Category             `gorm:"foreignKey:CategoryId;references:Id"`
+	Tax           Tax                  `gorm:"foreignKey:TaxID;references:TaxID"`
+	Inventory     *Inventory           `gorm:"foreignKey:ProductID;references:ProductID;constraint:OnDelete:CASCADE"`
+	Stores        []StoreProduct       `gorm:"foreignKey:ProductID;references:ProductID;constraint:OnDelete:CASCADE"`
+	Options       []ProductOption      `gorm:"foreignKey:ProductID;references:ProductID;constraint:OnDelete:CASCADE"`
+	Variants      []Product            `gorm:"foreignKey:ParentID;references:ProductID;constraint:OnDelete:CASCADE"`
+	OptionValues  []VariantOptionValue `gorm:"foreignKey:ProductID;references:ProductID;constraint:OnDelete:CASCADE"` // of a variant, its value on every option
+}
+
+// HasVariants tells a parent product, which is sold through its variants only
+func (product Product) HasVariants() bool {
+	return len(product.Options) > 0
+}
+
+// VariantLabel names the option values of a variant, e.g. "M / Red"
+func (product Product) VariantLabel() string {
+	values := make([]string, 0, len(product.OptionValues))
+	for _, optionValue := range product.OptionValues {
+		values = append(values, optionValue.Value)
+	}
+	return strings.Join(values, " / ")
+}
+
+// AtStore is the product as one store sees it, with the stock the store holds and the price it sells at
+func (product Product) AtStore(storeId uint64) Product {
+	atStore := product
+	atStore.StockQty = 0
+	for _, store := range product.Stores {
+		if store.StoreID != storeId {
+			continue
+		}
+		atStore.StockQty = store.StockQty
+		if store.Price != nil {
+			atStore.Price = *store.Price
+		}
+	}
+	if len(product.Variants) > 0 {
+		atStore.Variants = make([]Product, len(product.Variants))
+		for i, variant := range product.Variants {
+			atStore.Variants[i] = variant.AtStore(storeId)
+		}
+	}
+	return atStore
 }
 
 type ProductError struct {
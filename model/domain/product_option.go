@@ -0,0 +1,23 @@
+package domain
+
+// ProductOption is an axis the variants of a product differ on, e.g. Size with the values S, M and L
+type ProductOption struct {
+	ProductOptionID uint64               `gorm:"primary_key;column:id;autoIncrement"`
+	ProductID       uint64               `gorm:"column:product_id;not null;index"`
+	Name            string               `gorm:"column:name;type:varchar(50)"`
+	Values          []ProductOptionValue `gorm:"foreignKey:ProductOptionID;references:ProductOptionID;constraint:OnDelete:CASCADE"`
+}
+
+type ProductOptionValue struct {
+	ProductOptionValueID uint64 `gorm:"primary_key;column:id;autoIncrement"`
+	ProductOptionID      uint64 `gorm:"column:product_option_id;not null;index"`
+	Value                string `gorm:"column:value;type:varchar(50)"`
+}
+
+// VariantOptionValue is the value a variant has on one option of its parent, e.g. M for Size
+type VariantOptionValue struct {
+	VariantOptionValueID uint64 `gorm:"primary_key;column:id;autoIncrement"`
+	ProductID            uint64 `gorm:"column:product_id;not null;uniqueIndex:idx_variant_option"` // the variant
+	Option               string `gorm:"column:option_name;type:varchar(50);uniqueIndex:idx_variant_option"`
+	Value                string `gorm:"column:value;type:varchar(50)"`
+}
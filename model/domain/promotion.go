@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+const (
+	PromotionTypeBuyXGetY      = "BuyXGetY"
+	PromotionTypeBundle        = "Bundle"
+	PromotionTypeQuantityBreak = "QuantityBreak"
+)
+
+// Promotion is a rule evaluated against the order lines at checkout. Which fields matter depends on Type:
+// BuyXGetY uses BuyQty and FreeQty, Bundle sells one of each product for BundlePrice and QuantityBreak
+// takes DiscountPct off a line once it reaches MinQty.
+type Promotion struct {
+	PromotionID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	Name        string      `gorm:"column:name;type:varchar(255)"`
+	Type        string      `gorm:"column:type;type:varchar(20)"` // e.g., BuyXGetY, Bundle, QuantityBreak
+	BuyQty      int         `gorm:"column:buy_qty"`
+	FreeQty     int         `gorm:"column:free_qty"`
+	BundlePrice money.Money `gorm:"column:bundle_price"`
+	MinQty      int         `gorm:"column:min_qty"`
+	DiscountPct float64     `gorm:"column:discount_pct"`
+	ValidFrom   time.Time   `gorm:"column:valid_from;index"`
+	ValidUntil  time.Time   `gorm:"column:valid_until;index"`
+	Products    []Product   `gorm:"many2many:promotion_products;joinForeignKey:PromotionID;joinReferences:ProductID"`
+}
+
+// OrderAdjustment is the part of a promotion's saving that lands on one order line
+type OrderAdjustment struct {
+	OrderAdjustmentID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	OrderID           uint64      `gorm:"column:order_id;not null;index"`
+	ProductID         uint64      `gorm:"column:product_id;not null"`
+	PromotionID       uint64      `gorm:"column:promotion_id;not null"`
+	PromotionName     string      `gorm:"column:promotion_name;type:varchar(255)"`
+	Quantity          int         `gorm:"column:quantity"` // units of the line the promotion used
+	Amount            money.Money `gorm:"column:amount"`
+	Explanation       string      `gorm:"column:explanation;type:varchar(255)"`
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+const (
+	PurchaseOrderStatusDraft             = "Draft"
+	PurchaseOrderStatusSent              = "Sent"
+	PurchaseOrderStatusPartiallyReceived = "Partially Received"
+	PurchaseOrderStatusReceived          = "Received"
+)
+
+// PurchaseOrder is stock ordered from a supplier. Lines can only change while it is a draft, once sent
+// the only thing that happens to it is goods arriving.
+type PurchaseOrder struct {
+	PurchaseOrderID uint64              `gorm:"primary_key;column:id;autoIncrement"`
+	SupplierID      uint64              `gorm:"column:supplier_id;not null;index"`
+	StoreID         uint64              `gorm:"column:store_id;index"`          // store the goods are delivered to
+	Status          string              `gorm:"column:status;type:varchar(20)"` // e.g., Draft, Sent, Partially Received, Received
+	Note            string              `gorm:"column:note;type:varchar(255)"`
+	CreatedAt       time.Time           `gorm:"column:created_at"`
+	SentAt          *time.Time          `gorm:"column:sent_at"`
+	ReceivedAt      *time.Time          `gorm:"column:received_at"` // when the order was closed as received
+	Supplier        Supplier            `gorm:"foreignKey:SupplierID;references:SupplierID"`
+	Lines           []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID;references:PurchaseOrderID"`
+}
+
+// TotalCost is what the ordered quantities cost
+func (purchaseOrder PurchaseOrder) TotalCost() money.Money {
+	var total money.Money
+	for _, line := range purchaseOrder.Lines {
+		total += line.UnitCost.Times(line.OrderedQty)
+	}
+	return total
+}
+
+// FullyReceived reports whether every line has received at least what was ordered
+func (purchaseOrder PurchaseOrder) FullyReceived() bool {
+	for _, line := range purchaseOrder.Lines {
+		if line.ReceivedQty < line.OrderedQty {
+			return false
+		}
+	}
+	return true
+}
+
+type PurchaseOrderLine struct {
+	PurchaseOrderLineID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	PurchaseOrderID     uint64      `gorm:"column:purchase_order_id;not null;index"`
+	ProductID           uint64      `gorm:"column:product_id;not null"`
+	OrderedQty          int         `gorm:"column:ordered_qty"`
+	ReceivedQty         int         `gorm:"column:received_qty"` // summed over every delivery, may exceed OrderedQty
+	UnitCost            money.Money `gorm:"column:unit_cost"`
+	Product             Product     `gorm:"foreignKey:ProductID;references:ProductID"`
+}
+
+// OutstandingQty is what is still expected for the line
+func (line PurchaseOrderLine) OutstandingQty() int {
+	if line.ReceivedQty >= line.OrderedQty {
+		return 0
+	}
+	return line.OrderedQty - line.ReceivedQty
+}
+
+// OverReceivedQty is what arrived on top of the ordered quantity
+func (line PurchaseOrderLine) OverReceivedQty() int {
+	if line.ReceivedQty <= line.OrderedQty {
+		return 0
+	}
+	return line.ReceivedQty - line.OrderedQty
+}
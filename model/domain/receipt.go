@@ -1,12 +1,102 @@
 package domain
 
+import (
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+var ErrReceiptImmutable = errors.New("receipts cannot be changed once issued")
+
+// Receipt is a snapshot of a fully paid order. Lines and tenders are copied so later changes to
+// products or payments never alter a receipt that was already handed to the customer.
 type Receipt struct {
-	ReceiptID   string  `json:"receipt_id"`
-	OrderID     string  `json:"order_id"`
-	PaymentID   string  `json:"payment_id"`
-	ReceiptDate string  `json:"receipt_date"`
-	TotalAmount float64 `json:"total_amount"`
-	Taxes       float64 `json:"taxes"`
-	Discount    float64 `json:"discount"`
-	FinalAmount float64 `json:"final_amount"`
+	ReceiptID        uint64          `gorm:"primary_key;column:id;autoIncrement"`
+	OrderID          uint64          `gorm:"column:order_id;not null;uniqueIndex"`
+	ReceiptDate      time.Time       `gorm:"column:receipt_date"`
+	TotalAmount      money.Money     `gorm:"column:total_amount"` // subtotal before tax and discount
+	Taxes            money.Money     `gorm:"column:taxes"`
+	Discount         money.Money     `gorm:"column:discount"`
+	FinalAmount      money.Money     `gorm:"column:final_amount"`
+	ChangeDue        money.Money     `gorm:"column:change_due"`
+	PricesIncludeTax bool            `gorm:"column:prices_include_tax"`
+	Currency         string          `gorm:"column:currency;type:varchar(3)"`
+	Items            []ReceiptItem   `gorm:"foreignKey:ReceiptID;references:ReceiptID"`
+	TaxLines         []ReceiptTax    `gorm:"foreignKey:ReceiptID;references:ReceiptID"`
+	Tenders          []ReceiptTender `gorm:"foreignKey:ReceiptID;references:ReceiptID"`
+}
+
+type ReceiptItem struct {
+	ReceiptItemID   uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	ReceiptID       uint64      `gorm:"column:receipt_id;not null"`
+	ProductID       uint64      `gorm:"column:product_id"`
+	ProductName     string      `gorm:"column:product_name;length:255"`
+	Quantity        int         `gorm:"column:quantity"`
+	UnitPrice       money.Money `gorm:"column:unit_price"`
+	TaxRate         float64     `gorm:"column:tax_rate"`
+	TaxAmount       money.Money `gorm:"column:tax_amount"`
+	TotalPrice      money.Money `gorm:"column:total_price"`
+	DiscountID      *uint64     `gorm:"column:discount_id"`
+	DiscountName    string      `gorm:"column:discount_name;length:255"`
+	DiscountAmount  money.Money `gorm:"column:discount_amount"`
+	PromotionName   string      `gorm:"column:promotion_name;length:255"` // names of every promotion on the line
+	PromotionAmount money.Money `gorm:"column:promotion_amount"`
+	TierName        string      `gorm:"column:tier_name;length:50"`
+	TierDiscount    money.Money `gorm:"column:tier_discount"`
+}
+
+type ReceiptTender struct {
+	ReceiptTenderID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	ReceiptID       uint64      `gorm:"column:receipt_id;not null"`
+	PaymentID       uint64      `gorm:"column:payment_id"`
+	PaymentType     string      `gorm:"column:payment_type;type:varchar(20)"`
+	Amount          money.Money `gorm:"column:amount"`
+	AmountTendered  money.Money `gorm:"column:amount_tendered"`
+	ChangeDue       money.Money `gorm:"column:change_due"`
+	Currency        string      `gorm:"column:currency;type:varchar(3)"` // foreign currency tendered, empty for the store currency
+	ExchangeRate    float64     `gorm:"column:exchange_rate"`
+	ForeignAmount   money.Money `gorm:"column:foreign_amount"` // amount handed over in Currency
+}
+
+// ReceiptTax is the tax total of one rate on a receipt
+type ReceiptTax struct {
+	ReceiptTaxID  uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	ReceiptID     uint64      `gorm:"column:receipt_id;not null"`
+	TaxName       string      `gorm:"column:tax_name;type:varchar(100)"`
+	TaxRate       float64     `gorm:"column:tax_rate"`
+	TaxableAmount money.Money `gorm:"column:taxable_amount"`
+	TaxAmount     money.Money `gorm:"column:tax_amount"`
+}
+
+func (receipt *Receipt) BeforeUpdate(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (receipt *Receipt) BeforeDelete(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (item *ReceiptItem) BeforeUpdate(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (item *ReceiptItem) BeforeDelete(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (tender *ReceiptTender) BeforeUpdate(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (tender *ReceiptTender) BeforeDelete(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (tax *ReceiptTax) BeforeUpdate(tx *gorm.DB) error {
+	return ErrReceiptImmutable
+}
+
+func (tax *ReceiptTax) BeforeDelete(tx *gorm.DB) error {
+	return ErrReceiptImmutable
 }
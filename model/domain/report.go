@@ -0,0 +1,28 @@
+package domain
+
+import "github.com/Kahffi/go-rest-api-test/money"
+
+// Groupings of the sales report
+const (
+	SalesGroupDay      = "day"
+	SalesGroupWeek     = "week"
+	SalesGroupMonth    = "month"
+	SalesGroupProduct  = "product"
+	SalesGroupCategory = "category"
+	SalesGroupEmployee = "employee"
+	SalesGroupStore    = "store"
+)
+
+// SalesReportRow is one group of the sales report as aggregated by the database. Returns are counted
+// against the group and the store of the sale they undo, on the day they were taken back. Sales count on
+// the day the order was paid in full.
+type SalesReportRow struct {
+	GroupKey   string      `gorm:"column:group_key"`
+	Label      string      `gorm:"column:label"`
+	OrderCount int         `gorm:"column:order_count"`
+	Quantity   int         `gorm:"column:quantity"`
+	GrossSales money.Money `gorm:"column:gross_sales"` // unit price times quantity
+	Discounts  money.Money `gorm:"column:discounts"`   // discounts, promotions and tier discounts
+	TaxAmount  money.Money `gorm:"column:tax_amount"`
+	NetSales   money.Money `gorm:"column:net_sales"` // GrossSales - Discounts, without tax
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+const (
+	ShiftStatusOpen   = "Open"
+	ShiftStatusClosed = "Closed"
+)
+
+const (
+	CashMovementPayIn  = "PayIn"
+	CashMovementPayOut = "PayOut"
+)
+
+// Shift is an employee working a register. Payments and refunds taken on the register carry the shift,
+// closing it counts the drawer and keeps what was expected next to what was counted. The report figures
+// are kept as they were at closing, so a closed shift keeps reporting what it was closed with.
+type Shift struct {
+	ShiftID        uint64         `gorm:"primary_key;column:id;autoIncrement"`
+	EmployeeID     uint64         `gorm:"column:employee_id;not null;index"`
+	StoreID        uint64         `gorm:"column:store_id;index"`
+	Register       string         `gorm:"column:register;type:varchar(50);index"` // unique within the store
+	Status         string         `gorm:"column:status;type:varchar(20)"`         // e.g., Open, Closed
+	OpeningFloat   money.Money    `gorm:"column:opening_float"`
+	ExpectedCash   money.Money    `gorm:"column:expected_cash"` // set when the shift is closed
+	CountedCash    money.Money    `gorm:"column:counted_cash"`
+	OrderCount     int            `gorm:"column:order_count"`
+	GrossSales     money.Money    `gorm:"column:gross_sales"`
+	TotalRefunds   money.Money    `gorm:"column:total_refunds"`
+	Discounts      money.Money    `gorm:"column:discounts"`
+	CashSales      money.Money    `gorm:"column:cash_sales"`
+	CashRefunds    money.Money    `gorm:"column:cash_refunds"`
+	TotalsRecorded bool           `gorm:"column:totals_recorded"` // false for shifts closed before the figures were kept
+	Note           string         `gorm:"column:note;type:varchar(255)"`
+	OpenedAt       time.Time      `gorm:"column:opened_at"`
+	ClosedAt       *time.Time     `gorm:"column:closed_at"`
+	Employee       Employee       `gorm:"foreignKey:EmployeeID;references:EmployeeID"`
+	CashMovements  []CashMovement `gorm:"foreignKey:ShiftID;references:ShiftID"`
+}
+
+// CashVariance is how far the counted drawer is off the expected cash, positive when there is more
+func (shift Shift) CashVariance() money.Money {
+	return shift.CountedCash - shift.ExpectedCash
+}
+
+// CashMovement is cash put into or taken out of the drawer that is not a sale or refund, e.g. change
+// brought from the safe or a supplier paid in cash
+type CashMovement struct {
+	CashMovementID uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	ShiftID        uint64      `gorm:"column:shift_id;not null;index"`
+	Type           string      `gorm:"column:type;type:varchar(20)"` // e.g., PayIn, PayOut
+	Amount         money.Money `gorm:"column:amount"`                // always positive, Type tells the direction
+	Reason         string      `gorm:"column:reason;type:varchar(255)"`
+	CreatedAt      time.Time   `gorm:"column:created_at"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	StockReasonSale       = "Sale"
+	StockReasonReturn     = "Return"
+	StockReasonRestock    = "Restock"
+	StockReasonAdjustment = "Adjustment"
+	StockReasonWaste      = "Waste"
+	StockReasonTransfer   = "Transfer"
+)
+
+var ErrStockMovementImmutable = errors.New("stock movements cannot be changed once recorded")
+
+// StockMovement is one change to the stock of a product at a store. The ledger is append only, so the
+// stock of a product at a store, and over the chain, always equals the sum of its movements.
+type StockMovement struct {
+	StockMovementID uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	ProductID       uint64    `gorm:"column:product_id;not null;index"`
+	StoreID         uint64    `gorm:"column:store_id;index"`
+	Delta           int       `gorm:"column:delta"`                       // negative when stock goes out
+	Reason          string    `gorm:"column:reason;type:varchar(20)"`     // e.g., Sale, Return, Restock, Waste
+	Reference       string    `gorm:"column:reference;type:varchar(100)"` // document behind the change, e.g. Order #12
+	EmployeeID      *uint64   `gorm:"column:employee_id"`
+	CreatedAt       time.Time `gorm:"column:created_at"`
+}
+
+func (movement *StockMovement) BeforeUpdate(tx *gorm.DB) error {
+	return ErrStockMovementImmutable
+}
+
+func (movement *StockMovement) BeforeDelete(tx *gorm.DB) error {
+	return ErrStockMovementImmutable
+}
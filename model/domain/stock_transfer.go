@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+const (
+	StockTransferStatusRequested = "Requested"
+	StockTransferStatusShipped   = "Shipped"
+	StockTransferStatusReceived  = "Received"
+)
+
+// StockTransfer moves stock from one store to another. Shipping takes the shipped quantities off the source
+// store, they are in transit until the destination receives them and adds what actually arrived.
+type StockTransfer struct {
+	StockTransferID uint64              `gorm:"primary_key;column:id;autoIncrement"`
+	FromStoreID     uint64              `gorm:"column:from_store_id;not null;index"`
+	ToStoreID       uint64              `gorm:"column:to_store_id;not null;index"`
+	Status          string              `gorm:"column:status;type:varchar(20)"` // e.g., Requested, Shipped, Received
+	Note            string              `gorm:"column:note;type:varchar(255)"`
+	CreatedAt       time.Time           `gorm:"column:created_at"`
+	ShippedAt       *time.Time          `gorm:"column:shipped_at"`
+	ReceivedAt      *time.Time          `gorm:"column:received_at"`
+	FromStore       Store               `gorm:"foreignKey:FromStoreID;references:StoreID"`
+	ToStore         Store               `gorm:"foreignKey:ToStoreID;references:StoreID"`
+	Lines           []StockTransferLine `gorm:"foreignKey:StockTransferID;references:StockTransferID"`
+}
+
+// InTransitQty is what of the line has left the source store and not been received yet
+func (transfer StockTransfer) InTransitQty(line StockTransferLine) int {
+	if transfer.Status != StockTransferStatusShipped {
+		return 0
+	}
+	return line.ShippedQty
+}
+
+// DiscrepancyQty is how much more arrived than was shipped, negative when the line arrived short
+func (transfer StockTransfer) DiscrepancyQty(line StockTransferLine) int {
+	if transfer.Status != StockTransferStatusReceived {
+		return 0
+	}
+	return line.ReceivedQty - line.ShippedQty
+}
+
+type StockTransferLine struct {
+	StockTransferLineID uint64  `gorm:"primary_key;column:id;autoIncrement"`
+	StockTransferID     uint64  `gorm:"column:stock_transfer_id;not null;index"`
+	ProductID           uint64  `gorm:"column:product_id;not null"`
+	RequestedQty        int     `gorm:"column:requested_qty"`
+	ShippedQty          int     `gorm:"column:shipped_qty"`
+	ReceivedQty         int     `gorm:"column:received_qty"`
+	DiscrepancyNote     string  `gorm:"column:discrepancy_note;type:varchar(255)"` // why the received quantity differs, e.g. Broken in transit
+	Product             Product `gorm:"foreignKey:ProductID;references:ProductID"`
+}
@@ -0,0 +1,74 @@
+package domain
+
+import "time"
+
+const (
+	StocktakeStatusOpen      = "Open"
+	StocktakeStatusCommitted = "Committed"
+	StocktakeStatusCancelled = "Cancelled"
+)
+
+// Reason codes explaining a stocktake variance
+const (
+	StocktakeReasonShrinkage = "Shrinkage"
+	StocktakeReasonDamaged   = "Damaged"
+	StocktakeReasonExpired   = "Expired"
+	StocktakeReasonMiscount  = "Miscount"
+)
+
+// Stocktake is a counting session at a store. Opening it snapshots the expected stock of every product in
+// its category, or of the whole store when CategoryID is nil, and committing it posts the variances to the
+// stock ledger. Stock sold or received while counting stays on top of the count because only the
+// difference to the snapshot is posted.
+type Stocktake struct {
+	StocktakeID   uint64          `gorm:"primary_key;column:id;autoIncrement"`
+	StoreID       uint64          `gorm:"column:store_id;index"`
+	CategoryID    *uint64         `gorm:"column:category_id;index"`
+	Status        string          `gorm:"column:status;type:varchar(20)"` // e.g., Open, Committed, Cancelled
+	Note          string          `gorm:"column:note;type:varchar(255)"`
+	CreatedAt     time.Time       `gorm:"column:created_at"`
+	CommittedAt   *time.Time      `gorm:"column:committed_at"`
+	CommittedByID *uint64         `gorm:"column:committed_by_id"`
+	Category      *Category       `gorm:"foreignKey:CategoryID;references:Id"`
+	CommittedBy   *Employee       `gorm:"foreignKey:CommittedByID;references:EmployeeID"`
+	Lines         []StocktakeLine `gorm:"foreignKey:StocktakeID;references:StocktakeID"`
+}
+
+type StocktakeLine struct {
+	StocktakeLineID uint64           `gorm:"primary_key;column:id;autoIncrement"`
+	StocktakeID     uint64           `gorm:"column:stocktake_id;not null;uniqueIndex:idx_stocktake_product"`
+	ProductID       uint64           `gorm:"column:product_id;not null;uniqueIndex:idx_stocktake_product"`
+	ExpectedQty     int              `gorm:"column:expected_qty"` // stock at the store when the stocktake was opened
+	ReasonCode      string           `gorm:"column:reason_code;type:varchar(20)"`
+	Product         Product          `gorm:"foreignKey:ProductID;references:ProductID"`
+	Counts          []StocktakeCount `gorm:"foreignKey:StocktakeLineID;references:StocktakeLineID"`
+}
+
+// CountedQty adds up what every employee counted, false when nobody counted the product yet
+func (line StocktakeLine) CountedQty() (int, bool) {
+	var counted int
+	for _, count := range line.Counts {
+		counted += count.Quantity
+	}
+	return counted, len(line.Counts) > 0
+}
+
+// Variance is how far the count is off the snapshot, zero while the product is uncounted
+func (line StocktakeLine) Variance() int {
+	counted, ok := line.CountedQty()
+	if !ok {
+		return 0
+	}
+	return counted - line.ExpectedQty
+}
+
+// StocktakeCount is what one employee counted of a product, a product stored on several shelves can be
+// counted by several employees and their counts add up
+type StocktakeCount struct {
+	StocktakeCountID uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	StocktakeLineID  uint64    `gorm:"column:stocktake_line_id;not null;uniqueIndex:idx_stocktake_line_employee"`
+	EmployeeID       uint64    `gorm:"column:employee_id;not null;uniqueIndex:idx_stocktake_line_employee"`
+	Quantity         int       `gorm:"column:quantity"`
+	CountedAt        time.Time `gorm:"column:counted_at"`
+	Employee         Employee  `gorm:"foreignKey:EmployeeID;references:EmployeeID"`
+}
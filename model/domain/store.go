@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// Store is one shop of the chain. Stock, orders, shifts and stocktakes belong to a store, customers,
+// products and their catalogue prices, taxes, discounts and promotions are shared by the whole chain.
+type Store struct {
+	StoreID   uint64    `gorm:"primary_key;column:id;autoIncrement"`
+	Code      string    `gorm:"column:code;type:varchar(20);uniqueIndex"` // short name printed on documents, e.g. JKT01
+	Name      string    `gorm:"column:name;type:varchar(100)"`
+	Address   string    `gorm:"column:address;type:varchar(255)"`
+	Phone     string    `gorm:"column:phone;type:varchar(30)"`
+	Active    bool      `gorm:"column:active"` // an inactive store takes no new orders or shifts
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// StoreProduct is what one store holds of a product and the price it sells it at. The row is created the
+// first time the store stocks the product or prices it, a store without a row holds none and sells at the
+// catalogue price.
+type StoreProduct struct {
+	StoreProductID uint64       `gorm:"primary_key;column:id;autoIncrement"`
+	StoreID        uint64       `gorm:"column:store_id;not null;uniqueIndex:idx_store_product"`
+	ProductID      uint64       `gorm:"column:product_id;not null;uniqueIndex:idx_store_product;index"`
+	StockQty       int          `gorm:"column:stock_qty"`
+	Price          *money.Money `gorm:"column:price"` // overrides the catalogue price at this store, nil when it does not
+}
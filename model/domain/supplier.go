@@ -0,0 +1,10 @@
+package domain
+
+type Supplier struct {
+	SupplierID  uint64 `gorm:"primary_key;column:id;autoIncrement"`
+	Name        string `gorm:"column:supplier_name;type:varchar(100)"`
+	ContactName string `gorm:"column:contact_name;type:varchar(100)"`
+	Email       string `gorm:"column:supplier_email;type:varchar(255)"`
+	Phone       string `gorm:"column:supplier_phone;type:varchar(20)"`
+	Address     string `gorm:"column:supplier_address;type:varchar(255)"`
+}
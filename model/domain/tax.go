@@ -1,8 +1,71 @@
 package domain
 
+import "github.com/Kahffi/go-rest-api-test/money"
+
+const (
+	TaxTypeVAT    = "VAT"
+	TaxTypeSales  = "Sales Tax"
+	TaxTypeExempt = "Exempt"
+)
+
+// Tax is a tax class products are assigned to
 type Tax struct {
-	TaxID       string  `json:"tax_id"`
-	TaxRate     float64 `json:"tax_rate"` // Percentage value of the tax rate
-	TaxType     string  `json:"tax_type"` // e.g., Sales Tax, VAT
-	Description string  `json:"description"`
+	TaxID       uint64  `gorm:"primary_key;column:id;autoIncrement"`
+	Name        string  `gorm:"column:name;type:varchar(100)"`
+	TaxRate     float64 `gorm:"column:tax_rate"`                  // Percentage value of the tax rate
+	TaxType     string  `gorm:"column:tax_type;type:varchar(20)"` // e.g., Sales Tax, VAT, Exempt
+	Description string  `gorm:"column:description;type:varchar(255)"`
+}
+
+// StoreSetting holds the store wide configuration, there is only ever one row
+type StoreSetting struct {
+	StoreSettingID   uint64      `gorm:"primary_key;column:id;autoIncrement"`
+	PricesIncludeTax bool        `gorm:"column:prices_include_tax"`
+	Currency         string      `gorm:"column:currency;type:varchar(3)"`       // ISO code, IDR when empty
+	RoundingMode     string      `gorm:"column:rounding_mode;type:varchar(20)"` // e.g., HalfUp, HalfEven, Down, Up
+	RoundingStep     money.Money `gorm:"column:rounding_step"`                  // in minor units, 1 rounds to the cent
+}
+
+// MoneySettings are the money settings of the store, the defaults for what was never set
+func (setting StoreSetting) MoneySettings() money.Settings {
+	settings := money.Settings{Currency: setting.Currency, Rounding: money.Rounding{Mode: setting.RoundingMode, Step: setting.RoundingStep}}
+	if settings.Currency == "" {
+		settings.Currency = money.DefaultCurrency
+	}
+	if settings.Rounding.Mode == "" {
+		settings.Rounding = money.DefaultRounding
+	}
+	return settings
+}
+
+// TaxLine is one amount to be taxed. Amount already has discounts taken off and includes the tax when
+// prices are tax inclusive.
+type TaxLine struct {
+	TaxName string
+	TaxRate float64
+	Amount  money.Money
+}
+
+type TaxLineResult struct {
+	Net   money.Money
+	Tax   money.Money
+	Gross money.Money
+}
+
+type TaxRateTotal struct {
+	TaxName string
+	TaxRate float64
+	Net     money.Money
+	Tax     money.Money
+}
+
+// TaxCalculation holds the tax of every line, in the order they were given, and the totals per rate.
+// Line taxes always add up to the rate totals.
+type TaxCalculation struct {
+	PricesIncludeTax bool
+	Lines            []TaxLineResult
+	Rates            []TaxRateTotal
+	Net              money.Money
+	Tax              money.Money
+	Gross            money.Money
 }
@@ -0,0 +1,35 @@
+package web
+
+import "time"
+
+type DiscountCreateRequest struct {
+	Description string    `json:"description" validate:"required,max=255"`
+	DiscountPct float64   `json:"discount_pct" validate:"required,gt=0,lte=100"`
+	Scope       string    `json:"scope" validate:"required,oneof=Order Category Product"`
+	CategoryID  uint64    `json:"category_id" validate:"required_if=Scope Category"`
+	ProductIDs  []uint64  `json:"product_ids" validate:"required_if=Scope Product,dive,required"`
+	ValidFrom   time.Time `json:"valid_from" validate:"required"`
+	ValidUntil  time.Time `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+}
+
+type DiscountUpdateRequest struct {
+	Id          uint64    `json:"id" validate:"required"`
+	Description string    `json:"description" validate:"required,max=255"`
+	DiscountPct float64   `json:"discount_pct" validate:"required,gt=0,lte=100"`
+	Scope       string    `json:"scope" validate:"required,oneof=Order Category Product"`
+	CategoryID  uint64    `json:"category_id" validate:"required_if=Scope Category"`
+	ProductIDs  []uint64  `json:"product_ids" validate:"required_if=Scope Product,dive,required"`
+	ValidFrom   time.Time `json:"valid_from" validate:"required"`
+	ValidUntil  time.Time `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+}
+
+type DiscountResponse struct {
+	Id          uint64    `json:"id"`
+	Description string    `json:"description"`
+	DiscountPct float64   `json:"discount_pct"`
+	Scope       string    `json:"scope"`
+	CategoryID  *uint64   `json:"category_id"`
+	ProductIDs  []uint64  `json:"product_ids"`
+	ValidFrom   time.Time `json:"valid_from"`
+	ValidUntil  time.Time `json:"valid_until"`
+}
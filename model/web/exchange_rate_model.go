@@ -0,0 +1,18 @@
+package web
+
+import "time"
+
+type ExchangeRateCreateRequest struct {
+	Currency      string     `json:"currency" validate:"required,len=3,uppercase"`
+	Rate          float64    `json:"rate" validate:"required,gt=0"` // store currency units per unit of currency
+	EffectiveFrom *time.Time `json:"effective_from"`                // now when left out
+}
+
+type ExchangeRateResponse struct {
+	Id            uint64    `json:"id"`
+	Currency      string    `json:"currency"`
+	BaseCurrency  string    `json:"base_currency"`
+	Rate          float64   `json:"rate"`
+	EffectiveFrom time.Time `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
+}
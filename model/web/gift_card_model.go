@@ -0,0 +1,50 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// GiftCardIssueRequest issues a new card with a generated code. Store credit always belongs to a customer.
+type GiftCardIssueRequest struct {
+	Kind       string      `json:"kind" validate:"required,oneof=GiftCard StoreCredit"`
+	Amount     money.Money `json:"amount" validate:"required,gt=0"`
+	CustomerID *uint64     `json:"customer_id" validate:"required_if=Kind StoreCredit"`
+	ExpiresAt  *time.Time  `json:"expires_at"`
+	Reference  string      `json:"reference" validate:"max=100"`
+}
+
+type GiftCardTopUpRequest struct {
+	Code      string      `json:"code"`
+	Amount    money.Money `json:"amount" validate:"required,gt=0"`
+	Reference string      `json:"reference" validate:"max=100"`
+}
+
+type GiftCardResponse struct {
+	Id         uint64      `json:"id"`
+	Code       string      `json:"code"`
+	Kind       string      `json:"kind"`
+	CustomerID *uint64     `json:"customer_id"`
+	Balance    money.Money `json:"balance"`
+	ExpiresAt  *time.Time  `json:"expires_at"`
+	Expired    bool        `json:"expired"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type GiftCardTransactionResponse struct {
+	Id        uint64      `json:"id"`
+	Type      string      `json:"type"`
+	Amount    money.Money `json:"amount"`
+	OrderID   *uint64     `json:"order_id"`
+	PaymentID *uint64     `json:"payment_id"`
+	Reference string      `json:"reference"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// GiftCardLedgerResponse compares the balance of a card with the sum of its ledger
+type GiftCardLedgerResponse struct {
+	Card          GiftCardResponse              `json:"card"`
+	LedgerBalance money.Money                   `json:"ledger_balance"`
+	Consistent    bool                          `json:"consistent"`
+	Transactions  []GiftCardTransactionResponse `json:"transactions"`
+}
@@ -0,0 +1,69 @@
+package web
+
+import "time"
+
+type InventoryUpdateRequest struct {
+	ProductID    uint64 `json:"product_id"`
+	RestockLevel int    `json:"restock_level" validate:"gte=0"`
+}
+
+type RestockRequest struct {
+	ProductID  uint64  `json:"product_id"`
+	StoreID    uint64  `json:"store_id" validate:"required"`
+	Quantity   int     `json:"quantity" validate:"required,gt=0"`
+	Reference  string  `json:"reference" validate:"max=100"` // e.g. the delivery note number
+	EmployeeID *uint64 `json:"employee_id"`
+}
+
+// StockAdjustmentRequest corrects stock by hand, Delta is negative when stock is taken out
+type StockAdjustmentRequest struct {
+	ProductID  uint64 `json:"product_id"`
+	StoreID    uint64 `json:"store_id" validate:"required"`
+	Delta      int    `json:"delta" validate:"required"`
+	Reason     string `json:"reason" validate:"required,oneof=Adjustment Waste"`
+	Reference  string `json:"reference" validate:"max=100"`
+	EmployeeID uint64 `json:"employee_id" validate:"required"`
+}
+
+// InventoryResponse is the stock of a product at one store when StoreID is set, otherwise the stock over
+// every store with the stock of each store listed
+type InventoryResponse struct {
+	ProductID    uint64               `json:"product_id"`
+	ProductName  string               `json:"product_name"`
+	SKU          string               `json:"sku"`
+	StoreID      uint64               `json:"store_id,omitempty"`
+	StockQty     int                  `json:"stock_qty"`
+	RestockLevel int                  `json:"restock_level"` // applies to every store
+	LastRestock  *time.Time           `json:"last_restock"`
+	LowStock     bool                 `json:"low_stock"`
+	Stores       []StoreStockResponse `json:"stores,omitempty"`
+}
+
+type StockMovementResponse struct {
+	Id         uint64    `json:"id"`
+	ProductID  uint64    `json:"product_id"`
+	StoreID    uint64    `json:"store_id"`
+	Delta      int       `json:"delta"`
+	Reason     string    `json:"reason"`
+	Reference  string    `json:"reference"`
+	EmployeeID *uint64   `json:"employee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// StockAuditResponse compares the stock of a product with the stock rebuilt from its movements, over the
+// chain and at every store that holds it or has movements of it
+type StockAuditResponse struct {
+	ProductID  uint64                    `json:"product_id"`
+	StockQty   int                       `json:"stock_qty"`
+	LedgerQty  int                       `json:"ledger_qty"`
+	Difference int                       `json:"difference"`
+	Consistent bool                      `json:"consistent"`
+	Stores     []StoreStockAuditResponse `json:"stores"`
+}
+
+type StoreStockAuditResponse struct {
+	StoreID    uint64 `json:"store_id"`
+	StockQty   int    `json:"stock_qty"`
+	LedgerQty  int    `json:"ledger_qty"`
+	Difference int    `json:"difference"`
+}
@@ -0,0 +1,116 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type LoyaltyRuleCreateRequest struct {
+	Name        string      `json:"name" validate:"required,max=100"`
+	CategoryID  *uint64     `json:"category_id"` // empty for the rule covering every other product
+	SpendAmount money.Money `json:"spend_amount" validate:"required,gt=0"`
+	Points      int         `json:"points" validate:"required,gt=0"`
+	Active      bool        `json:"active"`
+}
+
+type LoyaltyRuleUpdateRequest struct {
+	Id          uint64      `json:"id" validate:"required"`
+	Name        string      `json:"name" validate:"required,max=100"`
+	CategoryID  *uint64     `json:"category_id"`
+	SpendAmount money.Money `json:"spend_amount" validate:"required,gt=0"`
+	Points      int         `json:"points" validate:"required,gt=0"`
+	Active      bool        `json:"active"`
+}
+
+type LoyaltyRuleResponse struct {
+	Id           uint64      `json:"id"`
+	Name         string      `json:"name"`
+	CategoryID   *uint64     `json:"category_id"`
+	CategoryName string      `json:"category_name"`
+	SpendAmount  money.Money `json:"spend_amount"`
+	Points       int         `json:"points"`
+	Active       bool        `json:"active"`
+}
+
+type LoyaltyTierCreateRequest struct {
+	Name             string      `json:"name" validate:"required,max=50"`
+	SpendThreshold   money.Money `json:"spend_threshold" validate:"gte=0"`
+	PointsThreshold  int         `json:"points_threshold" validate:"gte=0"`
+	DiscountPct      float64     `json:"discount_pct" validate:"gte=0,lte=100"`
+	PointsMultiplier float64     `json:"points_multiplier" validate:"required,gte=1"`
+}
+
+type LoyaltyTierUpdateRequest struct {
+	Id               uint64      `json:"id" validate:"required"`
+	Name             string      `json:"name" validate:"required,max=50"`
+	SpendThreshold   money.Money `json:"spend_threshold" validate:"gte=0"`
+	PointsThreshold  int         `json:"points_threshold" validate:"gte=0"`
+	DiscountPct      float64     `json:"discount_pct" validate:"gte=0,lte=100"`
+	PointsMultiplier float64     `json:"points_multiplier" validate:"required,gte=1"`
+}
+
+type LoyaltyTierResponse struct {
+	Id               uint64      `json:"id"`
+	Name             string      `json:"name"`
+	SpendThreshold   money.Money `json:"spend_threshold"`
+	PointsThreshold  int         `json:"points_threshold"`
+	DiscountPct      float64     `json:"discount_pct"`
+	PointsMultiplier float64     `json:"points_multiplier"`
+}
+
+// TierReviewResponse counts the customers a tier review looked at and how many of them changed tier
+type TierReviewResponse struct {
+	Reviewed int `json:"reviewed"`
+	Promoted int `json:"promoted"`
+	Demoted  int `json:"demoted"`
+}
+
+// CustomerTierResponse is the tier of a customer and how far their rolling 12-month spend or points,
+// whichever Basis says, are from the next tier. Progress runs from 0 at the current tier to 100 at the next.
+type CustomerTierResponse struct {
+	Basis         string  `json:"basis"`
+	Value         float64 `json:"value"`
+	TierID        *uint64 `json:"tier_id"`
+	TierName      string  `json:"tier_name"`
+	NextTierID    *uint64 `json:"next_tier_id"`
+	NextTierName  string  `json:"next_tier_name"`
+	NextThreshold float64 `json:"next_threshold"`
+	Remaining     float64 `json:"remaining"`
+	Progress      float64 `json:"progress"`
+}
+
+type LoyaltySettingsUpdateRequest struct {
+	PointValue *money.Money `json:"point_value" validate:"required,gte=0"`
+	TierBasis  string       `json:"tier_basis" validate:"omitempty,oneof=Spend Points"` // left as it is when empty
+}
+
+type LoyaltySettingsResponse struct {
+	PointValue money.Money `json:"point_value"`
+	TierBasis  string      `json:"tier_basis"`
+}
+
+// PointsAdjustmentRequest corrects the points of a customer by hand, Reference says why
+type PointsAdjustmentRequest struct {
+	CustomerID uint64 `json:"customer_id"`
+	Points     int    `json:"points" validate:"required"`
+	Reference  string `json:"reference" validate:"required,max=100"`
+}
+
+type LoyaltyTransactionResponse struct {
+	Id        uint64    `json:"id"`
+	Type      string    `json:"type"`
+	Points    int       `json:"points"`
+	OrderID   *uint64   `json:"order_id"`
+	PaymentID *uint64   `json:"payment_id"`
+	Reference string    `json:"reference"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoyaltyLedgerResponse compares the points of a customer with the sum of their ledger
+type LoyaltyLedgerResponse struct {
+	CustomerID   uint64                       `json:"customer_id"`
+	Points       int                          `json:"points"`
+	LedgerPoints int                          `json:"ledger_points"`
+	Consistent   bool                         `json:"consistent"`
+	Transactions []LoyaltyTransactionResponse `json:"transactions"`
+}
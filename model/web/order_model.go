@@ -0,0 +1,79 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type OrderCreateRequest struct {
+	CustomerID uint64                   `json:"customer_id" validate:"required"`
+	EmployeeID *uint64                  `json:"employee_id"`
+	StoreID    uint64                   `json:"store_id" validate:"required"`
+	Currency   string                   `json:"currency" validate:"omitempty,len=3,uppercase"` // to pay in a foreign currency, prices stay in the store currency
+	Items      []OrderItemCreateRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+// OrderItemCreateRequest sells a product, or one variant of it. A product with variants is only sold as one of
+// its variants, which can also be given straight as ProductID.
+type OrderItemCreateRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	VariantID uint64 `json:"variant_id"`
+	Quantity  int    `json:"quantity" validate:"required,gt=0"`
+}
+
+type OrderResponse struct {
+	Id               uint64                    `json:"id"`
+	CustomerID       uint64                    `json:"customer_id"`
+	EmployeeID       *uint64                   `json:"employee_id"`
+	StoreID          uint64                    `json:"store_id"`
+	OrderDate        time.Time                 `json:"order_date"`
+	Status           string                    `json:"status"`
+	Subtotal         money.Money               `json:"subtotal"`
+	TaxAmount        money.Money               `json:"tax_amount"`
+	Discount         money.Money               `json:"discount"`
+	TotalAmount      money.Money               `json:"total_amount"`
+	PricesIncludeTax bool                      `json:"prices_include_tax"`
+	Currency         string                    `json:"currency"`
+	TenderCurrency   string                    `json:"tender_currency,omitempty"`
+	ExchangeRate     float64                   `json:"exchange_rate,omitempty"`
+	TenderTotal      money.Money               `json:"tender_total,omitempty"` // total in the tender currency
+	LoyaltyTier      string                    `json:"loyalty_tier,omitempty"`
+	AmountPaid       money.Money               `json:"amount_paid"`
+	BalanceDue       money.Money               `json:"balance_due"`
+	PaymentStatus    string                    `json:"payment_status"`
+	ChangeDue        money.Money               `json:"change_due"`
+	Items            []OrderItemResponse       `json:"items"`
+	Payments         []PaymentResponse         `json:"payments"`
+	Adjustments      []OrderAdjustmentResponse `json:"adjustments"`
+}
+
+type OrderItemResponse struct {
+	Id              uint64      `json:"id"`
+	ProductID       uint64      `json:"product_id"`          // the variant when a variant was sold
+	ParentID        *uint64     `json:"parent_id,omitempty"` // product the variant sold belongs to
+	Quantity        int         `json:"quantity"`
+	UnitPrice       money.Money `json:"unit_price"`
+	TaxName         string      `json:"tax_name"`
+	TaxRate         float64     `json:"tax_rate"`
+	TaxAmount       money.Money `json:"tax_amount"`
+	TotalPrice      money.Money `json:"total_price"`
+	DiscountID      *uint64     `json:"discount_id"`
+	DiscountAmount  money.Money `json:"discount_amount"`
+	PromotionAmount money.Money `json:"promotion_amount"`
+	TierDiscount    money.Money `json:"tier_discount"`
+}
+
+type OrderAdjustmentResponse struct {
+	PromotionID   uint64      `json:"promotion_id"`
+	PromotionName string      `json:"promotion_name"`
+	ProductID     uint64      `json:"product_id"`
+	Quantity      int         `json:"quantity"`
+	Amount        money.Money `json:"amount"`
+	Explanation   string      `json:"explanation"`
+}
+
+type StockShortageResponse struct {
+	ProductID uint64 `json:"product_id"`
+	Requested int    `json:"requested"`
+	Available int    `json:"available"`
+}
@@ -0,0 +1,48 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// OrderReturnCreateRequest returns units of the order lines, Restock puts them back on the shelf
+type OrderReturnCreateRequest struct {
+	OrderID     uint64                   `json:"order_id"`
+	Lines       []OrderReturnLineRequest `json:"lines" validate:"required,min=1,dive"`
+	PaymentType string                   `json:"payment_type" validate:"required,oneof=Cash Card QRIS Online StoreCredit"` // how the refund is paid out
+	Restock     bool                     `json:"restock"`
+	Reason      string                   `json:"reason" validate:"max=255"`
+	EmployeeID  *uint64                  `json:"employee_id"`
+	ShiftID     *uint64                  `json:"shift_id"` // open shift of the register paying out the refund
+}
+
+type OrderReturnLineRequest struct {
+	OrderItemID uint64 `json:"order_item_id" validate:"required"`
+	Quantity    int    `json:"quantity" validate:"required,gt=0"`
+}
+
+type OrderReturnResponse struct {
+	Id           uint64                    `json:"id"`
+	OrderID      uint64                    `json:"order_id"`
+	EmployeeID   *uint64                   `json:"employee_id"`
+	Reason       string                    `json:"reason"`
+	Restocked    bool                      `json:"restocked"`
+	Subtotal     money.Money               `json:"subtotal"`
+	Discount     money.Money               `json:"discount"`
+	TaxAmount    money.Money               `json:"tax_amount"`
+	RefundAmount money.Money               `json:"refund_amount"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	Refund       PaymentResponse           `json:"refund"`
+	Lines        []OrderReturnLineResponse `json:"lines"`
+}
+
+type OrderReturnLineResponse struct {
+	OrderItemID  uint64      `json:"order_item_id"`
+	ProductID    uint64      `json:"product_id"`
+	ProductName  string      `json:"product_name"`
+	Quantity     int         `json:"quantity"`
+	Subtotal     money.Money `json:"subtotal"`
+	Discount     money.Money `json:"discount"`
+	TaxAmount    money.Money `json:"tax_amount"`
+	RefundAmount money.Money `json:"refund_amount"`
+}
@@ -0,0 +1,33 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type PaymentCreateRequest struct {
+	OrderID      uint64      `json:"order_id" validate:"required"`
+	Amount       money.Money `json:"amount" validate:"required,gt=0"` // amount handed over by the customer
+	PaymentType  string      `json:"payment_type" validate:"required,oneof=Cash Card QRIS Online Points GiftCard StoreCredit"`
+	Status       string      `json:"status" validate:"omitempty,oneof=Pending Completed"`
+	GiftCardCode string      `json:"gift_card_code" validate:"required_if=PaymentType GiftCard,required_if=PaymentType StoreCredit"`
+	ShiftID      *uint64     `json:"shift_id"`                                      // open shift of the register taking the payment
+	Currency     string      `json:"currency" validate:"omitempty,len=3,uppercase"` // Amount is in this currency when set, change is given in the store currency
+}
+
+type PaymentResponse struct {
+	Id             uint64      `json:"id"`
+	OrderID        uint64      `json:"order_id"`
+	Amount         money.Money `json:"amount"`
+	AmountTendered money.Money `json:"amount_tendered"`
+	ChangeDue      money.Money `json:"change_due"`
+	PaymentType    string      `json:"payment_type"`
+	PaymentDate    time.Time   `json:"payment_date"`
+	Status         string      `json:"status"`
+	GiftCardCode   string      `json:"gift_card_code,omitempty"`
+	ShiftID        *uint64     `json:"shift_id,omitempty"`
+	RefundShiftID  *uint64     `json:"refund_shift_id,omitempty"`
+	Currency       string      `json:"currency,omitempty"`
+	ExchangeRate   float64     `json:"exchange_rate,omitempty"`
+	ForeignAmount  money.Money `json:"foreign_amount,omitempty"`
+}
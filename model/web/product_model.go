@@ -1,33 +1,100 @@
 package web
 
+import "github.com/Kahffi/go-rest-api-test/money"
+
+// ProductCreateRequest creates a product, or with Options a parent product and one variant for every
+// combination of option values. The opening stock of a parent goes into its variants.
 type ProductCreateRequest struct {
-	Name        string  `json:"name" validate:"required,max=32,min=10"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" validate:"required,gte=0"`
-	StockQty    int     `json:"stock_qty" validate:"required,gte=0"`
-	CategoryID  int     `json:"category" validate:"required"`
-	SKU         string  `json:"sku" validate:"required"`
-	TaxRate     float64 `json:"tax_rate" validate:"required,gte=0"`
+	Name        string                  `json:"name" validate:"required,max=32,min=10"`
+	Description string                  `json:"description"`
+	Price       money.Money             `json:"price" validate:"required,gte=0"`
+	StockQty    int                     `json:"stock_qty" validate:"required_without=Options,gte=0"`
+	CategoryID  int                     `json:"category" validate:"required"`
+	SKU         string                  `json:"sku" validate:"required"`
+	Barcode     string                  `json:"barcode" validate:"max=64"`
+	TaxID       uint64                  `json:"tax_id" validate:"required"`
+	StoreID     uint64                  `json:"store_id" validate:"required"` // store the opening stock is put into
+	Options     []ProductOptionRequest  `json:"options" validate:"max=3,dive"`
+	Variants    []ProductVariantRequest `json:"variants" validate:"dive"` // details of generated variants, others take defaults
+}
+
+type ProductOptionRequest struct {
+	Name   string   `json:"name" validate:"required,max=50"`
+	Values []string `json:"values" validate:"required,min=1,dive,required,max=50"`
+}
+
+// ProductVariantRequest describes the generated variant with the given value on every option. Without an SKU
+// it gets the SKU of its parent followed by its values, without a price it sells at the price of its parent.
+type ProductVariantRequest struct {
+	Options  map[string]string `json:"options" validate:"required"` // option name to value, e.g. {"Size": "M"}
+	SKU      string            `json:"sku" validate:"max=64"`
+	Barcode  string            `json:"barcode" validate:"max=64"`
+	Price    *money.Money      `json:"price" validate:"omitempty,gte=0"`
+	StockQty int               `json:"stock_qty" validate:"gte=0"`
+}
+
+// ProductVariantUpdateRequest changes what sets a variant apart from its parent, a nil Price goes back to the
+// price of the parent
+type ProductVariantUpdateRequest struct {
+	ProductID uint64       `json:"product_id"`
+	VariantID uint64       `json:"variant_id"`
+	SKU       string       `json:"sku" validate:"required,max=64"`
+	Barcode   string       `json:"barcode" validate:"max=64"`
+	Price     *money.Money `json:"price" validate:"omitempty,gte=0"`
 }
 
 type ProductUpdateRequest struct {
-	Id          uint64  `json:"id" validate:"required,gte=0"`
-	Name        string  `json:"name" validate:"required,max=32,min=10"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" validate:"required,gte=0"`
-	StockQty    int     `json:"stock_qty" validate:"required,gte=0"`
-	CategoryID  int     `json:"category_id" validate:"required"`
-	SKU         string  `json:"sku" validate:"required"`
-	TaxRate     float64 `json:"tax_rate" validate:"required,gte=0"`
+	Id          uint64      `json:"id" validate:"required,gte=0"`
+	Name        string      `json:"name" validate:"required,max=32,min=10"`
+	Description string      `json:"description"`
+	Price       money.Money `json:"price" validate:"required,gte=0"`
+	StockQty    int         `json:"stock_qty" validate:"required,gte=0"`
+	CategoryID  int         `json:"category_id" validate:"required"`
+	SKU         string      `json:"sku" validate:"required"`
+	Barcode     string      `json:"barcode" validate:"max=64"`
+	TaxID       uint64      `json:"tax_id" validate:"required"`
+}
+
+// ProductQuery narrows how products are shown. With a StoreID the price and stock are those of the store,
+// otherwise the catalogue price and the stock over every store.
+type ProductQuery struct {
+	Currency string
+	StoreID  uint64
 }
 
 type ProductResponse struct {
-	Id          uint64  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	StockQty    int     `json:"stock_qty"`
-	CategoryID  int     `json:"category_id"`
-	SKU         string  `json:"sku"`
-	TaxRate     float64 `json:"tax_rate"`
+	Id             uint64                   `json:"id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	Price          money.Money              `json:"price"`
+	StockQty       int                      `json:"stock_qty"`
+	CategoryID     int                      `json:"category_id"`
+	SKU            string                   `json:"sku"`
+	Barcode        string                   `json:"barcode"`
+	TaxID          uint64                   `json:"tax_id"`
+	TaxRate        float64                  `json:"tax_rate"`
+	ParentID       *uint64                  `json:"parent_id,omitempty"` // set on a variant
+	StoreID        uint64                   `json:"store_id,omitempty"`
+	Currency       string                   `json:"currency,omitempty"` // currency asked for, Price stays in the store currency
+	ExchangeRate   float64                  `json:"exchange_rate,omitempty"`
+	ConvertedPrice money.Money              `json:"converted_price,omitempty"`
+	Options        []ProductOptionResponse  `json:"options,omitempty"`
+	Variants       []ProductVariantResponse `json:"variants,omitempty"` // StockQty of a parent is the sum of its variants
+}
+
+type ProductOptionResponse struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type ProductVariantResponse struct {
+	Id             uint64            `json:"id"`
+	Name           string            `json:"name"`
+	Options        map[string]string `json:"options"`
+	SKU            string            `json:"sku"`
+	Barcode        string            `json:"barcode"`
+	Price          money.Money       `json:"price"`
+	PriceOverride  *money.Money      `json:"price_override"`
+	StockQty       int               `json:"stock_qty"`
+	ConvertedPrice money.Money       `json:"converted_price,omitempty"`
 }
@@ -0,0 +1,47 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type PromotionCreateRequest struct {
+	Name        string      `json:"name" validate:"required,max=255"`
+	Type        string      `json:"type" validate:"required,oneof=BuyXGetY Bundle QuantityBreak"`
+	BuyQty      int         `json:"buy_qty" validate:"required_if=Type BuyXGetY,gte=0"`
+	FreeQty     int         `json:"free_qty" validate:"required_if=Type BuyXGetY,gte=0"`
+	BundlePrice money.Money `json:"bundle_price" validate:"required_if=Type Bundle,gte=0"`
+	MinQty      int         `json:"min_qty" validate:"required_if=Type QuantityBreak,gte=0"`
+	DiscountPct float64     `json:"discount_pct" validate:"required_if=Type QuantityBreak,gte=0,lte=100"`
+	ProductIDs  []uint64    `json:"product_ids" validate:"required,min=1,dive,required"`
+	ValidFrom   time.Time   `json:"valid_from" validate:"required"`
+	ValidUntil  time.Time   `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+}
+
+type PromotionUpdateRequest struct {
+	Id          uint64      `json:"id" validate:"required"`
+	Name        string      `json:"name" validate:"required,max=255"`
+	Type        string      `json:"type" validate:"required,oneof=BuyXGetY Bundle QuantityBreak"`
+	BuyQty      int         `json:"buy_qty" validate:"required_if=Type BuyXGetY,gte=0"`
+	FreeQty     int         `json:"free_qty" validate:"required_if=Type BuyXGetY,gte=0"`
+	BundlePrice money.Money `json:"bundle_price" validate:"required_if=Type Bundle,gte=0"`
+	MinQty      int         `json:"min_qty" validate:"required_if=Type QuantityBreak,gte=0"`
+	DiscountPct float64     `json:"discount_pct" validate:"required_if=Type QuantityBreak,gte=0,lte=100"`
+	ProductIDs  []uint64    `json:"product_ids" validate:"required,min=1,dive,required"`
+	ValidFrom   time.Time   `json:"valid_from" validate:"required"`
+	ValidUntil  time.Time   `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+}
+
+type PromotionResponse struct {
+	Id          uint64      `json:"id"`
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	BuyQty      int         `json:"buy_qty,omitempty"`
+	FreeQty     int         `json:"free_qty,omitempty"`
+	BundlePrice money.Money `json:"bundle_price,omitempty"`
+	MinQty      int         `json:"min_qty,omitempty"`
+	DiscountPct float64     `json:"discount_pct,omitempty"`
+	ProductIDs  []uint64    `json:"product_ids"`
+	ValidFrom   time.Time   `json:"valid_from"`
+	ValidUntil  time.Time   `json:"valid_until"`
+}
@@ -0,0 +1,67 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type PurchaseOrderCreateRequest struct {
+	SupplierID uint64                     `json:"supplier_id" validate:"required"`
+	StoreID    uint64                     `json:"store_id" validate:"required"` // store the goods are delivered to
+	Note       string                     `json:"note" validate:"max=255"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type PurchaseOrderUpdateRequest struct {
+	Id         uint64                     `json:"id" validate:"required"`
+	SupplierID uint64                     `json:"supplier_id" validate:"required"`
+	StoreID    uint64                     `json:"store_id" validate:"required"` // store the goods are delivered to
+	Note       string                     `json:"note" validate:"max=255"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type PurchaseOrderLineRequest struct {
+	ProductID  uint64      `json:"product_id" validate:"required"`
+	OrderedQty int         `json:"ordered_qty" validate:"required,gt=0"`
+	UnitCost   money.Money `json:"unit_cost" validate:"gte=0"`
+}
+
+// PurchaseOrderReceiveRequest books one delivery. Close marks the order received even when lines are still
+// short, for suppliers that will not deliver the rest.
+type PurchaseOrderReceiveRequest struct {
+	PurchaseOrderID uint64               `json:"purchase_order_id"`
+	Lines           []ReceiveLineRequest `json:"lines" validate:"required_without=Close,dive"`
+	Reference       string               `json:"reference" validate:"max=60"` // e.g. the delivery note number
+	EmployeeID      *uint64              `json:"employee_id"`
+	Close           bool                 `json:"close"`
+}
+
+type ReceiveLineRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,gt=0"`
+}
+
+type PurchaseOrderResponse struct {
+	Id           uint64                      `json:"id"`
+	SupplierID   uint64                      `json:"supplier_id"`
+	SupplierName string                      `json:"supplier_name"`
+	StoreID      uint64                      `json:"store_id"`
+	Status       string                      `json:"status"`
+	Note         string                      `json:"note"`
+	CreatedAt    time.Time                   `json:"created_at"`
+	SentAt       *time.Time                  `json:"sent_at"`
+	ReceivedAt   *time.Time                  `json:"received_at"`
+	TotalCost    money.Money                 `json:"total_cost"`
+	Lines        []PurchaseOrderLineResponse `json:"lines"`
+}
+
+type PurchaseOrderLineResponse struct {
+	Id              uint64      `json:"id"`
+	ProductID       uint64      `json:"product_id"`
+	ProductName     string      `json:"product_name"`
+	OrderedQty      int         `json:"ordered_qty"`
+	ReceivedQty     int         `json:"received_qty"`
+	OutstandingQty  int         `json:"outstanding_qty"`
+	OverReceivedQty int         `json:"over_received_qty"`
+	UnitCost        money.Money `json:"unit_cost"`
+}
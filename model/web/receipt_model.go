@@ -0,0 +1,56 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type ReceiptResponse struct {
+	Id               uint64                  `json:"id"`
+	OrderID          uint64                  `json:"order_id"`
+	ReceiptDate      time.Time               `json:"receipt_date"`
+	TotalAmount      money.Money             `json:"total_amount"`
+	Taxes            money.Money             `json:"taxes"`
+	Discount         money.Money             `json:"discount"`
+	FinalAmount      money.Money             `json:"final_amount"`
+	ChangeDue        money.Money             `json:"change_due"`
+	PricesIncludeTax bool                    `json:"prices_include_tax"`
+	Currency         string                  `json:"currency"`
+	Items            []ReceiptItemResponse   `json:"items"`
+	TaxLines         []ReceiptTaxResponse    `json:"tax_lines"`
+	Tenders          []ReceiptTenderResponse `json:"tenders"`
+}
+
+type ReceiptItemResponse struct {
+	ProductID       uint64      `json:"product_id"`
+	ProductName     string      `json:"product_name"`
+	Quantity        int         `json:"quantity"`
+	UnitPrice       money.Money `json:"unit_price"`
+	TaxRate         float64     `json:"tax_rate"`
+	TaxAmount       money.Money `json:"tax_amount"`
+	TotalPrice      money.Money `json:"total_price"`
+	DiscountName    string      `json:"discount_name,omitempty"`
+	DiscountAmount  money.Money `json:"discount_amount"`
+	PromotionName   string      `json:"promotion_name,omitempty"`
+	PromotionAmount money.Money `json:"promotion_amount"`
+	TierName        string      `json:"tier_name,omitempty"`
+	TierDiscount    money.Money `json:"tier_discount"`
+}
+
+type ReceiptTaxResponse struct {
+	TaxName       string      `json:"tax_name"`
+	TaxRate       float64     `json:"tax_rate"`
+	TaxableAmount money.Money `json:"taxable_amount"`
+	TaxAmount     money.Money `json:"tax_amount"`
+}
+
+type ReceiptTenderResponse struct {
+	PaymentID      uint64      `json:"payment_id"`
+	PaymentType    string      `json:"payment_type"`
+	Amount         money.Money `json:"amount"`
+	AmountTendered money.Money `json:"amount_tendered"`
+	ChangeDue      money.Money `json:"change_due"`
+	Currency       string      `json:"currency,omitempty"`
+	ExchangeRate   float64     `json:"exchange_rate,omitempty"`
+	ForeignAmount  money.Money `json:"foreign_amount,omitempty"`
+}
@@ -0,0 +1,35 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// SalesReportRequest asks for the sales between two dates, both included, of one store or of the whole
+// chain when StoreID is left out
+type SalesReportRequest struct {
+	From    string `query:"from" validate:"required,datetime=2006-01-02"`
+	To      string `query:"to" validate:"required,datetime=2006-01-02"`
+	GroupBy string `query:"group_by" validate:"omitempty,oneof=day week month product category employee store"`
+	StoreID uint64 `query:"store_id"`
+}
+
+type SalesReportResponse struct {
+	From    time.Time                `json:"from"`
+	To      time.Time                `json:"to"`
+	GroupBy string                   `json:"group_by"`
+	StoreID uint64                   `json:"store_id,omitempty"`
+	Rows    []SalesReportRowResponse `json:"rows"`
+	Totals  SalesReportRowResponse   `json:"totals"`
+}
+
+type SalesReportRowResponse struct {
+	Key        string      `json:"key"`
+	Label      string      `json:"label"`
+	OrderCount int         `json:"order_count"`
+	Quantity   int         `json:"quantity"`
+	GrossSales money.Money `json:"gross_sales"`
+	Discounts  money.Money `json:"discounts"`
+	TaxAmount  money.Money `json:"tax_amount"`
+	NetSales   money.Money `json:"net_sales"`
+}
@@ -0,0 +1,97 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// ShiftOpenRequest opens a shift on a register of a store with the cash put into the drawer to start with
+type ShiftOpenRequest struct {
+	EmployeeID   uint64      `json:"employee_id" validate:"required"`
+	StoreID      uint64      `json:"store_id" validate:"required"`
+	Register     string      `json:"register" validate:"required,max=50"`
+	OpeningFloat money.Money `json:"opening_float" validate:"gte=0"`
+	Note         string      `json:"note" validate:"max=255"`
+}
+
+// ShiftCloseRequest closes a shift with the cash counted in the drawer
+type ShiftCloseRequest struct {
+	ShiftID     uint64      `json:"shift_id"`
+	CountedCash money.Money `json:"counted_cash" validate:"gte=0"`
+	Note        string      `json:"note" validate:"max=255"`
+}
+
+type CashMovementRequest struct {
+	ShiftID uint64      `json:"shift_id"`
+	Type    string      `json:"type" validate:"required,oneof=PayIn PayOut"`
+	Amount  money.Money `json:"amount" validate:"required,gt=0"`
+	Reason  string      `json:"reason" validate:"required,max=255"`
+}
+
+type ShiftResponse struct {
+	Id            uint64                 `json:"id"`
+	EmployeeID    uint64                 `json:"employee_id"`
+	EmployeeName  string                 `json:"employee_name"`
+	StoreID       uint64                 `json:"store_id"`
+	Register      string                 `json:"register"`
+	Status        string                 `json:"status"`
+	OpeningFloat  money.Money            `json:"opening_float"`
+	ExpectedCash  *money.Money           `json:"expected_cash"` // nil while the shift is open
+	CountedCash   *money.Money           `json:"counted_cash"`
+	CashVariance  *money.Money           `json:"cash_variance"`
+	Note          string                 `json:"note"`
+	OpenedAt      time.Time              `json:"opened_at"`
+	ClosedAt      *time.Time             `json:"closed_at"`
+	CashMovements []CashMovementResponse `json:"cash_movements"`
+}
+
+type CashMovementResponse struct {
+	Id        uint64      `json:"id"`
+	Type      string      `json:"type"`
+	Amount    money.Money `json:"amount"`
+	Reason    string      `json:"reason"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// ShiftReportResponse is the Z-report of a shift. While the shift is open it reads as an X-report, the
+// figures so far without a counted drawer.
+type ShiftReportResponse struct {
+	Shift        ShiftResponse         `json:"shift"`
+	OrderCount   int                   `json:"order_count"`
+	GrossSales   money.Money           `json:"gross_sales"`
+	TotalRefunds money.Money           `json:"total_refunds"`
+	NetSales     money.Money           `json:"net_sales"`
+	Discounts    money.Money           `json:"discounts"` // given on the orders paid during the shift
+	Sales        []TenderTotalResponse `json:"sales"`
+	Refunds      []TenderTotalResponse `json:"refunds"`
+	Voids        []TenderTotalResponse `json:"voids"`
+	Cash         CashSummaryResponse   `json:"cash"`
+}
+
+type TenderTotalResponse struct {
+	PaymentType string      `json:"payment_type"`
+	Count       int         `json:"count"`
+	Amount      money.Money `json:"amount"`
+}
+
+// CashSummaryResponse reconciles the drawer in the store currency: the float plus cash taken in, less cash
+// paid out and the change handed back on foreign cash. Foreign notes taken are kept apart per currency.
+type CashSummaryResponse struct {
+	OpeningFloat  money.Money           `json:"opening_float"`
+	CashSales     money.Money           `json:"cash_sales"`
+	CashRefunds   money.Money           `json:"cash_refunds"`
+	ForeignChange money.Money           `json:"foreign_change"`
+	PayIns        money.Money           `json:"pay_ins"`
+	PayOuts       money.Money           `json:"pay_outs"`
+	ExpectedCash  money.Money           `json:"expected_cash"`
+	CountedCash   *money.Money          `json:"counted_cash"`
+	Variance      *money.Money          `json:"variance"`
+	ForeignCash   []ForeignCashResponse `json:"foreign_cash"`
+}
+
+// ForeignCashResponse is the foreign currency cash the drawer should hold, in that currency
+type ForeignCashResponse struct {
+	Currency     string      `json:"currency"`
+	Count        int         `json:"count"`
+	ExpectedCash money.Money `json:"expected_cash"`
+}
@@ -0,0 +1,67 @@
+package web
+
+import "time"
+
+type StockTransferCreateRequest struct {
+	FromStoreID uint64                     `json:"from_store_id" validate:"required"`
+	ToStoreID   uint64                     `json:"to_store_id" validate:"required,nefield=FromStoreID"`
+	Note        string                     `json:"note" validate:"max=255"`
+	Lines       []StockTransferLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type StockTransferLineRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,gt=0"`
+}
+
+// StockTransferShipRequest ships the transfer, lines left out ship the requested quantity
+type StockTransferShipRequest struct {
+	StockTransferID uint64                    `json:"stock_transfer_id"`
+	Lines           []TransferShipLineRequest `json:"lines" validate:"dive"`
+	EmployeeID      *uint64                   `json:"employee_id"`
+}
+
+type TransferShipLineRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"gte=0"`
+}
+
+// StockTransferReceiveRequest books what arrived, lines left out arrived as shipped
+type StockTransferReceiveRequest struct {
+	StockTransferID uint64                       `json:"stock_transfer_id"`
+	Lines           []TransferReceiveLineRequest `json:"lines" validate:"dive"`
+	EmployeeID      *uint64                      `json:"employee_id"`
+}
+
+type TransferReceiveLineRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"gte=0"`
+	Note      string `json:"note" validate:"max=255"` // why it differs from what was shipped
+}
+
+type StockTransferResponse struct {
+	Id            uint64                      `json:"id"`
+	FromStoreID   uint64                      `json:"from_store_id"`
+	FromStoreName string                      `json:"from_store_name"`
+	ToStoreID     uint64                      `json:"to_store_id"`
+	ToStoreName   string                      `json:"to_store_name"`
+	Status        string                      `json:"status"`
+	Note          string                      `json:"note"`
+	CreatedAt     time.Time                   `json:"created_at"`
+	ShippedAt     *time.Time                  `json:"shipped_at"`
+	ReceivedAt    *time.Time                  `json:"received_at"`
+	Lines         []StockTransferLineResponse `json:"lines"`
+}
+
+type StockTransferLineResponse struct {
+	Id              uint64 `json:"id"`
+	ProductID       uint64 `json:"product_id"`
+	ProductName     string `json:"product_name"`
+	SKU             string `json:"sku"`
+	RequestedQty    int    `json:"requested_qty"`
+	ShippedQty      int    `json:"shipped_qty"`
+	InTransitQty    int    `json:"in_transit_qty"`
+	ReceivedQty     int    `json:"received_qty"`
+	DiscrepancyQty  int    `json:"discrepancy_qty"` // received less shipped, negative when short
+	DiscrepancyNote string `json:"discrepancy_note"`
+}
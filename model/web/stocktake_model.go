@@ -0,0 +1,87 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+// StocktakeCreateRequest opens a stocktake of one category at a store, or of the whole store without
+// CategoryID
+type StocktakeCreateRequest struct {
+	StoreID    uint64  `json:"store_id" validate:"required"`
+	CategoryID *uint64 `json:"category_id"`
+	Note       string  `json:"note" validate:"max=255"`
+}
+
+// StocktakeCountRequest records what one employee counted. Sending a product again replaces that
+// employee's earlier count of it.
+type StocktakeCountRequest struct {
+	StocktakeID uint64                      `json:"stocktake_id"`
+	EmployeeID  uint64                      `json:"employee_id" validate:"required"`
+	Counts      []StocktakeCountLineRequest `json:"counts" validate:"required,min=1,dive"`
+}
+
+type StocktakeCountLineRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"gte=0"`
+}
+
+// StocktakeCommitRequest needs a reason code for every counted product that is off its snapshot
+type StocktakeCommitRequest struct {
+	StocktakeID uint64                   `json:"stocktake_id"`
+	EmployeeID  uint64                   `json:"employee_id" validate:"required"`
+	Reasons     []StocktakeReasonRequest `json:"reasons" validate:"dive"`
+}
+
+type StocktakeReasonRequest struct {
+	ProductID  uint64 `json:"product_id" validate:"required"`
+	ReasonCode string `json:"reason_code" validate:"required,oneof=Shrinkage Damaged Expired Miscount"`
+}
+
+type StocktakeResponse struct {
+	Id            uint64                  `json:"id"`
+	StoreID       uint64                  `json:"store_id"`
+	CategoryID    *uint64                 `json:"category_id"`
+	CategoryName  string                  `json:"category_name"`
+	Status        string                  `json:"status"`
+	Note          string                  `json:"note"`
+	CreatedAt     time.Time               `json:"created_at"`
+	CommittedAt   *time.Time              `json:"committed_at"`
+	CommittedByID *uint64                 `json:"committed_by_id"`
+	CommittedBy   string                  `json:"committed_by"`
+	ProductCount  int                     `json:"product_count"`
+	CountedCount  int                     `json:"counted_count"`
+	Lines         []StocktakeLineResponse `json:"lines"`
+}
+
+type StocktakeLineResponse struct {
+	ProductID     uint64                   `json:"product_id"`
+	ProductName   string                   `json:"product_name"`
+	SKU           string                   `json:"sku"`
+	ExpectedQty   int                      `json:"expected_qty"`
+	CountedQty    *int                     `json:"counted_qty"`
+	Variance      int                      `json:"variance"`
+	VarianceValue money.Money              `json:"variance_value"` // variance at the selling price
+	ReasonCode    string                   `json:"reason_code"`
+	Counts        []StocktakeCountResponse `json:"counts"`
+}
+
+type StocktakeCountResponse struct {
+	EmployeeID   uint64    `json:"employee_id"`
+	EmployeeName string    `json:"employee_name"`
+	Quantity     int       `json:"quantity"`
+	CountedAt    time.Time `json:"counted_at"`
+}
+
+// StocktakeVarianceResponse is what committing would post, the counted products that are off their
+// snapshot. Uncounted products are listed apart, committing leaves their stock as it is.
+type StocktakeVarianceResponse struct {
+	StocktakeID        uint64                  `json:"stocktake_id"`
+	Status             string                  `json:"status"`
+	ProductCount       int                     `json:"product_count"`
+	CountedCount       int                     `json:"counted_count"`
+	TotalVarianceQty   int                     `json:"total_variance_qty"`
+	TotalVarianceValue money.Money             `json:"total_variance_value"`
+	Variances          []StocktakeLineResponse `json:"variances"`
+	Uncounted          []StocktakeLineResponse `json:"uncounted"`
+}
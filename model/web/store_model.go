@@ -0,0 +1,57 @@
+package web
+
+import (
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type StoreCreateRequest struct {
+	Code    string `json:"code" validate:"required,max=20"`
+	Name    string `json:"name" validate:"required,max=100"`
+	Address string `json:"address" validate:"max=255"`
+	Phone   string `json:"phone_number" validate:"max=30"`
+}
+
+type StoreUpdateRequest struct {
+	Id      uint64 `json:"id" validate:"required"`
+	Code    string `json:"code" validate:"required,max=20"`
+	Name    string `json:"name" validate:"required,max=100"`
+	Address string `json:"address" validate:"max=255"`
+	Phone   string `json:"phone_number" validate:"max=30"`
+	Active  bool   `json:"active"`
+}
+
+// StorePriceRequest sets the price a store sells a product at, a nil Price goes back to the catalogue price
+type StorePriceRequest struct {
+	StoreID   uint64       `json:"store_id"`
+	ProductID uint64       `json:"product_id"`
+	Price     *money.Money `json:"price" validate:"omitempty,gte=0"`
+}
+
+type StoreResponse struct {
+	Id        uint64    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	Phone     string    `json:"phone_number"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StoreProductResponse is a product as one store sells it
+type StoreProductResponse struct {
+	StoreID       uint64       `json:"store_id"`
+	ProductID     uint64       `json:"product_id"`
+	ProductName   string       `json:"product_name"`
+	SKU           string       `json:"sku"`
+	CatalogPrice  money.Money  `json:"catalog_price"`
+	PriceOverride *money.Money `json:"price_override"`
+	Price         money.Money  `json:"price"` // what the store sells at
+	StockQty      int          `json:"stock_qty"`
+}
+
+// StoreStockResponse is the stock of a product at one store
+type StoreStockResponse struct {
+	StoreID  uint64 `json:"store_id"`
+	StockQty int    `json:"stock_qty"`
+}
@@ -0,0 +1,27 @@
+package web
+
+type SupplierCreateRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	ContactName string `json:"contact_name" validate:"max=100"`
+	Email       string `json:"email" validate:"omitempty,email"`
+	Phone       string `json:"phone_number" validate:"max=20"`
+	Address     string `json:"address" validate:"max=255"`
+}
+
+type SupplierUpdateRequest struct {
+	Id          uint64 `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required,max=100"`
+	ContactName string `json:"contact_name" validate:"max=100"`
+	Email       string `json:"email" validate:"omitempty,email"`
+	Phone       string `json:"phone_number" validate:"max=20"`
+	Address     string `json:"address" validate:"max=255"`
+}
+
+type SupplierResponse struct {
+	Id          uint64 `json:"id"`
+	Name        string `json:"name"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email"`
+	Phone       string `json:"phone_number"`
+	Address     string `json:"address"`
+}
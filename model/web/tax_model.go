@@ -0,0 +1,46 @@
+package web
+
+import "github.com/Kahffi/go-rest-api-test/money"
+
+type TaxCreateRequest struct {
+	Name        string  `json:"name" validate:"required,max=100"`
+	TaxRate     float64 `json:"tax_rate" validate:"gte=0,lte=100"`
+	TaxType     string  `json:"tax_type" validate:"required,oneof=VAT 'Sales Tax' Exempt"`
+	Description string  `json:"description" validate:"max=255"`
+}
+
+type TaxUpdateRequest struct {
+	Id          uint64  `json:"id" validate:"required"`
+	Name        string  `json:"name" validate:"required,max=100"`
+	TaxRate     float64 `json:"tax_rate" validate:"gte=0,lte=100"`
+	TaxType     string  `json:"tax_type" validate:"required,oneof=VAT 'Sales Tax' Exempt"`
+	Description string  `json:"description" validate:"max=255"`
+}
+
+type TaxResponse struct {
+	Id          uint64  `json:"id"`
+	Name        string  `json:"name"`
+	TaxRate     float64 `json:"tax_rate"`
+	TaxType     string  `json:"tax_type"`
+	Description string  `json:"description"`
+}
+
+type TaxSettingsUpdateRequest struct {
+	PricesIncludeTax *bool `json:"prices_include_tax" validate:"required"`
+}
+
+type TaxSettingsResponse struct {
+	PricesIncludeTax bool `json:"prices_include_tax"`
+}
+
+type MoneySettingsUpdateRequest struct {
+	Currency     string      `json:"currency" validate:"required,len=3,uppercase"`
+	RoundingMode string      `json:"rounding_mode" validate:"required,oneof=HalfUp HalfEven Down Up"`
+	RoundingStep money.Money `json:"rounding_step" validate:"required,gt=0"` // e.g. 0.05 rounds to five cents, 100 to hundreds
+}
+
+type MoneySettingsResponse struct {
+	Currency     string      `json:"currency"`
+	RoundingMode string      `json:"rounding_mode"`
+	RoundingStep money.Money `json:"rounding_step"`
+}
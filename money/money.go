@@ -0,0 +1,206 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of minor units in one unit of currency. Every amount is kept in hundredths, so
+// rupiah, dollars and euros are all exact and switching the store currency never rescales stored rows.
+const Scale = 100
+
+// Money is an amount in minor units. It is stored as a BIGINT and read and written as a plain decimal
+// number in JSON, so 15000.5 in a request is Money(1500050).
+type Money int64
+
+// New turns whole units into Money
+func New(units int64) Money {
+	return Money(units * Scale)
+}
+
+// FromFloat converts a floating point amount, half away from zero. Only meant for values that never were
+// money arithmetic, e.g. rows written before amounts were exact.
+func FromFloat(amount float64) Money {
+	return Money(math.Round(amount * Scale))
+}
+
+// Parse reads a decimal amount such as "15000", "-2.5" or "0.05" without going through a float
+func Parse(s string) (Money, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	rat.Mul(rat, big.NewRat(Scale, 1))
+	if !rat.IsInt() {
+		return 0, fmt.Errorf("amount %q has more than two decimals", s)
+	}
+	if !rat.Num().IsInt64() {
+		return 0, fmt.Errorf("amount %q is out of range", s)
+	}
+	return Money(rat.Num().Int64()), nil
+}
+
+// Float64 is the amount in units, for display and for ratios that need no exactness
+func (m Money) Float64() float64 {
+	return float64(m) / Scale
+}
+
+// String formats the amount with two decimals, e.g. "-1500.05"
+func (m Money) String() string {
+	sign, minor := "", int64(m)
+	if minor < 0 {
+		sign, minor = "-", -minor
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, minor/Scale, minor%Scale)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m%Scale == 0 {
+		return []byte(strconv.FormatInt(int64(m/Scale), 10)), nil
+	}
+	return []byte(strings.TrimRight(m.String(), "0")), nil
+}
+
+// UnmarshalJSON accepts a number or a numeric string
+func (m *Money) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+	if text == "null" || text == "" {
+		return nil
+	}
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Times multiplies the amount by a quantity
+func (m Money) Times(quantity int) Money {
+	return m * Money(quantity)
+}
+
+// Percent takes pct percent of the amount, rounded by the store rounding rule
+func (m Money) Percent(pct float64) Money {
+	rate := rat(pct)
+	rate.Mul(rate, new(big.Rat).SetInt64(int64(m)))
+	rate.Quo(rate, big.NewRat(100, 1))
+	return CurrentRounding().round(rate)
+}
+
+// IncludedPercent is the part of an amount that already has pct percent added on top of it, e.g. the tax in a
+// tax inclusive price, rounded by the store rounding rule
+func (m Money) IncludedPercent(pct float64) Money {
+	rate := rat(pct)
+	rate.Mul(rate, new(big.Rat).SetInt64(int64(m)))
+	rate.Quo(rate, new(big.Rat).Add(big.NewRat(100, 1), rat(pct)))
+	return CurrentRounding().round(rate)
+}
+
+// Share is the part of the amount that numerator out of denominator stands for, rounded by the store
+// rounding rule. A zero denominator has no share.
+func (m Money) Share(numerator int64, denominator int64) Money {
+	if denominator == 0 {
+		return 0
+	}
+	return CurrentRounding().round(new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(numerator)),
+		big.NewInt(denominator)))
+}
+
+// Ratio is how many times other goes into the amount, zero when other is zero
+func (m Money) Ratio(other Money) float64 {
+	if other == 0 {
+		return 0
+	}
+	return float64(m) / float64(other)
+}
+
+// ToBase values a foreign amount in the store currency, rate being store currency units per foreign unit.
+// It is a store amount from then on, so it is rounded by the store rounding rule.
+func (m Money) ToBase(rate float64) Money {
+	return CurrentRounding().round(new(big.Rat).Mul(new(big.Rat).SetInt64(int64(m)), rat(rate)))
+}
+
+// FromBase is the store amount in a foreign currency at rate, to the cent half away from zero. A zero
+// rate has no foreign amount.
+func (m Money) FromBase(rate float64) Money {
+	if rate == 0 {
+		return 0
+	}
+	return DefaultRounding.round(new(big.Rat).Quo(new(big.Rat).SetInt64(int64(m)), rat(rate)))
+}
+
+func Min(a Money, b Money) Money {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func Max(a Money, b Money) Money {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Allocate splits total over the weights in proportion, largest remainder first, so the parts always add
+// up to total exactly. Parts are whole minor units, the rounding rule does not apply.
+func Allocate(total Money, weights []Money) []Money {
+	parts := make([]Money, len(weights))
+	var sum int64
+	for _, weight := range weights {
+		sum += int64(weight)
+	}
+	if sum == 0 {
+		return parts
+	}
+
+	type remainder struct {
+		index int
+		value *big.Int
+	}
+	remainders := make([]remainder, len(weights))
+	left := total
+	for i, weight := range weights {
+		quotient, rest := new(big.Int).QuoRem(
+			new(big.Int).Mul(big.NewInt(int64(total)), big.NewInt(int64(weight))), big.NewInt(sum), new(big.Int))
+		parts[i] = Money(quotient.Int64())
+		left -= parts[i]
+		remainders[i] = remainder{index: i, value: rest.Abs(rest)}
+	}
+
+	// Stable selection keeps ties in line order
+	step := Money(1)
+	if left < 0 {
+		step = -1
+	}
+	for left != 0 {
+		best := -1
+		for i, candidate := range remainders {
+			if candidate.value.Sign() > 0 && (best == -1 || candidate.value.Cmp(remainders[best].value) > 0) {
+				best = i
+			}
+		}
+		if best == -1 {
+			best = 0
+		}
+		parts[remainders[best].index] += step
+		remainders[best].value.SetInt64(0)
+		left -= step
+	}
+	return parts
+}
+
+// rat turns a rate such as 11 or 12.5 into the exact decimal it was written as, NaN and infinities are 0
+func rat(value float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
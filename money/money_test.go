@@ -0,0 +1,109 @@
+package money
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect Money
+		err    bool
+	}{
+		{input: "15000", expect: 1500000},
+		{input: "0.1", expect: 10},
+		{input: "-2.05", expect: -205},
+		{input: "1e3", expect: 100000},
+		{input: "0.005", err: true},
+		{input: "abc", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := Parse(tt.input)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var body struct {
+		Amount Money `json:"amount"`
+		Price  Money `json:"price"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":0.1,"price":"22000"}`), &body))
+	assert.Equal(t, Money(10), body.Amount)
+	assert.Equal(t, New(22000), body.Price)
+
+	out, err := json.Marshal(body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":0.1,"price":22000}`, string(out))
+
+	out, _ = json.Marshal(Money(-1505))
+	assert.Equal(t, "-15.05", string(out))
+}
+
+func TestRounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding Rounding
+		amount   Money
+		pct      float64
+		expect   Money
+	}{
+		{name: "Half up to the cent", rounding: DefaultRounding, amount: 25, pct: 10, expect: 3},
+		{name: "Half even to the cent", rounding: Rounding{Mode: RoundHalfEven, Step: 1}, amount: 25, pct: 10, expect: 2},
+		{name: "Half even odd", rounding: Rounding{Mode: RoundHalfEven, Step: 1}, amount: 35, pct: 10, expect: 4},
+		{name: "Down", rounding: Rounding{Mode: RoundDown, Step: 1}, amount: 29, pct: 10, expect: 2},
+		{name: "Up", rounding: Rounding{Mode: RoundUp, Step: 1}, amount: 21, pct: 10, expect: 3},
+		{name: "Whole rupiah", rounding: Rounding{Mode: RoundHalfUp, Step: 100}, amount: New(1015), pct: 11, expect: New(112)},
+		{name: "Negative half up", rounding: DefaultRounding, amount: -25, pct: 10, expect: -3},
+		{name: "Rate with decimals", rounding: DefaultRounding, amount: New(100), pct: 12.5, expect: 1250},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Configure(Settings{Currency: "IDR", Rounding: tt.rounding}))
+			defer Configure(Settings{Currency: "IDR", Rounding: DefaultRounding})
+
+			assert.Equal(t, tt.expect, tt.amount.Percent(tt.pct))
+		})
+	}
+}
+
+func TestIncludedPercent(t *testing.T) {
+	assert.Equal(t, New(1000), New(11000).IncludedPercent(10))
+	assert.Equal(t, Money(99099), New(10000).IncludedPercent(11))
+	assert.Equal(t, Money(0), New(10000).IncludedPercent(0))
+}
+
+func TestShareAndAllocate(t *testing.T) {
+	assert.Equal(t, Money(333), Money(1000).Share(1, 3))
+	assert.Equal(t, Money(0), Money(1000).Share(1, 0))
+
+	parts := Allocate(100, []Money{1, 1, 1})
+	assert.Equal(t, []Money{34, 33, 33}, parts)
+	assert.Equal(t, []Money{-34, -33, -33}, Allocate(-100, []Money{1, 1, 1}))
+	assert.Equal(t, []Money{0, 0}, Allocate(100, []Money{0, 0}))
+}
+
+func TestExchange(t *testing.T) {
+	assert.Equal(t, New(155000), New(10).ToBase(15500))
+	assert.Equal(t, Money(15499377), Money(1350).ToBase(11481.02))
+	assert.Equal(t, Money(1000), New(155000).FromBase(15500))
+	assert.Equal(t, Money(1000), New(154999).FromBase(15500))
+	assert.Equal(t, Money(0), New(155000).FromBase(0))
+}
+
+func TestConfigureRejectsUnknownMode(t *testing.T) {
+	assert.Error(t, Configure(Settings{Currency: "IDR", Rounding: Rounding{Mode: "Nearest", Step: 1}}))
+	assert.Error(t, Configure(Settings{Currency: "IDR", Rounding: Rounding{Mode: RoundHalfUp}}))
+	assert.Equal(t, DefaultRounding, CurrentRounding())
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"sync/atomic"
+)
+
+// Rounding modes for amounts that come out between two minor units, e.g. tax or a percentage discount
+const (
+	RoundHalfUp   = "HalfUp"   // half away from zero
+	RoundHalfEven = "HalfEven" // half to the even step, banker's rounding
+	RoundDown     = "Down"     // towards zero
+	RoundUp       = "Up"       // away from zero
+)
+
+// Rounding is the store rule for amounts that do not come out whole: they are rounded to a multiple of
+// Step by Mode. A Step of 100 rounds rupiah to whole rupiah, 5 rounds to five cents.
+type Rounding struct {
+	Mode string
+	Step Money
+}
+
+// DefaultCurrency is the store currency until another one is configured
+const DefaultCurrency = "IDR"
+
+// DefaultRounding rounds to the cent, half away from zero
+var DefaultRounding = Rounding{Mode: RoundHalfUp, Step: 1}
+
+// Settings are the store wide money settings every calculation uses
+type Settings struct {
+	Currency string
+	Rounding Rounding
+}
+
+var current atomic.Pointer[Settings]
+
+func init() {
+	current.Store(&Settings{Currency: DefaultCurrency, Rounding: DefaultRounding})
+}
+
+// Configure replaces the store wide money settings
+func Configure(settings Settings) error {
+	if err := settings.Rounding.Validate(); err != nil {
+		return err
+	}
+	current.Store(&settings)
+	return nil
+}
+
+// Currency is the ISO code of the store currency
+func Currency() string {
+	return current.Load().Currency
+}
+
+// CurrentRounding is the store rounding rule
+func CurrentRounding() Rounding {
+	return current.Load().Rounding
+}
+
+func (rounding Rounding) Validate() error {
+	switch rounding.Mode {
+	case RoundHalfUp, RoundHalfEven, RoundDown, RoundUp:
+	default:
+		return fmt.Errorf("unknown rounding mode %q", rounding.Mode)
+	}
+	if rounding.Step <= 0 {
+		return fmt.Errorf("rounding step must be above 0")
+	}
+	return nil
+}
+
+// Round rounds an amount to the step of the rule, amounts on a step stay as they are
+func (rounding Rounding) Round(m Money) Money {
+	return rounding.round(new(big.Rat).SetInt64(int64(m)))
+}
+
+// round rounds an exact number of minor units to a multiple of Step
+func (rounding Rounding) round(minor *big.Rat) Money {
+	step := int64(rounding.Step)
+	if step <= 0 {
+		step = 1
+	}
+	steps := new(big.Rat).Quo(minor, new(big.Rat).SetInt64(step))
+
+	quotient, remainder := new(big.Int).QuoRem(steps.Num(), steps.Denom(), new(big.Int))
+	if remainder.Sign() != 0 {
+		away := big.NewInt(int64(steps.Num().Sign()))
+		// Compare twice the remainder with the denominator to see which side of the half it is on
+		half := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(steps.Denom())
+		switch rounding.Mode {
+		case RoundUp:
+			quotient.Add(quotient, away)
+		case RoundHalfUp:
+			if half >= 0 {
+				quotient.Add(quotient, away)
+			}
+		case RoundHalfEven:
+			if half > 0 || (half == 0 && quotient.Bit(0) == 1) {
+				quotient.Add(quotient, away)
+			}
+		}
+	}
+	return Money(quotient.Int64() * step)
+}
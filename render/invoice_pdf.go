@@ -0,0 +1,234 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/go-pdf/fpdf"
+	"time"
+)
+
+// Invoice is everything printed on a PDF invoice. It is filled from a receipt or from an order
+// that has not been fully paid yet.
+type Invoice struct {
+	Title      string
+	Number     string
+	Date       time.Time
+	OrderID    uint64
+	Customer   InvoiceParty
+	Lines      []InvoiceLine
+	Subtotal   money.Money
+	Discount   money.Money
+	Tax        money.Money
+	Total      money.Money
+	AmountPaid money.Money
+	BalanceDue money.Money
+	Tenders    []InvoiceTender
+	// Taxes holds the total per rate. With tax inclusive prices Tax is part of Total rather than added to it.
+	Taxes            []InvoiceTax
+	PricesIncludeTax bool
+}
+
+type InvoiceTax struct {
+	Name          string
+	Rate          float64
+	TaxableAmount money.Money
+	Amount        money.Money
+}
+
+type InvoiceParty struct {
+	Name    string
+	Email   string
+	Phone   string
+	Address string
+}
+
+type InvoiceLine struct {
+	Description string
+	Quantity    int
+	UnitPrice   money.Money
+	TaxRate     float64
+	TaxAmount   money.Money
+	Total       money.Money // before discount and tax
+	Discount    money.Money
+}
+
+type InvoiceTender struct {
+	PaymentType   string
+	Amount        money.Money
+	Currency      string // foreign currency tendered, empty for the store currency
+	ExchangeRate  float64
+	ForeignAmount money.Money
+}
+
+// widths of the line item table in millimetres, they add up to the printable width of an A4 page
+var invoiceColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Description", 80, "L"},
+	{"Qty", 15, "R"},
+	{"Unit Price", 30, "R"},
+	{"Tax", 15, "R"},
+	{"Amount", 40, "R"},
+}
+
+const (
+	invoiceRowHeight    = 7.0
+	invoiceBottomMargin = 20.0
+)
+
+// InvoicePDF renders invoice as an A4 PDF, starting a new page with a repeated table header whenever the lines run over
+func InvoicePDF(invoice Invoice, header StoreHeader) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle(fmt.Sprintf("%s %s", invoice.Title, invoice.Number), true)
+	pdf.SetAutoPageBreak(true, invoiceBottomMargin)
+	pdf.AliasNbPages("")
+
+	pdf.SetHeaderFunc(func() {
+		pdf.SetFont("Helvetica", "B", 16)
+		pdf.CellFormat(110, 8, tr(header.Name), "", 0, "L", false, 0, "")
+		pdf.CellFormat(70, 8, tr(invoice.Title), "", 1, "R", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", 9)
+		details := []string{header.Address, header.Phone, header.TaxID}
+		meta := []string{
+			"No. " + invoice.Number,
+			"Date " + invoice.Date.Format("02 Jan 2006"),
+			fmt.Sprintf("Order #%d", invoice.OrderID),
+		}
+		for i := range details {
+			pdf.CellFormat(110, 5, tr(details[i]), "", 0, "L", false, 0, "")
+			pdf.CellFormat(70, 5, tr(meta[i]), "", 1, "R", false, 0, "")
+		}
+		pdf.Ln(2)
+		left, _, right, _ := pdf.GetMargins()
+		pageWidth, _ := pdf.GetPageSize()
+		pdf.Line(left, pdf.GetY(), pageWidth-right, pdf.GetY())
+		pdf.Ln(4)
+	})
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.CellFormat(90, 10, tr(header.Footer), "", 0, "L", false, 0, "")
+		pdf.CellFormat(90, 10, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	tableHeader := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for _, column := range invoiceColumns {
+			pdf.CellFormat(column.width, invoiceRowHeight, column.title, "1", 0, column.align, true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 9)
+	}
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 6, "Bill To", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	for _, text := range []string{invoice.Customer.Name, invoice.Customer.Address, invoice.Customer.Phone, invoice.Customer.Email} {
+		if text != "" {
+			pdf.CellFormat(0, 5, tr(text), "", 1, "L", false, 0, "")
+		}
+	}
+	pdf.Ln(4)
+
+	_, pageHeight := pdf.GetPageSize()
+	tableHeader()
+	var rows [][]string
+	for _, line := range invoice.Lines {
+		rows = append(rows, []string{
+			tr(line.Description),
+			fmt.Sprintf("%d", line.Quantity),
+			FormatAmount(line.UnitPrice),
+			formatRate(line.TaxRate) + "%",
+			FormatAmount(line.Total),
+		})
+		if line.Discount > 0 {
+			rows = append(rows, []string{"   Discount", "", "", "", FormatAmount(-line.Discount)})
+		}
+	}
+	for _, values := range rows {
+		if pdf.GetY()+invoiceRowHeight > pageHeight-invoiceBottomMargin {
+			pdf.AddPage()
+			tableHeader()
+		}
+		for i, column := range invoiceColumns {
+			pdf.CellFormat(column.width, invoiceRowHeight, fitWidth(pdf, values[i], column.width-2), "1", 0, column.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+
+	totals := []invoiceTotal{{"Subtotal", invoice.Subtotal, false}}
+	if invoice.Discount > 0 {
+		totals = append(totals, invoiceTotal{"Discount", -invoice.Discount, false})
+	}
+	if !invoice.PricesIncludeTax {
+		for _, tax := range invoice.Taxes {
+			totals = append(totals, invoiceTotal{fmt.Sprintf("%s on %s", taxLabel(tax.Name, tax.Rate), FormatAmount(tax.TaxableAmount)), tax.Amount, false})
+		}
+	}
+	totals = append(totals, invoiceTotal{"Total", invoice.Total, true})
+	if invoice.PricesIncludeTax {
+		for _, tax := range invoice.Taxes {
+			totals = append(totals, invoiceTotal{fmt.Sprintf("Incl. %s on %s", taxLabel(tax.Name, tax.Rate), FormatAmount(tax.TaxableAmount)), tax.Amount, false})
+		}
+	}
+	for _, tender := range invoice.Tenders {
+		totals = append(totals, invoiceTotal{tenderLabel(tender), tender.Amount, false})
+	}
+	totals = append(totals, invoiceTotal{"Balance Due", invoice.BalanceDue, true})
+
+	for _, total := range totals {
+		if pdf.GetY()+6 > pageHeight-invoiceBottomMargin {
+			pdf.AddPage()
+		}
+		if total.bold {
+			pdf.SetFont("Helvetica", "B", 10)
+		} else {
+			pdf.SetFont("Helvetica", "", 9)
+		}
+		pdf.CellFormat(100, 6, "", "", 0, "L", false, 0, "")
+		pdf.CellFormat(50, 6, tr(total.label), "", 0, "L", false, 0, "")
+		pdf.CellFormat(30, 6, FormatAmount(total.amount), "", 1, "R", false, 0, "")
+	}
+
+	var buffer bytes.Buffer
+	if err := pdf.Output(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+type invoiceTotal struct {
+	label  string
+	amount money.Money
+	bold   bool
+}
+
+// fitWidth shortens text with an ellipsis until it fits in width millimetres at the current font
+func fitWidth(pdf *fpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// tenderLabel names the tender a payment was made with, and what was handed over when it was foreign
+func tenderLabel(tender InvoiceTender) string {
+	if tender.Currency == "" {
+		return "Paid by " + tender.PaymentType
+	}
+	return fmt.Sprintf("Paid by %s (%s)", tender.PaymentType, foreignTender(tender.Currency, tender.ForeignAmount, tender.ExchangeRate))
+}
@@ -0,0 +1,62 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestInvoicePDF(t *testing.T) {
+	invoice := Invoice{
+		Title:    "INVOICE",
+		Number:   "INV-000012",
+		Date:     time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		OrderID:  4,
+		Customer: InvoiceParty{Name: "PT Maju Jaya", Address: "Jl. Sudirman 10", Email: "finance@majujaya.co.id"},
+	}
+	for i := 0; i < 80; i++ {
+		invoice.Lines = append(invoice.Lines, InvoiceLine{
+			Description: fmt.Sprintf("Product number %d with a rather long description that needs cutting", i),
+			Quantity:    1,
+			UnitPrice:   1000,
+			TaxRate:     float64(10 * (i % 2)),
+			TaxAmount:   money.Money(100 * (i % 2)),
+			Total:       1000,
+		})
+	}
+
+	output, err := InvoicePDF(invoice, headerTpl)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(output, []byte("%PDF-")))
+
+	pages := regexp.MustCompile(`/Type /Page\b[^s]`).FindAll(output, -1)
+	assert.Greater(t, len(pages), 1)
+}
+
+func TestInvoiceTaxInclusive(t *testing.T) {
+	invoice := Invoice{
+		Title:            "INVOICE",
+		Number:           "INV-000013",
+		Date:             time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		Lines:            []InvoiceLine{{Description: "Kopi", Quantity: 1, UnitPrice: 11100, TaxRate: 11, TaxAmount: 1100, Total: 11100}},
+		Subtotal:         11100,
+		Tax:              1100,
+		Total:            11100,
+		Taxes:            []InvoiceTax{{Name: "VAT 11%", Rate: 11, TaxableAmount: 10000, Amount: 1100}},
+		PricesIncludeTax: true,
+	}
+
+	output, err := InvoicePDF(invoice, headerTpl)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(output, []byte("%PDF-")))
+}
+
+func TestInvoiceTenderLabel(t *testing.T) {
+	assert.Equal(t, "Paid by Card", tenderLabel(InvoiceTender{PaymentType: "Card", Amount: 11100}))
+	assert.Equal(t, "Paid by Cash (USD 0.75 @ 14800)",
+		tenderLabel(InvoiceTender{PaymentType: "Cash", Amount: 11100, Currency: "USD", ExchangeRate: 14800, ForeignAmount: 75}))
+}
@@ -0,0 +1,30 @@
+package render
+
+// PrinterProfile describes the paper a receipt is printed on. Width and AmountWidth are counted
+// in characters of the printer's default font.
+type PrinterProfile struct {
+	Name        string `json:"name"`
+	Width       int    `json:"width"`        // characters per line
+	AmountWidth int    `json:"amount_width"` // right-hand column holding amounts
+	FeedLines   int    `json:"feed_lines"`   // blank lines fed before the cut
+	Cut         bool   `json:"cut"`          // send a paper cut at the end of ESC/POS output
+}
+
+// StoreHeader is printed at the top of every receipt
+type StoreHeader struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Phone   string `json:"phone"`
+	TaxID   string `json:"tax_id"`
+	Footer  string `json:"footer"`
+}
+
+var (
+	Profile58mm = PrinterProfile{Name: "58mm", Width: 32, AmountWidth: 12, FeedLines: 3, Cut: false}
+	Profile80mm = PrinterProfile{Name: "80mm", Width: 48, AmountWidth: 14, FeedLines: 4, Cut: true}
+)
+
+const (
+	FormatText   = "text"
+	FormatEscPos = "escpos"
+)
@@ -0,0 +1,51 @@
+package render
+
+import (
+	"bytes"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+// ESC/POS command sequences understood by common thermal printers
+var (
+	escPosInit         = []byte{0x1B, 0x40}
+	escPosAlignLeft    = []byte{0x1B, 0x61, 0x00}
+	escPosBoldOn       = []byte{0x1B, 0x45, 0x01}
+	escPosBoldOff      = []byte{0x1B, 0x45, 0x00}
+	escPosDoubleHeight = []byte{0x1D, 0x21, 0x10}
+	escPosNormalSize   = []byte{0x1D, 0x21, 0x00}
+	escPosPartialCut   = []byte{0x1D, 0x56, 0x01}
+)
+
+// ReceiptEscPos renders receipt as a raw ESC/POS byte stream using the same layout as ReceiptText
+func ReceiptEscPos(receipt web.ReceiptResponse, header StoreHeader, profile PrinterProfile) []byte {
+	var buffer bytes.Buffer
+	buffer.Write(escPosInit)
+	buffer.Write(escPosAlignLeft)
+
+	for _, line := range receiptLines(receipt, header, profile) {
+		switch line.kind {
+		case lineTitle:
+			buffer.Write(escPosBoldOn)
+			buffer.Write(escPosDoubleHeight)
+			buffer.WriteString(line.text)
+			buffer.WriteByte('\n')
+			buffer.Write(escPosNormalSize)
+			buffer.Write(escPosBoldOff)
+		case lineBold:
+			buffer.Write(escPosBoldOn)
+			buffer.WriteString(line.text)
+			buffer.WriteByte('\n')
+			buffer.Write(escPosBoldOff)
+		default:
+			buffer.WriteString(line.text)
+			buffer.WriteByte('\n')
+		}
+	}
+
+	// ESC d n feeds n lines so the last line clears the cutter
+	buffer.Write([]byte{0x1B, 0x64, byte(profile.FeedLines)})
+	if profile.Cut {
+		buffer.Write(escPosPartialCut)
+	}
+	return buffer.Bytes()
+}
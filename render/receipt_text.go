@@ -0,0 +1,223 @@
+package render
+
+import (
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"strconv"
+	"strings"
+)
+
+// lineKind tells the ESC/POS writer how a line of the text layout should be styled
+type lineKind int
+
+const (
+	lineNormal lineKind = iota
+	lineTitle
+	lineCentered
+	lineBold
+)
+
+type receiptLine struct {
+	kind lineKind
+	text string
+}
+
+// ReceiptText renders receipt as fixed-width plain text for the given printer profile
+func ReceiptText(receipt web.ReceiptResponse, header StoreHeader, profile PrinterProfile) string {
+	var builder strings.Builder
+	for _, line := range receiptLines(receipt, header, profile) {
+		builder.WriteString(line.text)
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+// receiptLines lays the receipt out line by line. Every text is already padded or cut to profile.Width.
+func receiptLines(receipt web.ReceiptResponse, header StoreHeader, profile PrinterProfile) []receiptLine {
+	width := profile.Width
+	separator := receiptLine{lineNormal, strings.Repeat("-", width)}
+	var lines []receiptLine
+
+	lines = append(lines, receiptLine{lineTitle, center(header.Name, width)})
+	for _, text := range []string{header.Address, header.Phone, header.TaxID} {
+		if text != "" {
+			for _, wrapped := range wrap(text, width) {
+				lines = append(lines, receiptLine{lineCentered, center(wrapped, width)})
+			}
+		}
+	}
+	lines = append(lines, separator)
+
+	lines = append(lines,
+		receiptLine{lineNormal, columns(fmt.Sprintf("Receipt #%d", receipt.Id), receipt.ReceiptDate.Format("2006-01-02 15:04"), width)},
+		receiptLine{lineNormal, fit(fmt.Sprintf("Order #%d", receipt.OrderID), width)},
+		separator,
+	)
+
+	for _, item := range receipt.Items {
+		lines = append(lines, receiptLine{lineNormal, fit(item.ProductName, width)})
+		quantity := fmt.Sprintf("  %d x %s", item.Quantity, FormatAmount(item.UnitPrice))
+		lines = append(lines, receiptLine{lineNormal, amountLine(quantity, item.TotalPrice, profile)})
+		if item.DiscountAmount > 0 {
+			label := item.DiscountName
+			if label == "" {
+				label = "Discount"
+			}
+			lines = append(lines, receiptLine{lineNormal, amountLine("  "+label, -item.DiscountAmount, profile)})
+		}
+		if item.PromotionAmount > 0 {
+			label := item.PromotionName
+			if label == "" {
+				label = "Promotion"
+			}
+			lines = append(lines, receiptLine{lineNormal, amountLine("  "+label, -item.PromotionAmount, profile)})
+		}
+		if item.TierDiscount > 0 {
+			lines = append(lines, receiptLine{lineNormal, amountLine("  "+strings.TrimSpace(item.TierName+" member discount"), -item.TierDiscount, profile)})
+		}
+	}
+	lines = append(lines, separator)
+
+	lines = append(lines, receiptLine{lineNormal, amountLine("Subtotal", receipt.TotalAmount, profile)})
+	if receipt.Discount > 0 {
+		lines = append(lines, receiptLine{lineNormal, amountLine("Discount", -receipt.Discount, profile)})
+	}
+	if !receipt.PricesIncludeTax {
+		for _, tax := range receipt.TaxLines {
+			lines = append(lines, receiptLine{lineNormal, amountLine(taxLabel(tax.TaxName, tax.TaxRate), tax.TaxAmount, profile)})
+		}
+	}
+	lines = append(lines, receiptLine{lineBold, amountLine("TOTAL", receipt.FinalAmount, profile)})
+	if receipt.PricesIncludeTax {
+		for _, tax := range receipt.TaxLines {
+			lines = append(lines, receiptLine{lineNormal, amountLine("Incl. "+taxLabel(tax.TaxName, tax.TaxRate), tax.TaxAmount, profile)})
+		}
+	}
+	lines = append(lines, separator)
+
+	for _, tender := range receipt.Tenders {
+		lines = append(lines, receiptLine{lineNormal, amountLine(tender.PaymentType, tender.AmountTendered, profile)})
+		if tender.Currency != "" {
+			lines = append(lines, receiptLine{lineNormal, fit("  "+foreignTender(tender.Currency, tender.ForeignAmount, tender.ExchangeRate), width)})
+		}
+	}
+	if receipt.ChangeDue > 0 {
+		lines = append(lines, receiptLine{lineNormal, amountLine("Change", receipt.ChangeDue, profile)})
+	}
+
+	if header.Footer != "" {
+		lines = append(lines, separator)
+		for _, wrapped := range wrap(header.Footer, width) {
+			lines = append(lines, receiptLine{lineCentered, center(wrapped, width)})
+		}
+	}
+	return lines
+}
+
+// taxLabel names a tax line after its tax class, falling back to the rate
+func taxLabel(name string, rate float64) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("Tax %s%%", formatRate(rate))
+}
+
+// amountLine puts label on the left and amount right-aligned in the amount column
+func amountLine(label string, amount money.Money, profile PrinterProfile) string {
+	value := FormatAmount(amount)
+	amountWidth := profile.AmountWidth
+	if len(value) > amountWidth {
+		amountWidth = len(value)
+	}
+	labelWidth := profile.Width - amountWidth - 1
+	if labelWidth < 0 {
+		labelWidth = 0
+	}
+	return fit(label, labelWidth) + " " + fmt.Sprintf("%*s", amountWidth, value)
+}
+
+// columns puts left and right on one line, shortening left when both don't fit
+func columns(left string, right string, width int) string {
+	space := width - len(right) - 1
+	if space < 0 {
+		return fit(right, width)
+	}
+	return fit(left, space) + " " + right
+}
+
+// fit pads text with spaces or cuts it so it is exactly width characters long
+func fit(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) > width {
+		return string(runes[:width])
+	}
+	return text + strings.Repeat(" ", width-len(runes))
+}
+
+func center(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) >= width {
+		return string(runes[:width])
+	}
+	left := (width - len(runes)) / 2
+	return fit(strings.Repeat(" ", left)+text, width)
+}
+
+// wrap breaks text on spaces into lines of at most width characters
+func wrap(text string, width int) []string {
+	var lines []string
+	var current string
+	for _, word := range strings.Fields(text) {
+		for len([]rune(word)) > width {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			lines = append(lines, string([]rune(word)[:width]))
+			word = string([]rune(word)[width:])
+		}
+		switch {
+		case current == "":
+			current = word
+		case len([]rune(current))+1+len([]rune(word)) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// FormatAmount formats an amount with two decimals and comma thousands separators
+func FormatAmount(amount money.Money) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	text := amount.String()
+	whole, fraction := text[:len(text)-3], text[len(text)-3:]
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + grouped.String() + fraction
+}
+
+// foreignTender tells what was handed over in a foreign currency and the rate it was taken at
+func foreignTender(currency string, amount money.Money, rate float64) string {
+	return fmt.Sprintf("%s %s @ %s", currency, FormatAmount(amount), strconv.FormatFloat(rate, 'f', -1, 64))
+}
+
+func formatRate(rate float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", rate), "0"), ".")
+}
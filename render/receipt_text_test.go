@@ -0,0 +1,110 @@
+package render
+
+import (
+	"bytes"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var receiptTpl = web.ReceiptResponse{
+	Id:          12,
+	OrderID:     4,
+	ReceiptDate: time.Date(2026, 10, 16, 20, 11, 0, 0, time.UTC),
+	TotalAmount: money.New(35000),
+	Taxes:       money.New(2500),
+	FinalAmount: money.New(37500),
+	ChangeDue:   money.New(2500),
+	Items: []web.ReceiptItemResponse{
+		{ProductID: 1, ProductName: "Kopi Susu Gula Aren Extra Large Size", Quantity: 2, UnitPrice: money.New(10000), TaxRate: 10, TaxAmount: money.New(2000), TotalPrice: money.New(20000)},
+		{ProductID: 2, ProductName: "Roti Bakar", Quantity: 1, UnitPrice: money.New(10000), TaxRate: 5, TaxAmount: money.New(500), TotalPrice: money.New(10000)},
+		{ProductID: 3, ProductName: "Air Mineral", Quantity: 1, UnitPrice: money.New(5000), TotalPrice: money.New(5000)},
+	},
+	TaxLines: []web.ReceiptTaxResponse{
+		{TaxRate: 5, TaxableAmount: money.New(10000), TaxAmount: money.New(500)},
+		{TaxRate: 10, TaxableAmount: money.New(20000), TaxAmount: money.New(2000)},
+	},
+	Tenders: []web.ReceiptTenderResponse{
+		{PaymentID: 1, PaymentType: "Card", Amount: money.New(20000), AmountTendered: money.New(20000)},
+		{PaymentID: 2, PaymentType: "Cash", Amount: money.New(17500), AmountTendered: money.New(20000), ChangeDue: money.New(2500)},
+	},
+}
+
+var headerTpl = StoreHeader{Name: "Toko Maju", Address: "Jl. Merdeka No. 1, Jakarta Pusat", Phone: "021-1234567", Footer: "Terima kasih"}
+
+func TestReceiptTextFitsProfileWidth(t *testing.T) {
+	for _, profile := range []PrinterProfile{Profile58mm, Profile80mm} {
+		t.Run(profile.Name, func(t *testing.T) {
+			text := ReceiptText(receiptTpl, headerTpl, profile)
+			for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+				assert.Len(t, []rune(line), profile.Width, line)
+			}
+			assert.Contains(t, text, "Tax 5%")
+			assert.Contains(t, text, "Tax 10%")
+			assert.Contains(t, text, "37,500.00")
+			assert.Contains(t, text, "Change")
+		})
+	}
+}
+
+func TestReceiptTextAmountColumn(t *testing.T) {
+	profile := PrinterProfile{Name: "custom", Width: 40, AmountWidth: 20}
+	text := ReceiptText(receiptTpl, headerTpl, profile)
+	assert.Contains(t, text, "TOTAL"+strings.Repeat(" ", 26)+"37,500.00\n")
+}
+
+func TestReceiptTextLineDiscount(t *testing.T) {
+	receipt := receiptTpl
+	receipt.Items = []web.ReceiptItemResponse{
+		{ProductID: 1, ProductName: "Kopi", Quantity: 1, UnitPrice: money.New(10000), TotalPrice: money.New(10000), DiscountName: "Happy Hour", DiscountAmount: money.New(1500)},
+	}
+	text := ReceiptText(receipt, headerTpl, Profile58mm)
+	assert.Contains(t, text, "  Happy Hour")
+	assert.Contains(t, text, "-1,500.00\n")
+}
+
+func TestReceiptTextForeignTender(t *testing.T) {
+	receipt := receiptTpl
+	receipt.Tenders = []web.ReceiptTenderResponse{
+		{PaymentID: 1, PaymentType: "Cash", Amount: money.New(37500), AmountTendered: money.New(40000), ChangeDue: money.New(2500),
+			Currency: "USD", ExchangeRate: 16000, ForeignAmount: money.Money(250)},
+	}
+	for _, profile := range []PrinterProfile{Profile58mm, Profile80mm} {
+		text := ReceiptText(receipt, headerTpl, profile)
+		assert.Contains(t, text, "\n  USD 2.50 @ 16000 ")
+	}
+	assert.Contains(t, string(ReceiptEscPos(receipt, headerTpl, Profile80mm)), "USD 2.50 @ 16000")
+}
+
+func TestReceiptTextTaxInclusive(t *testing.T) {
+	receipt := receiptTpl
+	receipt.PricesIncludeTax = true
+	receipt.FinalAmount = money.New(35000)
+	receipt.TaxLines = []web.ReceiptTaxResponse{{TaxName: "VAT 11%", TaxRate: 11, TaxableAmount: money.Money(3153153), TaxAmount: money.Money(346847)}}
+
+	text := ReceiptText(receipt, headerTpl, Profile80mm)
+	total := strings.Index(text, "TOTAL")
+	included := strings.Index(text, "Incl. VAT 11%")
+	assert.Greater(t, included, total)
+	assert.Contains(t, text, "3,468.47")
+}
+
+func TestReceiptEscPos(t *testing.T) {
+	output := ReceiptEscPos(receiptTpl, headerTpl, Profile80mm)
+	assert.True(t, bytes.HasPrefix(output, escPosInit))
+	assert.True(t, bytes.HasSuffix(output, escPosPartialCut))
+	assert.Contains(t, string(output), "Toko Maju")
+
+	output = ReceiptEscPos(receiptTpl, headerTpl, Profile58mm)
+	assert.False(t, bytes.HasSuffix(output, escPosPartialCut))
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "0.00", FormatAmount(0))
+	assert.Equal(t, "999.50", FormatAmount(99950))
+	assert.Equal(t, "1,000.00", FormatAmount(money.New(1000)))
+	assert.Equal(t, "-12,345,678.90", FormatAmount(-1234567890))
+}
@@ -44,7 +44,7 @@ func (repository *CategoryRepositoryImpl) FindById(ctx context.Context, category
 	var category domain.Category
 	err := repository.db.WithContext(ctx).First(&category, categoryId).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return category, errors.New("category is not found")
+		return category, notFoundError{message: "category is not found"}
 	}
 	return category, err
 }
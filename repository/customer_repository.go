@@ -11,4 +11,6 @@ type CustomerRepository interface {
 	Delete(ctx context.Context, customer domain.Customer) error
 	FindById(ctx context.Context, customerId uint64) (domain.Customer, error)
 	FindAll(ctx context.Context) ([]domain.Customer, error)
+	UpdateTier(ctx context.Context, customer domain.Customer) (domain.Customer, error)
+	MovePoints(ctx context.Context, transaction domain.LoyaltyTransaction) (domain.LoyaltyTransaction, error)
 }
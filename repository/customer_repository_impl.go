@@ -7,6 +7,8 @@ import (
 	"gorm.io/gorm"
 )
 
+var ErrInsufficientPoints = errors.New("insufficient loyalty points")
+
 type CustomerRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -23,9 +25,9 @@ func (repository *CustomerRepositoryImpl) Save(ctx context.Context, customer dom
 	return customer, nil
 }
 
-// Update customer
+// Update customer. Points and tier are left out, they only change through MovePoints and UpdateTier.
 func (repository *CustomerRepositoryImpl) Update(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
-	if err := repository.db.WithContext(ctx).Save(&customer).Error; err != nil {
+	if err := repository.db.WithContext(ctx).Omit("loyalty_pts", "loyalty_tier_id", "LoyaltyTier").Save(&customer).Error; err != nil {
 		return domain.Customer{}, err
 	}
 	return customer, nil
@@ -42,16 +44,48 @@ func (repository *CustomerRepositoryImpl) Delete(ctx context.Context, customer d
 // FindById - Get customer by ID
 func (repository *CustomerRepositoryImpl) FindById(ctx context.Context, customerId uint64) (domain.Customer, error) {
 	var customer domain.Customer
-	err := repository.db.WithContext(ctx).First(&customer, customerId).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return customer, errors.New("customer is not found")
-	}
+	err := repository.db.WithContext(ctx).Preload("LoyaltyTier").First(&customer, customerId).Error
 	return customer, err
 }
 
 // FindAll - Get all categories
 func (repository *CustomerRepositoryImpl) FindAll(ctx context.Context) ([]domain.Customer, error) {
 	var categories []domain.Customer
-	err := repository.db.WithContext(ctx).Find(&categories).Error
+	err := repository.db.WithContext(ctx).Preload("LoyaltyTier").Find(&categories).Error
 	return categories, err
 }
+
+// UpdateTier only touches the tier of the customer
+func (repository *CustomerRepositoryImpl) UpdateTier(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
+	err := dbFromContext(ctx, repository.db).Model(&customer).Update("loyalty_tier_id", customer.LoyaltyTierID).Error
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
+
+// MovePoints applies transaction.Points to the points of the customer and appends the transaction to the
+// loyalty ledger in one transaction. The update is conditional, points never go below zero.
+func (repository *CustomerRepositoryImpl) MovePoints(ctx context.Context, transaction domain.LoyaltyTransaction) (domain.LoyaltyTransaction, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&domain.Customer{}).Where("id = ?", transaction.CustomerID)
+		if transaction.Points < 0 {
+			query = query.Where("loyalty_pts >= ?", -transaction.Points)
+		}
+		result := query.Update("loyalty_pts", gorm.Expr("loyalty_pts + ?", transaction.Points))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 && transaction.Points < 0 {
+			return ErrInsufficientPoints
+		} else if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Create(&transaction).Error
+	})
+	if err != nil {
+		return domain.LoyaltyTransaction{}, err
+	}
+	return transaction, nil
+}
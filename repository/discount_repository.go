@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"time"
+)
+
+type DiscountRepository interface {
+	Save(ctx context.Context, discount domain.Discount) (domain.Discount, error)
+	Update(ctx context.Context, discount domain.Discount) (domain.Discount, error)
+	Delete(ctx context.Context, discount domain.Discount) error
+	FindById(ctx context.Context, discountId uint64) (domain.Discount, error)
+	FindAll(ctx context.Context) ([]domain.Discount, error)
+	FindActive(ctx context.Context, at time.Time) ([]domain.Discount, error)
+	CountOrderItems(ctx context.Context, discountId uint64) (int64, error)
+}
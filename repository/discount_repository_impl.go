@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+type DiscountRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDiscountRepository(db *gorm.DB) DiscountRepository {
+	return &DiscountRepositoryImpl{db: db}
+}
+
+// Save discount and link its target products. Products themselves are never written.
+func (repository *DiscountRepositoryImpl) Save(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("Products.*").Create(&discount).Error; err != nil {
+		return domain.Discount{}, err
+	}
+	return discount, nil
+}
+
+// Update discount and replace its target products
+func (repository *DiscountRepositoryImpl) Update(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Products").Save(&discount).Error; err != nil {
+			return err
+		}
+		return tx.Model(&discount).Omit("Products.*").Association("Products").Replace(discount.Products)
+	})
+	if err != nil {
+		return domain.Discount{}, err
+	}
+	return discount, nil
+}
+
+// Delete discount together with its product links
+func (repository *DiscountRepositoryImpl) Delete(ctx context.Context, discount domain.Discount) error {
+	return dbFromContext(ctx, repository.db).Select("Products").Delete(&discount).Error
+}
+
+// FindById - Get discount by ID
+func (repository *DiscountRepositoryImpl) FindById(ctx context.Context, discountId uint64) (domain.Discount, error) {
+	var discount domain.Discount
+	err := dbFromContext(ctx, repository.db).Preload("Products").First(&discount, discountId).Error
+	return discount, err
+}
+
+// FindAll - Get all discounts
+func (repository *DiscountRepositoryImpl) FindAll(ctx context.Context) ([]domain.Discount, error) {
+	var discounts []domain.Discount
+	err := dbFromContext(ctx, repository.db).Preload("Products").Find(&discounts).Error
+	return discounts, err
+}
+
+// FindActive - Get the discounts whose validity window contains at
+func (repository *DiscountRepositoryImpl) FindActive(ctx context.Context, at time.Time) ([]domain.Discount, error) {
+	var discounts []domain.Discount
+	err := dbFromContext(ctx, repository.db).Preload("Products").
+		Where("valid_from <= ? AND valid_until >= ?", at, at).
+		Order("id").
+		Find(&discounts).Error
+	return discounts, err
+}
+
+// CountOrderItems - Count the order items the discount was applied to
+func (repository *DiscountRepositoryImpl) CountOrderItems(ctx context.Context, discountId uint64) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, repository.db).Model(&domain.OrderItem{}).Where("discount_id = ?", discountId).Count(&count).Error
+	return count, err
+}
@@ -10,5 +10,6 @@ type EmployeeRepository interface {
 	Update(ctx context.Context, employee domain.Employee) (domain.Employee, error)
 	Delete(ctx context.Context, employee domain.Employee) error
 	FindById(ctx context.Context, employeeId uint64) (domain.Employee, error)
-	FindAll(ctx context.Context) ([]domain.Employee, error)
+	FindByIdForUpdate(ctx context.Context, employeeId uint64) (domain.Employee, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.Employee, error)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EmployeeRepositoryImpl struct {
@@ -44,14 +45,24 @@ func (repository *EmployeeRepositoryImpl) FindById(ctx context.Context, employee
 	var employee domain.Employee
 	err := repository.db.WithContext(ctx).First(&employee, employeeId).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return employee, errors.New("employee is not found")
+		return employee, notFoundError{message: "employee is not found"}
 	}
 	return employee, err
 }
 
-// FindAll - Get all categories
-func (repository *EmployeeRepositoryImpl) FindAll(ctx context.Context) ([]domain.Employee, error) {
+// FindByIdForUpdate - Get employee by ID and lock its row until the surrounding transaction ends
+func (repository *EmployeeRepositoryImpl) FindByIdForUpdate(ctx context.Context, employeeId uint64) (domain.Employee, error) {
+	var employee domain.Employee
+	err := dbFromContext(ctx, repository.db).Clauses(clause.Locking{Strength: "UPDATE"}).First(&employee, employeeId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return employee, notFoundError{message: "employee is not found"}
+	}
+	return employee, err
+}
+
+// FindAll - Get all employees assigned to a store, or every employee when storeId is 0
+func (repository *EmployeeRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.Employee, error) {
 	var categories []domain.Employee
-	err := repository.db.WithContext(ctx).Find(&categories).Error
+	err := byStore(repository.db.WithContext(ctx), storeId).Find(&categories).Error
 	return categories, err
 }
@@ -93,10 +93,10 @@ func TestEmployeeRepository(t *testing.T) {
 		{
 			name: "FindAll Success",
 			mock: func() {
-				repo.EXPECT().FindAll(ctx).Return([]domain.Employee{CompleteEmployee}, nil)
+				repo.EXPECT().FindAll(ctx, uint64(0)).Return([]domain.Employee{CompleteEmployee}, nil)
 			},
 			method: func() (interface{}, error) {
-				return repo.FindAll(ctx)
+				return repo.FindAll(ctx, 0)
 			},
 			expect:    []domain.Employee{CompleteEmployee},
 			expectErr: false,
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"time"
+)
+
+type ExchangeRateRepository interface {
+	Save(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	Delete(ctx context.Context, rate domain.ExchangeRate) error
+	FindById(ctx context.Context, exchangeRateId uint64) (domain.ExchangeRate, error)
+	FindAll(ctx context.Context) ([]domain.ExchangeRate, error)
+	FindEffective(ctx context.Context, currency string, at time.Time) (domain.ExchangeRate, error)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ExchangeRateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) ExchangeRateRepository {
+	return &ExchangeRateRepositoryImpl{db: db}
+}
+
+// Save exchange rate
+func (repository *ExchangeRateRepositoryImpl) Save(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&rate).Error; err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+// Delete exchange rate
+func (repository *ExchangeRateRepositoryImpl) Delete(ctx context.Context, rate domain.ExchangeRate) error {
+	return dbFromContext(ctx, repository.db).Delete(&rate).Error
+}
+
+// FindById - Get exchange rate by ID
+func (repository *ExchangeRateRepositoryImpl) FindById(ctx context.Context, exchangeRateId uint64) (domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+	err := dbFromContext(ctx, repository.db).First(&rate, exchangeRateId).Error
+	return rate, err
+}
+
+// FindAll - Get all exchange rates per currency, the latest first
+func (repository *ExchangeRateRepositoryImpl) FindAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	var rates []domain.ExchangeRate
+	err := dbFromContext(ctx, repository.db).Order("currency").Order("effective_from DESC").Order("id DESC").Find(&rates).Error
+	return rates, err
+}
+
+// FindEffective - Get the rate of the currency in effect at the given time, the one that took effect last
+func (repository *ExchangeRateRepositoryImpl) FindEffective(ctx context.Context, currency string, at time.Time) (domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+	err := dbFromContext(ctx, repository.db).
+		Where("currency = ? AND effective_from <= ?", currency, at).
+		Order("effective_from DESC").Order("id DESC").
+		First(&rate).Error
+	return rate, err
+}
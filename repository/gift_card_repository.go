@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type GiftCardRepository interface {
+	Save(ctx context.Context, card domain.GiftCard) (domain.GiftCard, error)
+	FindById(ctx context.Context, cardId uint64) (domain.GiftCard, error)
+	FindByCode(ctx context.Context, code string) (domain.GiftCard, error)
+	FindAll(ctx context.Context) ([]domain.GiftCard, error)
+	FindExpired(ctx context.Context, at time.Time) ([]domain.GiftCard, error)
+	FindTransactions(ctx context.Context, cardId uint64) ([]domain.GiftCardTransaction, error)
+	SumByCardId(ctx context.Context, cardId uint64) (money.Money, error)
+	MoveBalance(ctx context.Context, transaction domain.GiftCardTransaction) (domain.GiftCardTransaction, error)
+}
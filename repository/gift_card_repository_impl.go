@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient gift card balance")
+
+type GiftCardRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewGiftCardRepository(db *gorm.DB) GiftCardRepository {
+	return &GiftCardRepositoryImpl{db: db}
+}
+
+// Save gift card. The balance is left out, it only changes through MoveBalance.
+func (repository *GiftCardRepositoryImpl) Save(ctx context.Context, card domain.GiftCard) (domain.GiftCard, error) {
+	card.Balance = 0
+	if err := dbFromContext(ctx, repository.db).Create(&card).Error; err != nil {
+		return domain.GiftCard{}, err
+	}
+	return card, nil
+}
+
+// FindById - Get gift card by ID
+func (repository *GiftCardRepositoryImpl) FindById(ctx context.Context, cardId uint64) (domain.GiftCard, error) {
+	var card domain.GiftCard
+	err := dbFromContext(ctx, repository.db).First(&card, cardId).Error
+	return card, err
+}
+
+// FindByCode - Get gift card by the code printed on it
+func (repository *GiftCardRepositoryImpl) FindByCode(ctx context.Context, code string) (domain.GiftCard, error) {
+	var card domain.GiftCard
+	err := dbFromContext(ctx, repository.db).Where("code = ?", code).First(&card).Error
+	return card, err
+}
+
+// FindAll - Get all gift cards, newest first
+func (repository *GiftCardRepositoryImpl) FindAll(ctx context.Context) ([]domain.GiftCard, error) {
+	var cards []domain.GiftCard
+	err := dbFromContext(ctx, repository.db).Order("id desc").Find(&cards).Error
+	return cards, err
+}
+
+// FindExpired - Get the cards expired by the given time that still hold a balance
+func (repository *GiftCardRepositoryImpl) FindExpired(ctx context.Context, at time.Time) ([]domain.GiftCard, error) {
+	var cards []domain.GiftCard
+	err := dbFromContext(ctx, repository.db).
+		Where("expires_at IS NOT NULL AND expires_at <= ? AND balance > 0", at).
+		Order("id").
+		Find(&cards).Error
+	return cards, err
+}
+
+// FindTransactions - Get the ledger of a gift card, oldest first
+func (repository *GiftCardRepositoryImpl) FindTransactions(ctx context.Context, cardId uint64) ([]domain.GiftCardTransaction, error) {
+	var transactions []domain.GiftCardTransaction
+	err := dbFromContext(ctx, repository.db).Where("gift_card_id = ?", cardId).Order("created_at, id").Find(&transactions).Error
+	return transactions, err
+}
+
+// SumByCardId - Add up the ledger of a gift card, which should always equal its balance
+func (repository *GiftCardRepositoryImpl) SumByCardId(ctx context.Context, cardId uint64) (money.Money, error) {
+	var sum money.Money
+	err := dbFromContext(ctx, repository.db).Model(&domain.GiftCardTransaction{}).
+		Where("gift_card_id = ?", cardId).
+		Select("CAST(COALESCE(SUM(amount), 0) AS SIGNED)").
+		Scan(&sum).Error
+	return sum, err
+}
+
+// MoveBalance applies transaction.Amount to the balance of the card and appends the transaction to the
+// gift card ledger in one transaction. The update is conditional, so concurrent redemptions never take a
+// card below zero.
+func (repository *GiftCardRepositoryImpl) MoveBalance(ctx context.Context, transaction domain.GiftCardTransaction) (domain.GiftCardTransaction, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&domain.GiftCard{}).Where("id = ?", transaction.GiftCardID)
+		if transaction.Amount < 0 {
+			query = query.Where("balance >= ?", -transaction.Amount)
+		}
+		result := query.Update("balance", gorm.Expr("balance + ?", transaction.Amount))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 && transaction.Amount < 0 {
+			return ErrInsufficientBalance
+		} else if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Create(&transaction).Error
+	})
+	if err != nil {
+		return domain.GiftCardTransaction{}, err
+	}
+	return transaction, nil
+}
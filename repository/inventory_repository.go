@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type InventoryRepository interface {
+	Save(ctx context.Context, inventory domain.Inventory) (domain.Inventory, error)
+	FindByProductId(ctx context.Context, productId uint64) (domain.Product, error)
+	FindAll(ctx context.Context) ([]domain.Product, error)
+	FindLowStock(ctx context.Context, storeId uint64) ([]domain.Product, error)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InventoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewInventoryRepository(db *gorm.DB) InventoryRepository {
+	return &InventoryRepositoryImpl{db: db}
+}
+
+// Save inventory record, there is at most one per product so an existing record is updated in place
+func (repository *InventoryRepositoryImpl) Save(ctx context.Context, inventory domain.Inventory) (domain.Inventory, error) {
+	err := dbFromContext(ctx, repository.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"restock_level", "last_restock"}),
+		}).
+		Create(&inventory).Error
+	if err != nil {
+		return domain.Inventory{}, err
+	}
+	return inventory, nil
+}
+
+// FindByProductId - Get product with its inventory record and store rows, Inventory is nil when none was
+// saved yet
+func (repository *InventoryRepositoryImpl) FindByProductId(ctx context.Context, productId uint64) (domain.Product, error) {
+	var product domain.Product
+	err := dbFromContext(ctx, repository.db).Preload("Inventory").Preload("Stores").Preload("Options").First(&product, productId).Error
+	return product, err
+}
+
+// FindAll - Get all products with their inventory records and store rows
+func (repository *InventoryRepositoryImpl) FindAll(ctx context.Context) ([]domain.Product, error) {
+	var products []domain.Product
+	err := dbFromContext(ctx, repository.db).Preload("Inventory").Preload("Stores").Order("id").Find(&products).Error
+	return products, err
+}
+
+// FindLowStock - Get products at or below their restock level at a store, or over the chain when storeId is
+// 0, furthest below first. A product without an inventory record counts as having a restock level of 0, a
+// store that never stocked a product holds none of it.
+func (repository *InventoryRepositoryImpl) FindLowStock(ctx context.Context, storeId uint64) ([]domain.Product, error) {
+	stock := "products.stock_qty"
+	query := dbFromContext(ctx, repository.db).
+		Preload("Inventory").
+		Preload("Stores").
+		Joins("LEFT JOIN inventories ON inventories.product_id = products.id")
+	if storeId != 0 {
+		stock = "COALESCE(store_products.stock_qty, 0)"
+		query = query.Joins("LEFT JOIN store_products ON store_products.product_id = products.id AND store_products.store_id = ?", storeId)
+	}
+
+	var products []domain.Product
+	err := query.
+		Where(stock + " <= COALESCE(inventories.restock_level, 0)").
+		Order(stock + " - COALESCE(inventories.restock_level, 0)").
+		Order("products.id").
+		Find(&products).Error
+	return products, err
+}
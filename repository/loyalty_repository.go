@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"time"
+)
+
+type LoyaltyRepository interface {
+	SaveRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error)
+	UpdateRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error)
+	DeleteRule(ctx context.Context, rule domain.LoyaltyRule) error
+	FindRuleById(ctx context.Context, ruleId uint64) (domain.LoyaltyRule, error)
+	FindRules(ctx context.Context) ([]domain.LoyaltyRule, error)
+	SaveTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error)
+	UpdateTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error)
+	DeleteTier(ctx context.Context, tier domain.LoyaltyTier) error
+	FindTierById(ctx context.Context, tierId uint64) (domain.LoyaltyTier, error)
+	FindTiers(ctx context.Context) ([]domain.LoyaltyTier, error)
+	FindSetting(ctx context.Context) (domain.LoyaltySetting, error)
+	SaveSetting(ctx context.Context, setting domain.LoyaltySetting) (domain.LoyaltySetting, error)
+	FindByCustomerId(ctx context.Context, customerId uint64) ([]domain.LoyaltyTransaction, error)
+	FindByOrderId(ctx context.Context, orderId uint64) ([]domain.LoyaltyTransaction, error)
+	SumByCustomerId(ctx context.Context, customerId uint64) (int, error)
+	SumSpendSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]money.Money, error)
+	SumEarnedSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]int, error)
+}
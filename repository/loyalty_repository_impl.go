@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoyaltyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoyaltyRepository(db *gorm.DB) LoyaltyRepository {
+	return &LoyaltyRepositoryImpl{db: db}
+}
+
+// SaveRule - Save loyalty earn rule
+func (repository *LoyaltyRepositoryImpl) SaveRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("Category").Create(&rule).Error; err != nil {
+		return domain.LoyaltyRule{}, err
+	}
+	return rule, nil
+}
+
+// UpdateRule - Update loyalty earn rule
+func (repository *LoyaltyRepositoryImpl) UpdateRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("Category").Save(&rule).Error; err != nil {
+		return domain.LoyaltyRule{}, err
+	}
+	return rule, nil
+}
+
+// DeleteRule - Delete loyalty earn rule
+func (repository *LoyaltyRepositoryImpl) DeleteRule(ctx context.Context, rule domain.LoyaltyRule) error {
+	return dbFromContext(ctx, repository.db).Delete(&rule).Error
+}
+
+// FindRuleById - Get loyalty earn rule by ID
+func (repository *LoyaltyRepositoryImpl) FindRuleById(ctx context.Context, ruleId uint64) (domain.LoyaltyRule, error) {
+	var rule domain.LoyaltyRule
+	err := dbFromContext(ctx, repository.db).Preload("Category").First(&rule, ruleId).Error
+	return rule, err
+}
+
+// FindRules - Get all loyalty earn rules
+func (repository *LoyaltyRepositoryImpl) FindRules(ctx context.Context) ([]domain.LoyaltyRule, error) {
+	var rules []domain.LoyaltyRule
+	err := dbFromContext(ctx, repository.db).Preload("Category").Order("id").Find(&rules).Error
+	return rules, err
+}
+
+// SaveTier - Save loyalty tier
+func (repository *LoyaltyRepositoryImpl) SaveTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&tier).Error; err != nil {
+		return domain.LoyaltyTier{}, err
+	}
+	return tier, nil
+}
+
+// UpdateTier - Update loyalty tier
+func (repository *LoyaltyRepositoryImpl) UpdateTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&tier).Error; err != nil {
+		return domain.LoyaltyTier{}, err
+	}
+	return tier, nil
+}
+
+// DeleteTier - Delete loyalty tier, the customers holding it are left without a tier until the next review
+func (repository *LoyaltyRepositoryImpl) DeleteTier(ctx context.Context, tier domain.LoyaltyTier) error {
+	return dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Customer{}).Where("loyalty_tier_id = ?", tier.LoyaltyTierID).Update("loyalty_tier_id", nil).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&tier).Error
+	})
+}
+
+// FindTierById - Get loyalty tier by ID
+func (repository *LoyaltyRepositoryImpl) FindTierById(ctx context.Context, tierId uint64) (domain.LoyaltyTier, error) {
+	var tier domain.LoyaltyTier
+	err := dbFromContext(ctx, repository.db).First(&tier, tierId).Error
+	return tier, err
+}
+
+// FindTiers - Get all loyalty tiers, lowest spend threshold first
+func (repository *LoyaltyRepositoryImpl) FindTiers(ctx context.Context) ([]domain.LoyaltyTier, error) {
+	var tiers []domain.LoyaltyTier
+	err := dbFromContext(ctx, repository.db).Order("threshold, points_threshold, id").Find(&tiers).Error
+	return tiers, err
+}
+
+// FindSetting - Get the loyalty settings, the defaults when they were never saved
+func (repository *LoyaltyRepositoryImpl) FindSetting(ctx context.Context) (domain.LoyaltySetting, error) {
+	var setting domain.LoyaltySetting
+	err := dbFromContext(ctx, repository.db).Order("id").First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.LoyaltySetting{TierBasis: domain.TierBasisSpend}, nil
+	}
+	return setting, err
+}
+
+// SaveSetting - Insert or update the single loyalty settings row
+func (repository *LoyaltyRepositoryImpl) SaveSetting(ctx context.Context, setting domain.LoyaltySetting) (domain.LoyaltySetting, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&setting).Error; err != nil {
+		return domain.LoyaltySetting{}, err
+	}
+	return setting, nil
+}
+
+// FindByCustomerId - Get the loyalty ledger of a customer, oldest first
+func (repository *LoyaltyRepositoryImpl) FindByCustomerId(ctx context.Context, customerId uint64) ([]domain.LoyaltyTransaction, error) {
+	var transactions []domain.LoyaltyTransaction
+	err := dbFromContext(ctx, repository.db).Where("customer_id = ?", customerId).Order("created_at, id").Find(&transactions).Error
+	return transactions, err
+}
+
+// FindByOrderId - Get every loyalty transaction booked for an order
+func (repository *LoyaltyRepositoryImpl) FindByOrderId(ctx context.Context, orderId uint64) ([]domain.LoyaltyTransaction, error) {
+	var transactions []domain.LoyaltyTransaction
+	err := dbFromContext(ctx, repository.db).Where("order_id = ?", orderId).Order("id").Find(&transactions).Error
+	return transactions, err
+}
+
+// SumByCustomerId - Add up the ledger of a customer, which should always equal their points
+func (repository *LoyaltyRepositoryImpl) SumByCustomerId(ctx context.Context, customerId uint64) (int, error) {
+	var sum int
+	err := dbFromContext(ctx, repository.db).Model(&domain.LoyaltyTransaction{}).
+		Where("customer_id = ?", customerId).
+		Select("COALESCE(SUM(points), 0)").
+		Scan(&sum).Error
+	return sum, err
+}
+
+// SumSpendSince - Add up what each customer paid since the given time, less what was refunded to them on
+// returns since then. Points tenders are not spend.
+func (repository *LoyaltyRepositoryImpl) SumSpendSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]money.Money, error) {
+	var rows []struct {
+		CustomerID uint64
+		Amount     money.Money
+	}
+	err := dbFromContext(ctx, repository.db).Raw(`SELECT customer_id, CAST(SUM(amount) AS SIGNED) AS amount FROM (
+			SELECT orders.customer_id, payments.amount FROM payments JOIN orders ON orders.id = payments.order_id
+			WHERE payments.status = ? AND payments.payment_type <> ? AND payments.payment_date >= ? AND orders.customer_id IN ?
+			UNION ALL
+			SELECT orders.customer_id, -order_returns.refund_amount FROM order_returns JOIN orders ON orders.id = order_returns.order_id
+			WHERE order_returns.created_at >= ? AND orders.customer_id IN ?
+		) AS spend GROUP BY customer_id`,
+		domain.PaymentStatusCompleted, domain.PaymentTypePoints, since, customerIds, since, customerIds).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	spend := make(map[uint64]money.Money, len(rows))
+	for _, row := range rows {
+		spend[row.CustomerID] = row.Amount
+	}
+	return spend, nil
+}
+
+// SumEarnedSince - Add up the points each customer earned since the given time, less what was clawed back
+func (repository *LoyaltyRepositoryImpl) SumEarnedSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]int, error) {
+	var rows []struct {
+		CustomerID uint64
+		Points     int
+	}
+	err := dbFromContext(ctx, repository.db).Model(&domain.LoyaltyTransaction{}).
+		Select("customer_id, SUM(points) AS points").
+		Where("type IN ? AND created_at >= ? AND customer_id IN ?",
+			[]string{domain.LoyaltyTypeEarn, domain.LoyaltyTypeClawback}, since, customerIds).
+		Group("customer_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	earned := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		earned[row.CustomerID] = row.Points
+	}
+	return earned, nil
+}
@@ -79,6 +79,21 @@ func (mr *MockCustomerRepositoryMockRecorder) FindById(ctx, customerId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCustomerRepository)(nil).FindById), ctx, customerId)
 }
 
+// MovePoints mocks base method.
+func (m *MockCustomerRepository) MovePoints(ctx context.Context, transaction domain.LoyaltyTransaction) (domain.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePoints", ctx, transaction)
+	ret0, _ := ret[0].(domain.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePoints indicates an expected call of MovePoints.
+func (mr *MockCustomerRepositoryMockRecorder) MovePoints(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePoints", reflect.TypeOf((*MockCustomerRepository)(nil).MovePoints), ctx, transaction)
+}
+
 // Save mocks base method.
 func (m *MockCustomerRepository) Save(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +123,18 @@ func (mr *MockCustomerRepositoryMockRecorder) Update(ctx, customer interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomerRepository)(nil).Update), ctx, customer)
 }
+
+// UpdateTier mocks base method.
+func (m *MockCustomerRepository) UpdateTier(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTier", ctx, customer)
+	ret0, _ := ret[0].(domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTier indicates an expected call of UpdateTier.
+func (mr *MockCustomerRepositoryMockRecorder) UpdateTier(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTier", reflect.TypeOf((*MockCustomerRepository)(nil).UpdateTier), ctx, customer)
+}
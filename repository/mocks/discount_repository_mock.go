@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/discount_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDiscountRepository is a mock of DiscountRepository interface.
+type MockDiscountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiscountRepositoryMockRecorder
+}
+
+// MockDiscountRepositoryMockRecorder is the mock recorder for MockDiscountRepository.
+type MockDiscountRepositoryMockRecorder struct {
+	mock *MockDiscountRepository
+}
+
+// NewMockDiscountRepository creates a new mock instance.
+func NewMockDiscountRepository(ctrl *gomock.Controller) *MockDiscountRepository {
+	mock := &MockDiscountRepository{ctrl: ctrl}
+	mock.recorder = &MockDiscountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiscountRepository) EXPECT() *MockDiscountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountOrderItems mocks base method.
+func (m *MockDiscountRepository) CountOrderItems(ctx context.Context, discountId uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrderItems", ctx, discountId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrderItems indicates an expected call of CountOrderItems.
+func (mr *MockDiscountRepositoryMockRecorder) CountOrderItems(ctx, discountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrderItems", reflect.TypeOf((*MockDiscountRepository)(nil).CountOrderItems), ctx, discountId)
+}
+
+// Delete mocks base method.
+func (m *MockDiscountRepository) Delete(ctx context.Context, discount domain.Discount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, discount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiscountRepositoryMockRecorder) Delete(ctx, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDiscountRepository)(nil).Delete), ctx, discount)
+}
+
+// FindActive mocks base method.
+func (m *MockDiscountRepository) FindActive(ctx context.Context, at time.Time) ([]domain.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, at)
+	ret0, _ := ret[0].([]domain.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockDiscountRepositoryMockRecorder) FindActive(ctx, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockDiscountRepository)(nil).FindActive), ctx, at)
+}
+
+// FindAll mocks base method.
+func (m *MockDiscountRepository) FindAll(ctx context.Context) ([]domain.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDiscountRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDiscountRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockDiscountRepository) FindById(ctx context.Context, discountId uint64) (domain.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, discountId)
+	ret0, _ := ret[0].(domain.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockDiscountRepositoryMockRecorder) FindById(ctx, discountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDiscountRepository)(nil).FindById), ctx, discountId)
+}
+
+// Save mocks base method.
+func (m *MockDiscountRepository) Save(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, discount)
+	ret0, _ := ret[0].(domain.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDiscountRepositoryMockRecorder) Save(ctx, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDiscountRepository)(nil).Save), ctx, discount)
+}
+
+// Update mocks base method.
+func (m *MockDiscountRepository) Update(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, discount)
+	ret0, _ := ret[0].(domain.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDiscountRepositoryMockRecorder) Update(ctx, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDiscountRepository)(nil).Update), ctx, discount)
+}
@@ -50,18 +50,18 @@ func (mr *MockEmployeeRepositoryMockRecorder) Delete(ctx, employee interface{})
 }
 
 // FindAll mocks base method.
-func (m *MockEmployeeRepository) FindAll(ctx context.Context) ([]domain.Employee, error) {
+func (m *MockEmployeeRepository) FindAll(ctx context.Context, storeId uint64) ([]domain.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
 	ret0, _ := ret[0].([]domain.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockEmployeeRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockEmployeeRepositoryMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeRepository)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeRepository)(nil).FindAll), ctx, storeId)
 }
 
 // FindById mocks base method.
@@ -79,6 +79,21 @@ func (mr *MockEmployeeRepositoryMockRecorder) FindById(ctx, employeeId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockEmployeeRepository)(nil).FindById), ctx, employeeId)
 }
 
+// FindByIdForUpdate mocks base method.
+func (m *MockEmployeeRepository) FindByIdForUpdate(ctx context.Context, employeeId uint64) (domain.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, employeeId)
+	ret0, _ := ret[0].(domain.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockEmployeeRepositoryMockRecorder) FindByIdForUpdate(ctx, employeeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByIdForUpdate), ctx, employeeId)
+}
+
 // Save mocks base method.
 func (m *MockEmployeeRepository) Save(ctx context.Context, employee domain.Employee) (domain.Employee, error) {
 	m.ctrl.T.Helper()
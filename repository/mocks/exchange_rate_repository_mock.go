@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/exchange_rate_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRateRepository) Delete(ctx context.Context, rate domain.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRateRepositoryMockRecorder) Delete(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRateRepository)(nil).Delete), ctx, rate)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateRepository) FindAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockExchangeRateRepository) FindById(ctx context.Context, exchangeRateId uint64) (domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, exchangeRateId)
+	ret0, _ := ret[0].(domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindById(ctx, exchangeRateId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindById), ctx, exchangeRateId)
+}
+
+// FindEffective mocks base method.
+func (m *MockExchangeRateRepository) FindEffective(ctx context.Context, currency string, at time.Time) (domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffective", ctx, currency, at)
+	ret0, _ := ret[0].(domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffective indicates an expected call of FindEffective.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindEffective(ctx, currency, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffective", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindEffective), ctx, currency, at)
+}
+
+// Save mocks base method.
+func (m *MockExchangeRateRepository) Save(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, rate)
+	ret0, _ := ret[0].(domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockExchangeRateRepositoryMockRecorder) Save(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockExchangeRateRepository)(nil).Save), ctx, rate)
+}
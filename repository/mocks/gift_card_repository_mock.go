@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/gift_card_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	money "github.com/Kahffi/go-rest-api-test/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGiftCardRepository is a mock of GiftCardRepository interface.
+type MockGiftCardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardRepositoryMockRecorder
+}
+
+// MockGiftCardRepositoryMockRecorder is the mock recorder for MockGiftCardRepository.
+type MockGiftCardRepositoryMockRecorder struct {
+	mock *MockGiftCardRepository
+}
+
+// NewMockGiftCardRepository creates a new mock instance.
+func NewMockGiftCardRepository(ctrl *gomock.Controller) *MockGiftCardRepository {
+	mock := &MockGiftCardRepository{ctrl: ctrl}
+	mock.recorder = &MockGiftCardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardRepository) EXPECT() *MockGiftCardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockGiftCardRepository) FindAll(ctx context.Context) ([]domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGiftCardRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGiftCardRepository)(nil).FindAll), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockGiftCardRepository) FindByCode(ctx context.Context, code string) (domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockGiftCardRepositoryMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockGiftCardRepository)(nil).FindByCode), ctx, code)
+}
+
+// FindById mocks base method.
+func (m *MockGiftCardRepository) FindById(ctx context.Context, cardId uint64) (domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, cardId)
+	ret0, _ := ret[0].(domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockGiftCardRepositoryMockRecorder) FindById(ctx, cardId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockGiftCardRepository)(nil).FindById), ctx, cardId)
+}
+
+// FindExpired mocks base method.
+func (m *MockGiftCardRepository) FindExpired(ctx context.Context, at time.Time) ([]domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", ctx, at)
+	ret0, _ := ret[0].([]domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockGiftCardRepositoryMockRecorder) FindExpired(ctx, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockGiftCardRepository)(nil).FindExpired), ctx, at)
+}
+
+// FindTransactions mocks base method.
+func (m *MockGiftCardRepository) FindTransactions(ctx context.Context, cardId uint64) ([]domain.GiftCardTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactions", ctx, cardId)
+	ret0, _ := ret[0].([]domain.GiftCardTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactions indicates an expected call of FindTransactions.
+func (mr *MockGiftCardRepositoryMockRecorder) FindTransactions(ctx, cardId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactions", reflect.TypeOf((*MockGiftCardRepository)(nil).FindTransactions), ctx, cardId)
+}
+
+// MoveBalance mocks base method.
+func (m *MockGiftCardRepository) MoveBalance(ctx context.Context, transaction domain.GiftCardTransaction) (domain.GiftCardTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBalance", ctx, transaction)
+	ret0, _ := ret[0].(domain.GiftCardTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBalance indicates an expected call of MoveBalance.
+func (mr *MockGiftCardRepositoryMockRecorder) MoveBalance(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBalance", reflect.TypeOf((*MockGiftCardRepository)(nil).MoveBalance), ctx, transaction)
+}
+
+// Save mocks base method.
+func (m *MockGiftCardRepository) Save(ctx context.Context, card domain.GiftCard) (domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, card)
+	ret0, _ := ret[0].(domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockGiftCardRepositoryMockRecorder) Save(ctx, card interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGiftCardRepository)(nil).Save), ctx, card)
+}
+
+// SumByCardId mocks base method.
+func (m *MockGiftCardRepository) SumByCardId(ctx context.Context, cardId uint64) (money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByCardId", ctx, cardId)
+	ret0, _ := ret[0].(money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByCardId indicates an expected call of SumByCardId.
+func (mr *MockGiftCardRepositoryMockRecorder) SumByCardId(ctx, cardId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByCardId", reflect.TypeOf((*MockGiftCardRepository)(nil).SumByCardId), ctx, cardId)
+}
@@ -0,0 +1,291 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/loyalty_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	money "github.com/Kahffi/go-rest-api-test/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoyaltyRepository is a mock of LoyaltyRepository interface.
+type MockLoyaltyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyRepositoryMockRecorder
+}
+
+// MockLoyaltyRepositoryMockRecorder is the mock recorder for MockLoyaltyRepository.
+type MockLoyaltyRepositoryMockRecorder struct {
+	mock *MockLoyaltyRepository
+}
+
+// NewMockLoyaltyRepository creates a new mock instance.
+func NewMockLoyaltyRepository(ctrl *gomock.Controller) *MockLoyaltyRepository {
+	mock := &MockLoyaltyRepository{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyRepository) EXPECT() *MockLoyaltyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRule mocks base method.
+func (m *MockLoyaltyRepository) DeleteRule(ctx context.Context, rule domain.LoyaltyRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockLoyaltyRepositoryMockRecorder) DeleteRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockLoyaltyRepository)(nil).DeleteRule), ctx, rule)
+}
+
+// DeleteTier mocks base method.
+func (m *MockLoyaltyRepository) DeleteTier(ctx context.Context, tier domain.LoyaltyTier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTier", ctx, tier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTier indicates an expected call of DeleteTier.
+func (mr *MockLoyaltyRepositoryMockRecorder) DeleteTier(ctx, tier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTier", reflect.TypeOf((*MockLoyaltyRepository)(nil).DeleteTier), ctx, tier)
+}
+
+// FindByCustomerId mocks base method.
+func (m *MockLoyaltyRepository) FindByCustomerId(ctx context.Context, customerId uint64) ([]domain.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomerId", ctx, customerId)
+	ret0, _ := ret[0].([]domain.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCustomerId indicates an expected call of FindByCustomerId.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindByCustomerId(ctx, customerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomerId", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindByCustomerId), ctx, customerId)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockLoyaltyRepository) FindByOrderId(ctx context.Context, orderId uint64) ([]domain.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].([]domain.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindByOrderId), ctx, orderId)
+}
+
+// FindRuleById mocks base method.
+func (m *MockLoyaltyRepository) FindRuleById(ctx context.Context, ruleId uint64) (domain.LoyaltyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuleById", ctx, ruleId)
+	ret0, _ := ret[0].(domain.LoyaltyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRuleById indicates an expected call of FindRuleById.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindRuleById(ctx, ruleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuleById", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindRuleById), ctx, ruleId)
+}
+
+// FindRules mocks base method.
+func (m *MockLoyaltyRepository) FindRules(ctx context.Context) ([]domain.LoyaltyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRules", ctx)
+	ret0, _ := ret[0].([]domain.LoyaltyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRules indicates an expected call of FindRules.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRules", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindRules), ctx)
+}
+
+// FindSetting mocks base method.
+func (m *MockLoyaltyRepository) FindSetting(ctx context.Context) (domain.LoyaltySetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSetting", ctx)
+	ret0, _ := ret[0].(domain.LoyaltySetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSetting indicates an expected call of FindSetting.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindSetting(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSetting", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindSetting), ctx)
+}
+
+// FindTierById mocks base method.
+func (m *MockLoyaltyRepository) FindTierById(ctx context.Context, tierId uint64) (domain.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTierById", ctx, tierId)
+	ret0, _ := ret[0].(domain.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTierById indicates an expected call of FindTierById.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindTierById(ctx, tierId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTierById", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindTierById), ctx, tierId)
+}
+
+// FindTiers mocks base method.
+func (m *MockLoyaltyRepository) FindTiers(ctx context.Context) ([]domain.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTiers", ctx)
+	ret0, _ := ret[0].([]domain.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTiers indicates an expected call of FindTiers.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindTiers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTiers", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindTiers), ctx)
+}
+
+// SaveRule mocks base method.
+func (m *MockLoyaltyRepository) SaveRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRule", ctx, rule)
+	ret0, _ := ret[0].(domain.LoyaltyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveRule indicates an expected call of SaveRule.
+func (mr *MockLoyaltyRepositoryMockRecorder) SaveRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRule", reflect.TypeOf((*MockLoyaltyRepository)(nil).SaveRule), ctx, rule)
+}
+
+// SaveSetting mocks base method.
+func (m *MockLoyaltyRepository) SaveSetting(ctx context.Context, setting domain.LoyaltySetting) (domain.LoyaltySetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSetting", ctx, setting)
+	ret0, _ := ret[0].(domain.LoyaltySetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSetting indicates an expected call of SaveSetting.
+func (mr *MockLoyaltyRepositoryMockRecorder) SaveSetting(ctx, setting interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSetting", reflect.TypeOf((*MockLoyaltyRepository)(nil).SaveSetting), ctx, setting)
+}
+
+// SaveTier mocks base method.
+func (m *MockLoyaltyRepository) SaveTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTier", ctx, tier)
+	ret0, _ := ret[0].(domain.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTier indicates an expected call of SaveTier.
+func (mr *MockLoyaltyRepositoryMockRecorder) SaveTier(ctx, tier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTier", reflect.TypeOf((*MockLoyaltyRepository)(nil).SaveTier), ctx, tier)
+}
+
+// SumByCustomerId mocks base method.
+func (m *MockLoyaltyRepository) SumByCustomerId(ctx context.Context, customerId uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByCustomerId", ctx, customerId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByCustomerId indicates an expected call of SumByCustomerId.
+func (mr *MockLoyaltyRepositoryMockRecorder) SumByCustomerId(ctx, customerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByCustomerId", reflect.TypeOf((*MockLoyaltyRepository)(nil).SumByCustomerId), ctx, customerId)
+}
+
+// SumEarnedSince mocks base method.
+func (m *MockLoyaltyRepository) SumEarnedSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumEarnedSince", ctx, since, customerIds)
+	ret0, _ := ret[0].(map[uint64]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumEarnedSince indicates an expected call of SumEarnedSince.
+func (mr *MockLoyaltyRepositoryMockRecorder) SumEarnedSince(ctx, since, customerIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumEarnedSince", reflect.TypeOf((*MockLoyaltyRepository)(nil).SumEarnedSince), ctx, since, customerIds)
+}
+
+// SumSpendSince mocks base method.
+func (m *MockLoyaltyRepository) SumSpendSince(ctx context.Context, since time.Time, customerIds []uint64) (map[uint64]money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumSpendSince", ctx, since, customerIds)
+	ret0, _ := ret[0].(map[uint64]money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumSpendSince indicates an expected call of SumSpendSince.
+func (mr *MockLoyaltyRepositoryMockRecorder) SumSpendSince(ctx, since, customerIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumSpendSince", reflect.TypeOf((*MockLoyaltyRepository)(nil).SumSpendSince), ctx, since, customerIds)
+}
+
+// UpdateRule mocks base method.
+func (m *MockLoyaltyRepository) UpdateRule(ctx context.Context, rule domain.LoyaltyRule) (domain.LoyaltyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, rule)
+	ret0, _ := ret[0].(domain.LoyaltyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockLoyaltyRepositoryMockRecorder) UpdateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockLoyaltyRepository)(nil).UpdateRule), ctx, rule)
+}
+
+// UpdateTier mocks base method.
+func (m *MockLoyaltyRepository) UpdateTier(ctx context.Context, tier domain.LoyaltyTier) (domain.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTier", ctx, tier)
+	ret0, _ := ret[0].(domain.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTier indicates an expected call of UpdateTier.
+func (mr *MockLoyaltyRepositoryMockRecorder) UpdateTier(ctx, tier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTier", reflect.TypeOf((*MockLoyaltyRepository)(nil).UpdateTier), ctx, tier)
+}
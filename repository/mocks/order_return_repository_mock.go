@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/order_return_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderReturnRepository is a mock of OrderReturnRepository interface.
+type MockOrderReturnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderReturnRepositoryMockRecorder
+}
+
+// MockOrderReturnRepositoryMockRecorder is the mock recorder for MockOrderReturnRepository.
+type MockOrderReturnRepositoryMockRecorder struct {
+	mock *MockOrderReturnRepository
+}
+
+// NewMockOrderReturnRepository creates a new mock instance.
+func NewMockOrderReturnRepository(ctrl *gomock.Controller) *MockOrderReturnRepository {
+	mock := &MockOrderReturnRepository{ctrl: ctrl}
+	mock.recorder = &MockOrderReturnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderReturnRepository) EXPECT() *MockOrderReturnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByOrderId mocks base method.
+func (m *MockOrderReturnRepository) FindByOrderId(ctx context.Context, orderId uint64) ([]domain.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].([]domain.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockOrderReturnRepositoryMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockOrderReturnRepository)(nil).FindByOrderId), ctx, orderId)
+}
+
+// Save mocks base method.
+func (m *MockOrderReturnRepository) Save(ctx context.Context, orderReturn domain.OrderReturn) (domain.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, orderReturn)
+	ret0, _ := ret[0].(domain.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrderReturnRepositoryMockRecorder) Save(ctx, orderReturn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderReturnRepository)(nil).Save), ctx, orderReturn)
+}
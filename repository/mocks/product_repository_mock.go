@@ -64,6 +64,21 @@ func (mr *MockProductRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductRepository)(nil).FindAll), ctx)
 }
 
+// FindByCategoryId mocks base method.
+func (m *MockProductRepository) FindByCategoryId(ctx context.Context, categoryId uint64) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCategoryId", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCategoryId indicates an expected call of FindByCategoryId.
+func (mr *MockProductRepositoryMockRecorder) FindByCategoryId(ctx, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCategoryId", reflect.TypeOf((*MockProductRepository)(nil).FindByCategoryId), ctx, categoryId)
+}
+
 // FindById mocks base method.
 func (m *MockProductRepository) FindById(ctx context.Context, productId uint64) (domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,51 @@ func (mr *MockProductRepositoryMockRecorder) FindById(ctx, productId interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductRepository)(nil).FindById), ctx, productId)
 }
 
+// FindByIdsForUpdate mocks base method.
+func (m *MockProductRepository) FindByIdsForUpdate(ctx context.Context, productIds []uint64) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdsForUpdate", ctx, productIds)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdsForUpdate indicates an expected call of FindByIdsForUpdate.
+func (mr *MockProductRepositoryMockRecorder) FindByIdsForUpdate(ctx, productIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdsForUpdate", reflect.TypeOf((*MockProductRepository)(nil).FindByIdsForUpdate), ctx, productIds)
+}
+
+// FindCatalog mocks base method.
+func (m *MockProductRepository) FindCatalog(ctx context.Context) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCatalog", ctx)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCatalog indicates an expected call of FindCatalog.
+func (mr *MockProductRepositoryMockRecorder) FindCatalog(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCatalog", reflect.TypeOf((*MockProductRepository)(nil).FindCatalog), ctx)
+}
+
+// MoveStock mocks base method.
+func (m *MockProductRepository) MoveStock(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveStock", ctx, movement)
+	ret0, _ := ret[0].(domain.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveStock indicates an expected call of MoveStock.
+func (mr *MockProductRepositoryMockRecorder) MoveStock(ctx, movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveStock", reflect.TypeOf((*MockProductRepository)(nil).MoveStock), ctx, movement)
+}
+
 // Save mocks base method.
 func (m *MockProductRepository) Save(ctx context.Context, product domain.Product) (domain.Product, error) {
 	m.ctrl.T.Helper()
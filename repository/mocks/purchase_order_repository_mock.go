@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/purchase_order_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchaseOrderRepository is a mock of PurchaseOrderRepository interface.
+type MockPurchaseOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseOrderRepositoryMockRecorder
+}
+
+// MockPurchaseOrderRepositoryMockRecorder is the mock recorder for MockPurchaseOrderRepository.
+type MockPurchaseOrderRepositoryMockRecorder struct {
+	mock *MockPurchaseOrderRepository
+}
+
+// NewMockPurchaseOrderRepository creates a new mock instance.
+func NewMockPurchaseOrderRepository(ctrl *gomock.Controller) *MockPurchaseOrderRepository {
+	mock := &MockPurchaseOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockPurchaseOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseOrderRepository) EXPECT() *MockPurchaseOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPurchaseOrderRepository) Delete(ctx context.Context, purchaseOrder domain.PurchaseOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, purchaseOrder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) Delete(ctx, purchaseOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).Delete), ctx, purchaseOrder)
+}
+
+// FindAll mocks base method.
+func (m *MockPurchaseOrderRepository) FindAll(ctx context.Context, storeId uint64) ([]domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockPurchaseOrderRepository) FindById(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, purchaseOrderId)
+	ret0, _ := ret[0].(domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) FindById(ctx, purchaseOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).FindById), ctx, purchaseOrderId)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockPurchaseOrderRepository) FindByIdForUpdate(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, purchaseOrderId)
+	ret0, _ := ret[0].(domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) FindByIdForUpdate(ctx, purchaseOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).FindByIdForUpdate), ctx, purchaseOrderId)
+}
+
+// Save mocks base method.
+func (m *MockPurchaseOrderRepository) Save(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, purchaseOrder)
+	ret0, _ := ret[0].(domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) Save(ctx, purchaseOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).Save), ctx, purchaseOrder)
+}
+
+// Update mocks base method.
+func (m *MockPurchaseOrderRepository) Update(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, purchaseOrder)
+	ret0, _ := ret[0].(domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) Update(ctx, purchaseOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).Update), ctx, purchaseOrder)
+}
+
+// UpdateReceivedQty mocks base method.
+func (m *MockPurchaseOrderRepository) UpdateReceivedQty(ctx context.Context, line domain.PurchaseOrderLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReceivedQty", ctx, line)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReceivedQty indicates an expected call of UpdateReceivedQty.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) UpdateReceivedQty(ctx, line interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceivedQty", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).UpdateReceivedQty), ctx, line)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPurchaseOrderRepository) UpdateStatus(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, purchaseOrder)
+	ret0, _ := ret[0].(domain.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPurchaseOrderRepositoryMockRecorder) UpdateStatus(ctx, purchaseOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPurchaseOrderRepository)(nil).UpdateStatus), ctx, purchaseOrder)
+}
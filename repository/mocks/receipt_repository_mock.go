@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/receipt_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptRepository is a mock of ReceiptRepository interface.
+type MockReceiptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptRepositoryMockRecorder
+}
+
+// MockReceiptRepositoryMockRecorder is the mock recorder for MockReceiptRepository.
+type MockReceiptRepositoryMockRecorder struct {
+	mock *MockReceiptRepository
+}
+
+// NewMockReceiptRepository creates a new mock instance.
+func NewMockReceiptRepository(ctrl *gomock.Controller) *MockReceiptRepository {
+	mock := &MockReceiptRepository{ctrl: ctrl}
+	mock.recorder = &MockReceiptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptRepository) EXPECT() *MockReceiptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockReceiptRepository) FindById(ctx context.Context, receiptId uint64) (domain.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, receiptId)
+	ret0, _ := ret[0].(domain.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReceiptRepositoryMockRecorder) FindById(ctx, receiptId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReceiptRepository)(nil).FindById), ctx, receiptId)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockReceiptRepository) FindByOrderId(ctx context.Context, orderId uint64) (domain.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].(domain.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockReceiptRepositoryMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockReceiptRepository)(nil).FindByOrderId), ctx, orderId)
+}
+
+// Save mocks base method.
+func (m *MockReceiptRepository) Save(ctx context.Context, receipt domain.Receipt) (domain.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, receipt)
+	ret0, _ := ret[0].(domain.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReceiptRepositoryMockRecorder) Save(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReceiptRepository)(nil).Save), ctx, receipt)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/report_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SumSales mocks base method.
+func (m *MockReportRepository) SumSales(ctx context.Context, groupBy string, from, to time.Time, storeId uint64) ([]domain.SalesReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumSales", ctx, groupBy, from, to, storeId)
+	ret0, _ := ret[0].([]domain.SalesReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumSales indicates an expected call of SumSales.
+func (mr *MockReportRepositoryMockRecorder) SumSales(ctx, groupBy, from, to, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumSales", reflect.TypeOf((*MockReportRepository)(nil).SumSales), ctx, groupBy, from, to, storeId)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/shift_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	money "github.com/Kahffi/go-rest-api-test/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShiftRepository is a mock of ShiftRepository interface.
+type MockShiftRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftRepositoryMockRecorder
+}
+
+// MockShiftRepositoryMockRecorder is the mock recorder for MockShiftRepository.
+type MockShiftRepositoryMockRecorder struct {
+	mock *MockShiftRepository
+}
+
+// NewMockShiftRepository creates a new mock instance.
+func NewMockShiftRepository(ctrl *gomock.Controller) *MockShiftRepository {
+	mock := &MockShiftRepository{ctrl: ctrl}
+	mock.recorder = &MockShiftRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftRepository) EXPECT() *MockShiftRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShiftRepository) Close(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, shift)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShiftRepositoryMockRecorder) Close(ctx, shift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShiftRepository)(nil).Close), ctx, shift)
+}
+
+// FindAll mocks base method.
+func (m *MockShiftRepository) FindAll(ctx context.Context, storeId uint64) ([]domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockShiftRepositoryMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockShiftRepository)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockShiftRepository) FindById(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, shiftId)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockShiftRepositoryMockRecorder) FindById(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockShiftRepository)(nil).FindById), ctx, shiftId)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockShiftRepository) FindByIdForUpdate(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, shiftId)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockShiftRepositoryMockRecorder) FindByIdForUpdate(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockShiftRepository)(nil).FindByIdForUpdate), ctx, shiftId)
+}
+
+// FindOpen mocks base method.
+func (m *MockShiftRepository) FindOpen(ctx context.Context) ([]domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpen", ctx)
+	ret0, _ := ret[0].([]domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpen indicates an expected call of FindOpen.
+func (mr *MockShiftRepositoryMockRecorder) FindOpen(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockShiftRepository)(nil).FindOpen), ctx)
+}
+
+// FindPayments mocks base method.
+func (m *MockShiftRepository) FindPayments(ctx context.Context, shiftId uint64) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayments", ctx, shiftId)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayments indicates an expected call of FindPayments.
+func (mr *MockShiftRepositoryMockRecorder) FindPayments(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayments", reflect.TypeOf((*MockShiftRepository)(nil).FindPayments), ctx, shiftId)
+}
+
+// FindRefundPaymentIds mocks base method.
+func (m *MockShiftRepository) FindRefundPaymentIds(ctx context.Context, shiftId uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRefundPaymentIds", ctx, shiftId)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRefundPaymentIds indicates an expected call of FindRefundPaymentIds.
+func (mr *MockShiftRepositoryMockRecorder) FindRefundPaymentIds(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefundPaymentIds", reflect.TypeOf((*MockShiftRepository)(nil).FindRefundPaymentIds), ctx, shiftId)
+}
+
+// Save mocks base method.
+func (m *MockShiftRepository) Save(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, shift)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockShiftRepositoryMockRecorder) Save(ctx, shift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockShiftRepository)(nil).Save), ctx, shift)
+}
+
+// SaveCashMovement mocks base method.
+func (m *MockShiftRepository) SaveCashMovement(ctx context.Context, movement domain.CashMovement) (domain.CashMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCashMovement", ctx, movement)
+	ret0, _ := ret[0].(domain.CashMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCashMovement indicates an expected call of SaveCashMovement.
+func (mr *MockShiftRepositoryMockRecorder) SaveCashMovement(ctx, movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCashMovement", reflect.TypeOf((*MockShiftRepository)(nil).SaveCashMovement), ctx, movement)
+}
+
+// SumOrderDiscounts mocks base method.
+func (m *MockShiftRepository) SumOrderDiscounts(ctx context.Context, shiftId uint64) (money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumOrderDiscounts", ctx, shiftId)
+	ret0, _ := ret[0].(money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumOrderDiscounts indicates an expected call of SumOrderDiscounts.
+func (mr *MockShiftRepositoryMockRecorder) SumOrderDiscounts(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumOrderDiscounts", reflect.TypeOf((*MockShiftRepository)(nil).SumOrderDiscounts), ctx, shiftId)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/stock_movement_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStockMovementRepository is a mock of StockMovementRepository interface.
+type MockStockMovementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockMovementRepositoryMockRecorder
+}
+
+// MockStockMovementRepositoryMockRecorder is the mock recorder for MockStockMovementRepository.
+type MockStockMovementRepositoryMockRecorder struct {
+	mock *MockStockMovementRepository
+}
+
+// NewMockStockMovementRepository creates a new mock instance.
+func NewMockStockMovementRepository(ctrl *gomock.Controller) *MockStockMovementRepository {
+	mock := &MockStockMovementRepository{ctrl: ctrl}
+	mock.recorder = &MockStockMovementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockMovementRepository) EXPECT() *MockStockMovementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByProductId mocks base method.
+func (m *MockStockMovementRepository) FindByProductId(ctx context.Context, productId, storeId uint64) ([]domain.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductId", ctx, productId, storeId)
+	ret0, _ := ret[0].([]domain.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProductId indicates an expected call of FindByProductId.
+func (mr *MockStockMovementRepositoryMockRecorder) FindByProductId(ctx, productId, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductId", reflect.TypeOf((*MockStockMovementRepository)(nil).FindByProductId), ctx, productId, storeId)
+}
+
+// SumByProductId mocks base method.
+func (m *MockStockMovementRepository) SumByProductId(ctx context.Context, productId uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByProductId", ctx, productId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByProductId indicates an expected call of SumByProductId.
+func (mr *MockStockMovementRepositoryMockRecorder) SumByProductId(ctx, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByProductId", reflect.TypeOf((*MockStockMovementRepository)(nil).SumByProductId), ctx, productId)
+}
+
+// SumByStore mocks base method.
+func (m *MockStockMovementRepository) SumByStore(ctx context.Context, productId uint64) (map[uint64]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByStore", ctx, productId)
+	ret0, _ := ret[0].(map[uint64]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByStore indicates an expected call of SumByStore.
+func (mr *MockStockMovementRepositoryMockRecorder) SumByStore(ctx, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByStore", reflect.TypeOf((*MockStockMovementRepository)(nil).SumByStore), ctx, productId)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/stock_transfer_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStockTransferRepository is a mock of StockTransferRepository interface.
+type MockStockTransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockTransferRepositoryMockRecorder
+}
+
+// MockStockTransferRepositoryMockRecorder is the mock recorder for MockStockTransferRepository.
+type MockStockTransferRepositoryMockRecorder struct {
+	mock *MockStockTransferRepository
+}
+
+// NewMockStockTransferRepository creates a new mock instance.
+func NewMockStockTransferRepository(ctrl *gomock.Controller) *MockStockTransferRepository {
+	mock := &MockStockTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockStockTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockTransferRepository) EXPECT() *MockStockTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStockTransferRepository) Delete(ctx context.Context, transfer domain.StockTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStockTransferRepositoryMockRecorder) Delete(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStockTransferRepository)(nil).Delete), ctx, transfer)
+}
+
+// FindAll mocks base method.
+func (m *MockStockTransferRepository) FindAll(ctx context.Context, storeId uint64) ([]domain.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]domain.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStockTransferRepositoryMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStockTransferRepository)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockStockTransferRepository) FindById(ctx context.Context, transferId uint64) (domain.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, transferId)
+	ret0, _ := ret[0].(domain.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStockTransferRepositoryMockRecorder) FindById(ctx, transferId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStockTransferRepository)(nil).FindById), ctx, transferId)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockStockTransferRepository) FindByIdForUpdate(ctx context.Context, transferId uint64) (domain.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, transferId)
+	ret0, _ := ret[0].(domain.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockStockTransferRepositoryMockRecorder) FindByIdForUpdate(ctx, transferId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockStockTransferRepository)(nil).FindByIdForUpdate), ctx, transferId)
+}
+
+// Save mocks base method.
+func (m *MockStockTransferRepository) Save(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, transfer)
+	ret0, _ := ret[0].(domain.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStockTransferRepositoryMockRecorder) Save(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStockTransferRepository)(nil).Save), ctx, transfer)
+}
+
+// UpdateLine mocks base method.
+func (m *MockStockTransferRepository) UpdateLine(ctx context.Context, line domain.StockTransferLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLine", ctx, line)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLine indicates an expected call of UpdateLine.
+func (mr *MockStockTransferRepositoryMockRecorder) UpdateLine(ctx, line interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLine", reflect.TypeOf((*MockStockTransferRepository)(nil).UpdateLine), ctx, line)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockStockTransferRepository) UpdateStatus(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, transfer)
+	ret0, _ := ret[0].(domain.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockStockTransferRepositoryMockRecorder) UpdateStatus(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockStockTransferRepository)(nil).UpdateStatus), ctx, transfer)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/stocktake_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStocktakeRepository is a mock of StocktakeRepository interface.
+type MockStocktakeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStocktakeRepositoryMockRecorder
+}
+
+// MockStocktakeRepositoryMockRecorder is the mock recorder for MockStocktakeRepository.
+type MockStocktakeRepositoryMockRecorder struct {
+	mock *MockStocktakeRepository
+}
+
+// NewMockStocktakeRepository creates a new mock instance.
+func NewMockStocktakeRepository(ctrl *gomock.Controller) *MockStocktakeRepository {
+	mock := &MockStocktakeRepository{ctrl: ctrl}
+	mock.recorder = &MockStocktakeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStocktakeRepository) EXPECT() *MockStocktakeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockStocktakeRepository) FindAll(ctx context.Context, storeId uint64) ([]domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStocktakeRepositoryMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStocktakeRepository)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockStocktakeRepository) FindById(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, stocktakeId)
+	ret0, _ := ret[0].(domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStocktakeRepositoryMockRecorder) FindById(ctx, stocktakeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStocktakeRepository)(nil).FindById), ctx, stocktakeId)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockStocktakeRepository) FindByIdForUpdate(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, stocktakeId)
+	ret0, _ := ret[0].(domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockStocktakeRepositoryMockRecorder) FindByIdForUpdate(ctx, stocktakeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockStocktakeRepository)(nil).FindByIdForUpdate), ctx, stocktakeId)
+}
+
+// FindOpen mocks base method.
+func (m *MockStocktakeRepository) FindOpen(ctx context.Context) ([]domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpen", ctx)
+	ret0, _ := ret[0].([]domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpen indicates an expected call of FindOpen.
+func (mr *MockStocktakeRepositoryMockRecorder) FindOpen(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockStocktakeRepository)(nil).FindOpen), ctx)
+}
+
+// Save mocks base method.
+func (m *MockStocktakeRepository) Save(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, stocktake)
+	ret0, _ := ret[0].(domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStocktakeRepositoryMockRecorder) Save(ctx, stocktake interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStocktakeRepository)(nil).Save), ctx, stocktake)
+}
+
+// SaveCount mocks base method.
+func (m *MockStocktakeRepository) SaveCount(ctx context.Context, count domain.StocktakeCount) (domain.StocktakeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCount", ctx, count)
+	ret0, _ := ret[0].(domain.StocktakeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCount indicates an expected call of SaveCount.
+func (mr *MockStocktakeRepositoryMockRecorder) SaveCount(ctx, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCount", reflect.TypeOf((*MockStocktakeRepository)(nil).SaveCount), ctx, count)
+}
+
+// UpdateReasonCode mocks base method.
+func (m *MockStocktakeRepository) UpdateReasonCode(ctx context.Context, line domain.StocktakeLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReasonCode", ctx, line)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReasonCode indicates an expected call of UpdateReasonCode.
+func (mr *MockStocktakeRepositoryMockRecorder) UpdateReasonCode(ctx, line interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReasonCode", reflect.TypeOf((*MockStocktakeRepository)(nil).UpdateReasonCode), ctx, line)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockStocktakeRepository) UpdateStatus(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, stocktake)
+	ret0, _ := ret[0].(domain.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockStocktakeRepositoryMockRecorder) UpdateStatus(ctx, stocktake interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockStocktakeRepository)(nil).UpdateStatus), ctx, stocktake)
+}
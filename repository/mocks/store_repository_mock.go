@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/store_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStoreRepository is a mock of StoreRepository interface.
+type MockStoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreRepositoryMockRecorder
+}
+
+// MockStoreRepositoryMockRecorder is the mock recorder for MockStoreRepository.
+type MockStoreRepositoryMockRecorder struct {
+	mock *MockStoreRepository
+}
+
+// NewMockStoreRepository creates a new mock instance.
+func NewMockStoreRepository(ctrl *gomock.Controller) *MockStoreRepository {
+	mock := &MockStoreRepository{ctrl: ctrl}
+	mock.recorder = &MockStoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreRepository) EXPECT() *MockStoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockStoreRepository) FindAll(ctx context.Context) ([]domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStoreRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStoreRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockStoreRepository) FindById(ctx context.Context, storeId uint64) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStoreRepositoryMockRecorder) FindById(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStoreRepository)(nil).FindById), ctx, storeId)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockStoreRepository) FindByIdForUpdate(ctx context.Context, storeId uint64) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockStoreRepositoryMockRecorder) FindByIdForUpdate(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockStoreRepository)(nil).FindByIdForUpdate), ctx, storeId)
+}
+
+// Save mocks base method.
+func (m *MockStoreRepository) Save(ctx context.Context, store domain.Store) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, store)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStoreRepositoryMockRecorder) Save(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStoreRepository)(nil).Save), ctx, store)
+}
+
+// SavePrice mocks base method.
+func (m *MockStoreRepository) SavePrice(ctx context.Context, storeProduct domain.StoreProduct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrice", ctx, storeProduct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrice indicates an expected call of SavePrice.
+func (mr *MockStoreRepositoryMockRecorder) SavePrice(ctx, storeProduct interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrice", reflect.TypeOf((*MockStoreRepository)(nil).SavePrice), ctx, storeProduct)
+}
+
+// Update mocks base method.
+func (m *MockStoreRepository) Update(ctx context.Context, store domain.Store) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, store)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStoreRepositoryMockRecorder) Update(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStoreRepository)(nil).Update), ctx, store)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/supplier_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSupplierRepository is a mock of SupplierRepository interface.
+type MockSupplierRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupplierRepositoryMockRecorder
+}
+
+// MockSupplierRepositoryMockRecorder is the mock recorder for MockSupplierRepository.
+type MockSupplierRepositoryMockRecorder struct {
+	mock *MockSupplierRepository
+}
+
+// NewMockSupplierRepository creates a new mock instance.
+func NewMockSupplierRepository(ctrl *gomock.Controller) *MockSupplierRepository {
+	mock := &MockSupplierRepository{ctrl: ctrl}
+	mock.recorder = &MockSupplierRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupplierRepository) EXPECT() *MockSupplierRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountPurchaseOrders mocks base method.
+func (m *MockSupplierRepository) CountPurchaseOrders(ctx context.Context, supplierId uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPurchaseOrders", ctx, supplierId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPurchaseOrders indicates an expected call of CountPurchaseOrders.
+func (mr *MockSupplierRepositoryMockRecorder) CountPurchaseOrders(ctx, supplierId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPurchaseOrders", reflect.TypeOf((*MockSupplierRepository)(nil).CountPurchaseOrders), ctx, supplierId)
+}
+
+// Delete mocks base method.
+func (m *MockSupplierRepository) Delete(ctx context.Context, supplier domain.Supplier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, supplier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSupplierRepositoryMockRecorder) Delete(ctx, supplier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSupplierRepository)(nil).Delete), ctx, supplier)
+}
+
+// FindAll mocks base method.
+func (m *MockSupplierRepository) FindAll(ctx context.Context) ([]domain.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSupplierRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSupplierRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockSupplierRepository) FindById(ctx context.Context, supplierId uint64) (domain.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, supplierId)
+	ret0, _ := ret[0].(domain.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockSupplierRepositoryMockRecorder) FindById(ctx, supplierId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSupplierRepository)(nil).FindById), ctx, supplierId)
+}
+
+// Save mocks base method.
+func (m *MockSupplierRepository) Save(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, supplier)
+	ret0, _ := ret[0].(domain.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSupplierRepositoryMockRecorder) Save(ctx, supplier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSupplierRepository)(nil).Save), ctx, supplier)
+}
+
+// Update mocks base method.
+func (m *MockSupplierRepository) Update(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, supplier)
+	ret0, _ := ret[0].(domain.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSupplierRepositoryMockRecorder) Update(ctx, supplier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSupplierRepository)(nil).Update), ctx, supplier)
+}
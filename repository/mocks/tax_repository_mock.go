@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/tax_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxRepository is a mock of TaxRepository interface.
+type MockTaxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRepositoryMockRecorder
+}
+
+// MockTaxRepositoryMockRecorder is the mock recorder for MockTaxRepository.
+type MockTaxRepositoryMockRecorder struct {
+	mock *MockTaxRepository
+}
+
+// NewMockTaxRepository creates a new mock instance.
+func NewMockTaxRepository(ctrl *gomock.Controller) *MockTaxRepository {
+	mock := &MockTaxRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRepository) EXPECT() *MockTaxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAmounts mocks base method.
+func (m *MockTaxRepository) CountAmounts(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAmounts", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAmounts indicates an expected call of CountAmounts.
+func (mr *MockTaxRepositoryMockRecorder) CountAmounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAmounts", reflect.TypeOf((*MockTaxRepository)(nil).CountAmounts), ctx)
+}
+
+// CountProducts mocks base method.
+func (m *MockTaxRepository) CountProducts(ctx context.Context, taxId uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProducts", ctx, taxId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProducts indicates an expected call of CountProducts.
+func (mr *MockTaxRepositoryMockRecorder) CountProducts(ctx, taxId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProducts", reflect.TypeOf((*MockTaxRepository)(nil).CountProducts), ctx, taxId)
+}
+
+// Delete mocks base method.
+func (m *MockTaxRepository) Delete(ctx context.Context, tax domain.Tax) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tax)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRepositoryMockRecorder) Delete(ctx, tax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRepository)(nil).Delete), ctx, tax)
+}
+
+// FindAll mocks base method.
+func (m *MockTaxRepository) FindAll(ctx context.Context) ([]domain.Tax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Tax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTaxRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTaxRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockTaxRepository) FindById(ctx context.Context, taxId uint64) (domain.Tax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, taxId)
+	ret0, _ := ret[0].(domain.Tax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTaxRepositoryMockRecorder) FindById(ctx, taxId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTaxRepository)(nil).FindById), ctx, taxId)
+}
+
+// FindSetting mocks base method.
+func (m *MockTaxRepository) FindSetting(ctx context.Context) (domain.StoreSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSetting", ctx)
+	ret0, _ := ret[0].(domain.StoreSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSetting indicates an expected call of FindSetting.
+func (mr *MockTaxRepositoryMockRecorder) FindSetting(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSetting", reflect.TypeOf((*MockTaxRepository)(nil).FindSetting), ctx)
+}
+
+// Save mocks base method.
+func (m *MockTaxRepository) Save(ctx context.Context, tax domain.Tax) (domain.Tax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tax)
+	ret0, _ := ret[0].(domain.Tax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTaxRepositoryMockRecorder) Save(ctx, tax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTaxRepository)(nil).Save), ctx, tax)
+}
+
+// SaveSetting mocks base method.
+func (m *MockTaxRepository) SaveSetting(ctx context.Context, setting domain.StoreSetting) (domain.StoreSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSetting", ctx, setting)
+	ret0, _ := ret[0].(domain.StoreSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSetting indicates an expected call of SaveSetting.
+func (mr *MockTaxRepositoryMockRecorder) SaveSetting(ctx, setting interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSetting", reflect.TypeOf((*MockTaxRepository)(nil).SaveSetting), ctx, setting)
+}
+
+// Update mocks base method.
+func (m *MockTaxRepository) Update(ctx context.Context, tax domain.Tax) (domain.Tax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tax)
+	ret0, _ := ret[0].(domain.Tax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxRepositoryMockRecorder) Update(ctx, tax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxRepository)(nil).Update), ctx, tax)
+}
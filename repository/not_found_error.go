@@ -0,0 +1,17 @@
+package repository
+
+import "gorm.io/gorm"
+
+// notFoundError carries a repository's own not-found message while still matching gorm.ErrRecordNotFound,
+// so services keep translating it with errors.Is
+type notFoundError struct {
+	message string
+}
+
+func (err notFoundError) Error() string {
+	return err.message
+}
+
+func (err notFoundError) Unwrap() error {
+	return gorm.ErrRecordNotFound
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type OrderRepository interface {
+	Save(ctx context.Context, order domain.Order) (domain.Order, error)
+	UpdateStatus(ctx context.Context, order domain.Order) (domain.Order, error)
+	UpdatePricing(ctx context.Context, order domain.Order) (domain.Order, error)
+	FindById(ctx context.Context, orderId uint64) (domain.Order, error)
+	FindByIdForUpdate(ctx context.Context, orderId uint64) (domain.Order, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.Order, error)
+}
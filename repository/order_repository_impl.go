@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) OrderRepository {
+	return &OrderRepositoryImpl{db: db}
+}
+
+// Save order together with its items. Products and discounts referenced by the items are never written.
+func (repository *OrderRepositoryImpl) Save(ctx context.Context, order domain.Order) (domain.Order, error) {
+	err := dbFromContext(ctx, repository.db).Omit("Customer", "OrderItems.Product", "OrderItems.Discount").Create(&order).Error
+	if err != nil {
+		return domain.Order{}, err
+	}
+	return order, nil
+}
+
+// UpdateStatus only touches the status column so line items are never rewritten
+func (repository *OrderRepositoryImpl) UpdateStatus(ctx context.Context, order domain.Order) (domain.Order, error) {
+	err := dbFromContext(ctx, repository.db).Model(&order).Update("status", order.Status).Error
+	if err != nil {
+		return domain.Order{}, err
+	}
+	return order, nil
+}
+
+// UpdatePricing rewrites the discount, promotions, tier discount and tax of every item, the promotion adjustments,
+// the order totals and the exchange rate
+func (repository *OrderRepositoryImpl) UpdatePricing(ctx context.Context, order domain.Order) (domain.Order, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		for _, item := range order.OrderItems {
+			err := tx.Model(&item).Select("discount_id", "discount_amount", "promotion_amount", "tier_discount", "tax_amount").Updates(map[string]interface{}{
+				"discount_id":      item.DiscountID,
+				"discount_amount":  item.DiscountAmount,
+				"promotion_amount": item.PromotionAmount,
+				"tier_discount":    item.TierDiscount,
+				"tax_amount":       item.TaxAmount,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("order_id = ?", order.OrderID).Delete(&domain.OrderAdjustment{}).Error; err != nil {
+			return err
+		}
+		for i := range order.Adjustments {
+			order.Adjustments[i].OrderAdjustmentID = 0
+			order.Adjustments[i].OrderID = order.OrderID
+		}
+		if len(order.Adjustments) > 0 {
+			if err := tx.Create(&order.Adjustments).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&order).Select("subtotal", "discount", "tax_amount", "total_amount", "prices_include_tax", "currency",
+			"exchange_rate", "loyalty_tier").Updates(map[string]interface{}{
+			"subtotal":           order.Subtotal,
+			"discount":           order.Discount,
+			"tax_amount":         order.TaxAmount,
+			"total_amount":       order.TotalAmount,
+			"prices_include_tax": order.PricesIncludeTax,
+			"currency":           order.Currency,
+			"exchange_rate":      order.ExchangeRate,
+			"loyalty_tier":       order.LoyaltyTier,
+		}).Error
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+	return order, nil
+}
+
+// FindById - Get order by ID including its items
+func (repository *OrderRepositoryImpl) FindById(ctx context.Context, orderId uint64) (domain.Order, error) {
+	var order domain.Order
+	err := dbFromContext(ctx, repository.db).Preload("OrderItems.Product").Preload("OrderItems.Discount").Preload("Payments").Preload("Adjustments").First(&order, orderId).Error
+	return order, err
+}
+
+// FindByIdForUpdate - Get order by ID and lock its row until the surrounding transaction ends
+func (repository *OrderRepositoryImpl) FindByIdForUpdate(ctx context.Context, orderId uint64) (domain.Order, error) {
+	var order domain.Order
+	err := dbFromContext(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("OrderItems.Product").Preload("OrderItems.Discount").Preload("Payments").Preload("Adjustments").
+		First(&order, orderId).Error
+	return order, err
+}
+
+// FindAll - Get all orders of a store, or of every store when storeId is 0, including their items
+func (repository *OrderRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.Order, error) {
+	var orders []domain.Order
+	err := byStore(dbFromContext(ctx, repository.db), storeId).Preload("OrderItems.Product").Preload("OrderItems.Discount").Preload("Payments").Preload("Adjustments").Order("id desc").Find(&orders).Error
+	return orders, err
+}
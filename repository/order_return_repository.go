@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type OrderReturnRepository interface {
+	Save(ctx context.Context, orderReturn domain.OrderReturn) (domain.OrderReturn, error)
+	FindByOrderId(ctx context.Context, orderId uint64) ([]domain.OrderReturn, error)
+}
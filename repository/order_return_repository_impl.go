@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type OrderReturnRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOrderReturnRepository(db *gorm.DB) OrderReturnRepository {
+	return &OrderReturnRepositoryImpl{db: db}
+}
+
+// Save return together with its lines. The refund payment is saved on its own beforehand.
+func (repository *OrderReturnRepositoryImpl) Save(ctx context.Context, orderReturn domain.OrderReturn) (domain.OrderReturn, error) {
+	err := dbFromContext(ctx, repository.db).Omit("Payment", "Lines.Product").Create(&orderReturn).Error
+	if err != nil {
+		return domain.OrderReturn{}, err
+	}
+	return orderReturn, nil
+}
+
+// FindByOrderId - Get every return of an order with its lines and refund, oldest first
+func (repository *OrderReturnRepositoryImpl) FindByOrderId(ctx context.Context, orderId uint64) ([]domain.OrderReturn, error) {
+	var orderReturns []domain.OrderReturn
+	err := dbFromContext(ctx, repository.db).
+		Preload("Payment.GiftCard").Preload("Lines.Product").
+		Where("order_id = ?", orderId).
+		Order("id").
+		Find(&orderReturns).Error
+	return orderReturns, err
+}
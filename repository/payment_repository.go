@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type PaymentRepository interface {
+	Save(ctx context.Context, payment domain.Payment) (domain.Payment, error)
+	UpdateStatus(ctx context.Context, payment domain.Payment) (domain.Payment, error)
+	FindById(ctx context.Context, paymentId uint64) (domain.Payment, error)
+	FindByOrderId(ctx context.Context, orderId uint64) ([]domain.Payment, error)
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type PaymentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) PaymentRepository {
+	return &PaymentRepositoryImpl{db: db}
+}
+
+// Save payment. The gift card it refers to is saved on its own.
+func (repository *PaymentRepositoryImpl) Save(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("GiftCard").Create(&payment).Error; err != nil {
+		return domain.Payment{}, err
+	}
+	return payment, nil
+}
+
+// UpdateStatus only touches the status and the shift a refund was paid out on, amounts are never edited
+// after a payment is recorded
+func (repository *PaymentRepositoryImpl) UpdateStatus(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+	err := dbFromContext(ctx, repository.db).Model(&payment).Select("status", "refund_shift_id").
+		Updates(map[string]interface{}{"status": payment.Status, "refund_shift_id": payment.RefundShiftID}).Error
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	return payment, nil
+}
+
+// FindById - Get payment by ID
+func (repository *PaymentRepositoryImpl) FindById(ctx context.Context, paymentId uint64) (domain.Payment, error) {
+	var payment domain.Payment
+	err := dbFromContext(ctx, repository.db).Preload("GiftCard").First(&payment, paymentId).Error
+	return payment, err
+}
+
+// FindByOrderId - Get every payment recorded against an order
+func (repository *PaymentRepositoryImpl) FindByOrderId(ctx context.Context, orderId uint64) ([]domain.Payment, error) {
+	var payments []domain.Payment
+	err := dbFromContext(ctx, repository.db).Preload("GiftCard").Where("order_id = ?", orderId).Order("id").Find(&payments).Error
+	return payments, err
+}
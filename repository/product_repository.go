@@ -11,4 +11,8 @@ type ProductRepository interface {
 	Delete(ctx context.Context, product domain.Product) error
 	FindById(ctx context.Context, productId uint64) (domain.Product, error)
 	FindAll(ctx context.Context) ([]domain.Product, error)
+	FindCatalog(ctx context.Context) ([]domain.Product, error)
+	FindByCategoryId(ctx context.Context, categoryId uint64) ([]domain.Product, error)
+	FindByIdsForUpdate(ctx context.Context, productIds []uint64) ([]domain.Product, error)
+	MoveStock(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error)
 }
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var ErrInsufficientStock = errors.New("insufficient stock")
+
 type ProductRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -15,43 +18,200 @@ func NewProductRepository(db *gorm.DB) ProductRepository {
 	return &ProductRepositoryImpl{db: db}
 }
 
-// Save product
+// Save product with the opening stock of its stores, which is written to the stock ledger along with it.
+// StockQty is the sum of the opening stock. The options of a parent are saved with it and each of its
+// variants is saved the same way as a product of its own.
 func (repository *ProductRepositoryImpl) Save(ctx context.Context, product domain.Product) (domain.Product, error) {
-	if err := repository.db.WithContext(ctx).Create(&product).Error; err != nil {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := createProduct(tx, &product); err != nil {
+			return err
+		}
+		for i := range product.Variants {
+			variant := &product.Variants[i]
+			variant.ParentID = &product.ProductID
+			if err := createProduct(tx, variant); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return domain.Product{}, err
 	}
 	return product, nil
 }
 
-// Update product
+// createProduct writes one product with its options, option values and store rows inside tx
+func createProduct(tx *gorm.DB, product *domain.Product) error {
+	product.StockQty = 0
+	for _, store := range product.Stores {
+		product.StockQty += store.StockQty
+	}
+
+	if err := tx.Omit("Category", "Tax", "Inventory", "Stores", "Variants").Create(product).Error; err != nil {
+		return err
+	}
+	for i := range product.Stores {
+		store := &product.Stores[i]
+		store.ProductID = product.ProductID
+		if err := tx.Create(store).Error; err != nil {
+			return err
+		}
+		if store.StockQty == 0 {
+			continue
+		}
+		err := tx.Create(&domain.StockMovement{
+			ProductID: product.ProductID,
+			StoreID:   store.StoreID,
+			Delta:     store.StockQty,
+			Reason:    domain.StockReasonAdjustment,
+			Reference: "Opening stock",
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Update product. Stock is left out on purpose, it only changes through MoveStock so
+// a stale copy can never overwrite a concurrent sale. Variants follow the category and tax of their parent
+// and, unless they override it, its price.
 func (repository *ProductRepositoryImpl) Update(ctx context.Context, product domain.Product) (domain.Product, error) {
-	if err := repository.db.WithContext(ctx).Save(&product).Error; err != nil {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("stock_qty", "Category", "Tax", "Inventory", "Stores", "Options", "Variants", "OptionValues").
+			Save(&product).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&domain.Product{}).Where("parent_id = ?", product.ProductID).Updates(map[string]interface{}{
+			"category_id": product.CategoryId,
+			"tax_id":      product.TaxID,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&domain.Product{}).Where("parent_id = ? AND price_override IS NULL", product.ProductID).
+			Update("product_price", product.Price).Error
+	})
+	if err != nil {
 		return domain.Product{}, err
 	}
+
+	for i := range product.Variants {
+		variant := &product.Variants[i]
+		variant.CategoryId = product.CategoryId
+		variant.TaxID = product.TaxID
+		variant.Tax = product.Tax
+		if variant.PriceOverride == nil {
+			variant.Price = product.Price
+		}
+	}
 	return product, nil
 }
 
 // Delete product
 func (repository *ProductRepositoryImpl) Delete(ctx context.Context, product domain.Product) error {
-	if err := repository.db.WithContext(ctx).Delete(&product).Error; err != nil {
+	if err := dbFromContext(ctx, repository.db).Delete(&product).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-// FindById - Get product by ID
+// FindById - Get product by ID with its store rows, its options and its variants
 func (repository *ProductRepositoryImpl) FindById(ctx context.Context, productId uint64) (domain.Product, error) {
 	var product domain.Product
-	err := repository.db.WithContext(ctx).First(&product, productId).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return product, errors.New("product is not found")
-	}
+	err := withVariants(dbFromContext(ctx, repository.db)).First(&product, productId).Error
 	return product, err
 }
 
-// FindAll - Get all categories
+// FindAll - Get all products with their store rows, parents and variants alike
 func (repository *ProductRepositoryImpl) FindAll(ctx context.Context) ([]domain.Product, error) {
-	var categories []domain.Product
-	err := repository.db.WithContext(ctx).Find(&categories).Error
-	return categories, err
+	var products []domain.Product
+	err := dbFromContext(ctx, repository.db).Preload("Tax").Preload("Stores").Preload("Options").Find(&products).Error
+	return products, err
+}
+
+// FindCatalog - Get all products that are not a variant, parents with their variants nested
+func (repository *ProductRepositoryImpl) FindCatalog(ctx context.Context) ([]domain.Product, error) {
+	var products []domain.Product
+	err := withVariants(dbFromContext(ctx, repository.db)).Where("parent_id IS NULL").Order("id").Find(&products).Error
+	return products, err
+}
+
+// FindByCategoryId - Get all products of a category with their store rows, parents and variants alike
+func (repository *ProductRepositoryImpl) FindByCategoryId(ctx context.Context, categoryId uint64) ([]domain.Product, error) {
+	var products []domain.Product
+	err := dbFromContext(ctx, repository.db).Preload("Stores").Preload("Options").Where("category_id = ?", categoryId).Order("id").Find(&products).Error
+	return products, err
+}
+
+// withVariants preloads what a product is shown with, options and variants in the order they were created
+func withVariants(db *gorm.DB) *gorm.DB {
+	byId := func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}
+	return db.Preload("Tax").Preload("Stores").
+		Preload("Options", byId).Preload("Options.Values", byId).Preload("OptionValues", byId).
+		Preload("Variants", byId).Preload("Variants.Tax").Preload("Variants.Stores").Preload("Variants.OptionValues", byId)
+}
+
+// FindByIdsForUpdate - Get products with SELECT ... FOR UPDATE, always locking in id order to avoid deadlocks.
+// Every stock change updates the product row, so holding it locks the stock of the product at every store.
+func (repository *ProductRepositoryImpl) FindByIdsForUpdate(ctx context.Context, productIds []uint64) ([]domain.Product, error) {
+	var products []domain.Product
+	err := dbFromContext(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Stores").
+		Where("id IN ?", productIds).
+		Order("id").
+		Find(&products).Error
+	return products, err
+}
+
+// MoveStock applies movement.Delta to the stock of the product at movement.StoreID and to its stock over
+// the chain, and appends the movement to the ledger in one transaction. The store update is conditional, a
+// decrease never takes the stock of a store below zero.
+func (repository *ProductRepositoryImpl) MoveStock(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Product{}).Where("id = ?", movement.ProductID).
+			Update("stock_qty", gorm.Expr("stock_qty + ?", movement.Delta))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if movement.Delta < 0 {
+			result = tx.Model(&domain.StoreProduct{}).
+				Where("store_id = ? AND product_id = ? AND stock_qty >= ?", movement.StoreID, movement.ProductID, -movement.Delta).
+				Update("stock_qty", gorm.Expr("stock_qty + ?", movement.Delta))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrInsufficientStock
+			}
+		} else {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "store_id"}, {Name: "product_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{"stock_qty": gorm.Expr("stock_qty + ?", movement.Delta)}),
+			}).Create(&domain.StoreProduct{
+				StoreID:   movement.StoreID,
+				ProductID: movement.ProductID,
+				StockQty:  movement.Delta,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&movement).Error
+	})
+	if err != nil {
+		return domain.StockMovement{}, err
+	}
+	return movement, nil
 }
@@ -11,6 +11,7 @@ import (
 )
 
 func TestProductRepository(t *testing.T) {
+	taxId := uint64(1)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -25,7 +26,7 @@ func TestProductRepository(t *testing.T) {
 		StockQty:    2,
 		CategoryId:  3,
 		SKU:         "WKWKWK",
-		TaxRate:     10,
+		TaxID:       &taxId,
 		Category:    domain.Category{},
 	}
 
@@ -104,6 +105,21 @@ func TestProductRepository(t *testing.T) {
 			expect:    []domain.Product{completeProduct},
 			expectErr: false,
 		},
+		{
+			name: "FindCatalog Success",
+			mock: func() {
+				parent := completeProduct
+				parent.Options = []domain.ProductOption{{Name: "Size", Values: []domain.ProductOptionValue{{Value: "M"}}}}
+				parent.Variants = []domain.Product{{ProductID: 2, ParentID: &parent.ProductID, SKU: "WKWKWK-M"}}
+				repo.EXPECT().FindCatalog(ctx).Return([]domain.Product{parent}, nil)
+			},
+			method: func() (interface{}, error) {
+				products, err := repo.FindCatalog(ctx)
+				return len(products[0].Variants), err
+			},
+			expect:    1,
+			expectErr: false,
+		},
 		{
 			name: "Delete Success",
 			mock: func() {
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"time"
+)
+
+type PromotionRepository interface {
+	Save(ctx context.Context, promotion domain.Promotion) (domain.Promotion, error)
+	Update(ctx context.Context, promotion domain.Promotion) (domain.Promotion, error)
+	Delete(ctx context.Context, promotion domain.Promotion) error
+	FindById(ctx context.Context, promotionId uint64) (domain.Promotion, error)
+	FindAll(ctx context.Context) ([]domain.Promotion, error)
+	FindActive(ctx context.Context, at time.Time) ([]domain.Promotion, error)
+}
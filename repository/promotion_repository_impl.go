@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+type PromotionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPromotionRepository(db *gorm.DB) PromotionRepository {
+	return &PromotionRepositoryImpl{db: db}
+}
+
+// Save promotion and link its target products. Products themselves are never written.
+func (repository *PromotionRepositoryImpl) Save(ctx context.Context, promotion domain.Promotion) (domain.Promotion, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("Products.*").Create(&promotion).Error; err != nil {
+		return domain.Promotion{}, err
+	}
+	return promotion, nil
+}
+
+// Update promotion and replace its target products
+func (repository *PromotionRepositoryImpl) Update(ctx context.Context, promotion domain.Promotion) (domain.Promotion, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Products").Save(&promotion).Error; err != nil {
+			return err
+		}
+		return tx.Model(&promotion).Omit("Products.*").Association("Products").Replace(promotion.Products)
+	})
+	if err != nil {
+		return domain.Promotion{}, err
+	}
+	return promotion, nil
+}
+
+// Delete promotion together with its product links
+func (repository *PromotionRepositoryImpl) Delete(ctx context.Context, promotion domain.Promotion) error {
+	return dbFromContext(ctx, repository.db).Select("Products").Delete(&promotion).Error
+}
+
+// FindById - Get promotion by ID
+func (repository *PromotionRepositoryImpl) FindById(ctx context.Context, promotionId uint64) (domain.Promotion, error) {
+	var promotion domain.Promotion
+	err := dbFromContext(ctx, repository.db).Preload("Products").First(&promotion, promotionId).Error
+	return promotion, err
+}
+
+// FindAll - Get all promotions
+func (repository *PromotionRepositoryImpl) FindAll(ctx context.Context) ([]domain.Promotion, error) {
+	var promotions []domain.Promotion
+	err := dbFromContext(ctx, repository.db).Preload("Products").Find(&promotions).Error
+	return promotions, err
+}
+
+// FindActive - Get the promotions whose validity window contains at
+func (repository *PromotionRepositoryImpl) FindActive(ctx context.Context, at time.Time) ([]domain.Promotion, error) {
+	var promotions []domain.Promotion
+	err := dbFromContext(ctx, repository.db).Preload("Products").
+		Where("valid_from <= ? AND valid_until >= ?", at, at).
+		Order("id").
+		Find(&promotions).Error
+	return promotions, err
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type PurchaseOrderRepository interface {
+	Save(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error)
+	Update(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error)
+	Delete(ctx context.Context, purchaseOrder domain.PurchaseOrder) error
+	UpdateStatus(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error)
+	UpdateReceivedQty(ctx context.Context, line domain.PurchaseOrderLine) error
+	FindById(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error)
+	FindByIdForUpdate(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.PurchaseOrder, error)
+}
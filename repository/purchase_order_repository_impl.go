@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PurchaseOrderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPurchaseOrderRepository(db *gorm.DB) PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryImpl{db: db}
+}
+
+// Save purchase order together with its lines. The supplier and the products are never written.
+func (repository *PurchaseOrderRepositoryImpl) Save(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	err := dbFromContext(ctx, repository.db).Omit("Supplier", "Lines.Product").Create(&purchaseOrder).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return purchaseOrder, nil
+}
+
+// Update a draft purchase order, its lines are replaced by the ones given
+func (repository *PurchaseOrderRepositoryImpl) Update(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	err := dbFromContext(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Supplier", "Lines").Save(&purchaseOrder).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("purchase_order_id = ?", purchaseOrder.PurchaseOrderID).Delete(&domain.PurchaseOrderLine{}).Error; err != nil {
+			return err
+		}
+		for i := range purchaseOrder.Lines {
+			purchaseOrder.Lines[i].PurchaseOrderLineID = 0
+			purchaseOrder.Lines[i].PurchaseOrderID = purchaseOrder.PurchaseOrderID
+		}
+		return tx.Omit("Product").Create(&purchaseOrder.Lines).Error
+	})
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return purchaseOrder, nil
+}
+
+// Delete purchase order with its lines
+func (repository *PurchaseOrderRepositoryImpl) Delete(ctx context.Context, purchaseOrder domain.PurchaseOrder) error {
+	return dbFromContext(ctx, repository.db).Select("Lines").Delete(&purchaseOrder).Error
+}
+
+// UpdateStatus only touches the status and its timestamps so lines are never rewritten
+func (repository *PurchaseOrderRepositoryImpl) UpdateStatus(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	err := dbFromContext(ctx, repository.db).Model(&purchaseOrder).Select("status", "sent_at", "received_at").Updates(map[string]interface{}{
+		"status":      purchaseOrder.Status,
+		"sent_at":     purchaseOrder.SentAt,
+		"received_at": purchaseOrder.ReceivedAt,
+	}).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return purchaseOrder, nil
+}
+
+// UpdateReceivedQty - Store the quantity received so far on a line
+func (repository *PurchaseOrderRepositoryImpl) UpdateReceivedQty(ctx context.Context, line domain.PurchaseOrderLine) error {
+	return dbFromContext(ctx, repository.db).Model(&line).Update("received_qty", line.ReceivedQty).Error
+}
+
+// FindById - Get purchase order by ID including its supplier and lines
+func (repository *PurchaseOrderRepositoryImpl) FindById(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error) {
+	var purchaseOrder domain.PurchaseOrder
+	err := dbFromContext(ctx, repository.db).Preload("Supplier").Preload("Lines.Product").First(&purchaseOrder, purchaseOrderId).Error
+	return purchaseOrder, err
+}
+
+// FindByIdForUpdate - Get purchase order by ID and lock its row until the surrounding transaction ends
+func (repository *PurchaseOrderRepositoryImpl) FindByIdForUpdate(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error) {
+	var purchaseOrder domain.PurchaseOrder
+	err := dbFromContext(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Supplier").Preload("Lines.Product").
+		First(&purchaseOrder, purchaseOrderId).Error
+	return purchaseOrder, err
+}
+
+// FindAll - Get all purchase orders delivered to a store, or to any store when storeId is 0, newest first
+func (repository *PurchaseOrderRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.PurchaseOrder, error) {
+	var purchaseOrders []domain.PurchaseOrder
+	err := byStore(dbFromContext(ctx, repository.db), storeId).Preload("Supplier").Preload("Lines.Product").Order("id desc").Find(&purchaseOrders).Error
+	return purchaseOrders, err
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+// ReceiptRepository has no Update or Delete, receipts are immutable once issued
+type ReceiptRepository interface {
+	Save(ctx context.Context, receipt domain.Receipt) (domain.Receipt, error)
+	FindById(ctx context.Context, receiptId uint64) (domain.Receipt, error)
+	FindByOrderId(ctx context.Context, orderId uint64) (domain.Receipt, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type ReceiptRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReceiptRepository(db *gorm.DB) ReceiptRepository {
+	return &ReceiptRepositoryImpl{db: db}
+}
+
+// Save receipt together with its items and tenders
+func (repository *ReceiptRepositoryImpl) Save(ctx context.Context, receipt domain.Receipt) (domain.Receipt, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&receipt).Error; err != nil {
+		return domain.Receipt{}, err
+	}
+	return receipt, nil
+}
+
+// FindById - Get receipt by ID including its items and tenders
+func (repository *ReceiptRepositoryImpl) FindById(ctx context.Context, receiptId uint64) (domain.Receipt, error) {
+	var receipt domain.Receipt
+	err := dbFromContext(ctx, repository.db).Preload("Items").Preload("TaxLines").Preload("Tenders").First(&receipt, receiptId).Error
+	return receipt, err
+}
+
+// FindByOrderId - Get the receipt issued for an order
+func (repository *ReceiptRepositoryImpl) FindByOrderId(ctx context.Context, orderId uint64) (domain.Receipt, error) {
+	var receipt domain.Receipt
+	err := dbFromContext(ctx, repository.db).Preload("Items").Preload("TaxLines").Preload("Tenders").
+		Where("order_id = ?", orderId).First(&receipt).Error
+	return receipt, err
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"time"
+)
+
+type ReportRepository interface {
+	SumSales(ctx context.Context, groupBy string, from time.Time, to time.Time, storeId uint64) ([]domain.SalesReportRow, error)
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ReportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &ReportRepositoryImpl{db: db}
+}
+
+// salesGroups maps each grouping to the SQL of its key and label, the empty grouping sums everything
+var salesGroups = map[string]struct{ key, label string }{
+	"":                        {"''", "'Total'"},
+	domain.SalesGroupDay:      {"DATE_FORMAT(sales.sold_at, '%Y-%m-%d')", "DATE_FORMAT(sales.sold_at, '%Y-%m-%d')"},
+	domain.SalesGroupWeek:     {"DATE_FORMAT(sales.sold_at, '%x-W%v')", "DATE_FORMAT(sales.sold_at, '%x-W%v')"},
+	domain.SalesGroupMonth:    {"DATE_FORMAT(sales.sold_at, '%Y-%m')", "DATE_FORMAT(sales.sold_at, '%Y-%m')"},
+	domain.SalesGroupProduct:  {"CAST(sales.product_id AS CHAR)", "products.product_name"},
+	domain.SalesGroupCategory: {"CAST(products.category_id AS CHAR)", "categories.name"},
+	domain.SalesGroupEmployee: {"COALESCE(CAST(sales.employee_id AS CHAR), '')", "COALESCE(employees.name, 'Unassigned')"},
+	domain.SalesGroupStore:    {"CAST(sales.store_id AS CHAR)", "stores.name"},
+}
+
+// SumSales - Aggregate the order lines of orders settled in [from, to) together with the lines returned in
+// that period, which count negative, of one store or of every store when storeId is 0. An order is settled
+// when it is paid in full, which is when its receipt is issued, so unpaid orders are no sales yet. From then on
+// money only goes back through returns, its payments can no longer be refunded or voided. Time
+// groupings come out in date order, the others with the best selling group first.
+func (repository *ReportRepositoryImpl) SumSales(ctx context.Context, groupBy string, from time.Time, to time.Time, storeId uint64) ([]domain.SalesReportRow, error) {
+	group, ok := salesGroups[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sales grouping %q", groupBy)
+	}
+	orderBy := "group_key"
+	if groupBy == domain.SalesGroupProduct || groupBy == domain.SalesGroupCategory || groupBy == domain.SalesGroupEmployee ||
+		groupBy == domain.SalesGroupStore {
+		orderBy = "net_sales DESC, group_key"
+	}
+
+	var rows []domain.SalesReportRow
+	err := dbFromContext(ctx, repository.db).Raw(fmt.Sprintf(`SELECT %s AS group_key, MAX(%s) AS label,
+			COUNT(DISTINCT sales.order_id) AS order_count, CAST(COALESCE(SUM(sales.quantity), 0) AS SIGNED) AS quantity,
+			CAST(COALESCE(SUM(sales.gross), 0) AS SIGNED) AS gross_sales, CAST(COALESCE(SUM(sales.discount), 0) AS SIGNED) AS discounts,
+			CAST(COALESCE(SUM(sales.tax), 0) AS SIGNED) AS tax_amount, CAST(COALESCE(SUM(sales.net), 0) AS SIGNED) AS net_sales
+		FROM (
+			SELECT orders.id AS order_id, receipts.receipt_date AS sold_at, orders.store_id, orders.employee_id, order_items.product_id,
+				order_items.quantity, order_items.total_price AS gross,
+				order_items.discount_amount + order_items.promotion_amount + order_items.tier_discount AS discount,
+				order_items.tax_amount AS tax,
+				order_items.total_price - order_items.discount_amount - order_items.promotion_amount - order_items.tier_discount
+					- CASE WHEN orders.prices_include_tax THEN order_items.tax_amount ELSE 0 END AS net
+			FROM order_items
+				JOIN orders ON orders.id = order_items.order_id
+				JOIN receipts ON receipts.order_id = orders.id
+			WHERE orders.status = ? AND receipts.receipt_date >= ? AND receipts.receipt_date < ?
+			UNION ALL
+			SELECT NULL, order_returns.created_at, orders.store_id, orders.employee_id, order_return_lines.product_id,
+				-order_return_lines.quantity, -order_return_lines.subtotal, -order_return_lines.discount,
+				-order_return_lines.tax_amount,
+				-(order_return_lines.subtotal - order_return_lines.discount
+					- CASE WHEN orders.prices_include_tax THEN order_return_lines.tax_amount ELSE 0 END)
+			FROM order_return_lines
+				JOIN order_returns ON order_returns.id = order_return_lines.order_return_id
+				JOIN orders ON orders.id = order_returns.order_id
+			WHERE order_returns.created_at >= ? AND order_returns.created_at < ?
+		) AS sales
+			LEFT JOIN products ON products.id = sales.product_id
+			LEFT JOIN categories ON categories.id = products.category_id
+			LEFT JOIN employees ON employees.id = sales.employee_id
+			LEFT JOIN stores ON stores.id = sales.store_id
+		WHERE ? = 0 OR sales.store_id = ?
+		GROUP BY group_key
+		ORDER BY %s`, group.key, group.label, orderBy),
+		domain.OrderStatusPlaced, from, to, from, to, storeId, storeId).
+		Scan(&rows).Error
+	return rows, err
+}
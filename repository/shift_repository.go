@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+)
+
+type ShiftRepository interface {
+	Save(ctx context.Context, shift domain.Shift) (domain.Shift, error)
+	SaveCashMovement(ctx context.Context, movement domain.CashMovement) (domain.CashMovement, error)
+	Close(ctx context.Context, shift domain.Shift) (domain.Shift, error)
+	FindById(ctx context.Context, shiftId uint64) (domain.Shift, error)
+	FindByIdForUpdate(ctx context.Context, shiftId uint64) (domain.Shift, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.Shift, error)
+	FindOpen(ctx context.Context) ([]domain.Shift, error)
+	FindPayments(ctx context.Context, shiftId uint64) ([]domain.Payment, error)
+	FindRefundPaymentIds(ctx context.Context, shiftId uint64) ([]uint64, error)
+	SumOrderDiscounts(ctx context.Context, shiftId uint64) (money.Money, error)
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ShiftRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewShiftRepository(db *gorm.DB) ShiftRepository {
+	return &ShiftRepositoryImpl{db: db}
+}
+
+// Save shift
+func (repository *ShiftRepositoryImpl) Save(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+	if err := dbFromContext(ctx, repository.db).Omit("Employee", "CashMovements").Create(&shift).Error; err != nil {
+		return domain.Shift{}, err
+	}
+	return shift, nil
+}
+
+// SaveCashMovement - Record a pay-in or pay-out of the drawer
+func (repository *ShiftRepositoryImpl) SaveCashMovement(ctx context.Context, movement domain.CashMovement) (domain.CashMovement, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&movement).Error; err != nil {
+		return domain.CashMovement{}, err
+	}
+	return movement, nil
+}
+
+// Close only touches the closing columns, what the shift was opened with is never rewritten
+func (repository *ShiftRepositoryImpl) Close(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+	err := dbFromContext(ctx, repository.db).Model(&shift).
+		Select("status", "expected_cash", "counted_cash", "note", "closed_at", "order_count", "gross_sales",
+			"total_refunds", "discounts", "cash_sales", "cash_refunds", "totals_recorded").
+		Updates(map[string]interface{}{
+			"status":          shift.Status,
+			"expected_cash":   shift.ExpectedCash,
+			"counted_cash":    shift.CountedCash,
+			"note":            shift.Note,
+			"closed_at":       shift.ClosedAt,
+			"order_count":     shift.OrderCount,
+			"gross_sales":     shift.GrossSales,
+			"total_refunds":   shift.TotalRefunds,
+			"discounts":       shift.Discounts,
+			"cash_sales":      shift.CashSales,
+			"cash_refunds":    shift.CashRefunds,
+			"totals_recorded": shift.TotalsRecorded,
+		}).Error
+	if err != nil {
+		return domain.Shift{}, err
+	}
+	return shift, nil
+}
+
+// FindById - Get shift by ID with its pay-ins and pay-outs
+func (repository *ShiftRepositoryImpl) FindById(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	var shift domain.Shift
+	err := repository.preload(dbFromContext(ctx, repository.db)).First(&shift, shiftId).Error
+	return shift, err
+}
+
+// FindByIdForUpdate - Get shift by ID and lock its row until the surrounding transaction ends
+func (repository *ShiftRepositoryImpl) FindByIdForUpdate(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	var shift domain.Shift
+	err := repository.preload(dbFromContext(ctx, repository.db)).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&shift, shiftId).Error
+	return shift, err
+}
+
+// FindAll - Get all shifts of a store, or of every store when storeId is 0, newest first
+func (repository *ShiftRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.Shift, error) {
+	var shifts []domain.Shift
+	err := repository.preload(byStore(dbFromContext(ctx, repository.db), storeId)).Order("id desc").Find(&shifts).Error
+	return shifts, err
+}
+
+// FindOpen - Get the shifts still running, without their cash movements
+func (repository *ShiftRepositoryImpl) FindOpen(ctx context.Context) ([]domain.Shift, error) {
+	var shifts []domain.Shift
+	err := dbFromContext(ctx, repository.db).Where("status = ?", domain.ShiftStatusOpen).Order("id").Find(&shifts).Error
+	return shifts, err
+}
+
+// FindPayments - Get every payment taken during the shift and every payment it paid a refund of
+func (repository *ShiftRepositoryImpl) FindPayments(ctx context.Context, shiftId uint64) ([]domain.Payment, error) {
+	var payments []domain.Payment
+	err := dbFromContext(ctx, repository.db).Where("shift_id = ? OR refund_shift_id = ?", shiftId, shiftId).Order("id").Find(&payments).Error
+	return payments, err
+}
+
+// FindRefundPaymentIds - Get the payments of the shift that paid out a return rather than took a sale
+func (repository *ShiftRepositoryImpl) FindRefundPaymentIds(ctx context.Context, shiftId uint64) ([]uint64, error) {
+	var paymentIds []uint64
+	err := dbFromContext(ctx, repository.db).Model(&domain.OrderReturn{}).
+		Joins("JOIN payments ON payments.id = order_returns.payment_id").
+		Where("payments.shift_id = ?", shiftId).
+		Pluck("order_returns.payment_id", &paymentIds).Error
+	return paymentIds, err
+}
+
+// SumOrderDiscounts - Sum the discounts of the orders the shift took a sale for
+func (repository *ShiftRepositoryImpl) SumOrderDiscounts(ctx context.Context, shiftId uint64) (money.Money, error) {
+	db := dbFromContext(ctx, repository.db)
+	sales := db.Model(&domain.Payment{}).Select("order_id").
+		Where("shift_id = ? AND status IN ?", shiftId, []string{domain.PaymentStatusCompleted, domain.PaymentStatusRefunded}).
+		Where("id NOT IN (?)", db.Model(&domain.OrderReturn{}).Select("payment_id"))
+
+	var sum money.Money
+	err := db.Model(&domain.Order{}).Select("CAST(COALESCE(SUM(discount), 0) AS SIGNED)").Where("id IN (?)", sales).Scan(&sum).Error
+	return sum, err
+}
+
+func (repository *ShiftRepositoryImpl) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Employee").Preload("CashMovements", func(db *gorm.DB) *gorm.DB {
+		return db.Order("cash_movements.id")
+	})
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+// StockMovementRepository reads the stock ledger. Movements are written by ProductRepository.MoveStock
+// together with the stock change they record.
+type StockMovementRepository interface {
+	FindByProductId(ctx context.Context, productId uint64, storeId uint64) ([]domain.StockMovement, error)
+	SumByProductId(ctx context.Context, productId uint64) (int, error)
+	SumByStore(ctx context.Context, productId uint64) (map[uint64]int, error)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type StockMovementRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) StockMovementRepository {
+	return &StockMovementRepositoryImpl{db: db}
+}
+
+// FindByProductId - Get the movements of a product at a store, or at every store when storeId is 0, oldest first
+func (repository *StockMovementRepositoryImpl) FindByProductId(ctx context.Context, productId uint64, storeId uint64) ([]domain.StockMovement, error) {
+	var movements []domain.StockMovement
+	query := dbFromContext(ctx, repository.db).Where("product_id = ?", productId)
+	if storeId != 0 {
+		query = query.Where("store_id = ?", storeId)
+	}
+	err := query.
+		Order("created_at").
+		Order("id").
+		Find(&movements).Error
+	return movements, err
+}
+
+// SumByProductId - Rebuild the stock of a product from its movements
+func (repository *StockMovementRepositoryImpl) SumByProductId(ctx context.Context, productId uint64) (int, error) {
+	var stock int
+	err := dbFromContext(ctx, repository.db).
+		Model(&domain.StockMovement{}).
+		Where("product_id = ?", productId).
+		Select("COALESCE(SUM(delta), 0)").
+		Scan(&stock).Error
+	return stock, err
+}
+
+// SumByStore - Rebuild the stock of a product at every store it has movements at, keyed by store
+func (repository *StockMovementRepositoryImpl) SumByStore(ctx context.Context, productId uint64) (map[uint64]int, error) {
+	var rows []struct {
+		StoreID uint64
+		Stock   int
+	}
+	err := dbFromContext(ctx, repository.db).
+		Model(&domain.StockMovement{}).
+		Where("product_id = ?", productId).
+		Group("store_id").
+		Select("store_id, SUM(delta) AS stock").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stock := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		stock[row.StoreID] = row.Stock
+	}
+	return stock, nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type StockTransferRepository interface {
+	Save(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error)
+	Delete(ctx context.Context, transfer domain.StockTransfer) error
+	UpdateStatus(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error)
+	UpdateLine(ctx context.Context, line domain.StockTransferLine) error
+	FindById(ctx context.Context, transferId uint64) (domain.StockTransfer, error)
+	FindByIdForUpdate(ctx context.Context, transferId uint64) (domain.StockTransfer, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.StockTransfer, error)
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StockTransferRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockTransferRepository(db *gorm.DB) StockTransferRepository {
+	return &StockTransferRepositoryImpl{db: db}
+}
+
+// Save stock transfer together with its lines. The stores and the products are never written.
+func (repository *StockTransferRepositoryImpl) Save(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+	err := dbFromContext(ctx, repository.db).Omit("FromStore", "ToStore", "Lines.Product").Create(&transfer).Error
+	if err != nil {
+		return domain.StockTransfer{}, err
+	}
+	return transfer, nil
+}
+
+// Delete stock transfer with its lines
+func (repository *StockTransferRepositoryImpl) Delete(ctx context.Context, transfer domain.StockTransfer) error {
+	return dbFromContext(ctx, repository.db).Select("Lines").Delete(&transfer).Error
+}
+
+// UpdateStatus only touches the status and its timestamps so lines are never rewritten
+func (repository *StockTransferRepositoryImpl) UpdateStatus(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+	err := dbFromContext(ctx, repository.db).Model(&transfer).Select("status", "shipped_at", "received_at").Updates(map[string]interface{}{
+		"status":      transfer.Status,
+		"shipped_at":  transfer.ShippedAt,
+		"received_at": transfer.ReceivedAt,
+	}).Error
+	if err != nil {
+		return domain.StockTransfer{}, err
+	}
+	return transfer, nil
+}
+
+// UpdateLine - Store the shipped and received quantities of a line
+func (repository *StockTransferRepositoryImpl) UpdateLine(ctx context.Context, line domain.StockTransferLine) error {
+	return dbFromContext(ctx, repository.db).Model(&line).Select("shipped_qty", "received_qty", "discrepancy_note").
+		Updates(map[string]interface{}{
+			"shipped_qty":      line.ShippedQty,
+			"received_qty":     line.ReceivedQty,
+			"discrepancy_note": line.DiscrepancyNote,
+		}).Error
+}
+
+// FindById - Get stock transfer by ID including its stores and lines
+func (repository *StockTransferRepositoryImpl) FindById(ctx context.Context, transferId uint64) (domain.StockTransfer, error) {
+	var transfer domain.StockTransfer
+	err := dbFromContext(ctx, repository.db).Preload("FromStore").Preload("ToStore").Preload("Lines.Product").
+		First(&transfer, transferId).Error
+	return transfer, err
+}
+
+// FindByIdForUpdate - Get stock transfer by ID and lock its row until the surrounding transaction ends
+func (repository *StockTransferRepositoryImpl) FindByIdForUpdate(ctx context.Context, transferId uint64) (domain.StockTransfer, error) {
+	var transfer domain.StockTransfer
+	err := dbFromContext(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("FromStore").Preload("ToStore").Preload("Lines.Product").
+		First(&transfer, transferId).Error
+	return transfer, err
+}
+
+// FindAll - Get all stock transfers leaving or reaching a store, or between any stores when storeId is 0,
+// newest first
+func (repository *StockTransferRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.StockTransfer, error) {
+	var transfers []domain.StockTransfer
+	query := dbFromContext(ctx, repository.db)
+	if storeId != 0 {
+		query = query.Where("from_store_id = ? OR to_store_id = ?", storeId, storeId)
+	}
+	err := query.Preload("FromStore").Preload("ToStore").Preload("Lines.Product").Order("id desc").Find(&transfers).Error
+	return transfers, err
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type StocktakeRepository interface {
+	Save(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error)
+	SaveCount(ctx context.Context, count domain.StocktakeCount) (domain.StocktakeCount, error)
+	UpdateReasonCode(ctx context.Context, line domain.StocktakeLine) error
+	UpdateStatus(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error)
+	FindById(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error)
+	FindByIdForUpdate(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error)
+	FindAll(ctx context.Context, storeId uint64) ([]domain.Stocktake, error)
+	FindOpen(ctx context.Context) ([]domain.Stocktake, error)
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StocktakeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStocktakeRepository(db *gorm.DB) StocktakeRepository {
+	return &StocktakeRepositoryImpl{db: db}
+}
+
+// Save stocktake together with its snapshot lines
+func (repository *StocktakeRepositoryImpl) Save(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+	err := dbFromContext(ctx, repository.db).Omit("Category", "CommittedBy", "Lines.Product", "Lines.Counts").Create(&stocktake).Error
+	if err != nil {
+		return domain.Stocktake{}, err
+	}
+	return stocktake, nil
+}
+
+// SaveCount stores what an employee counted of a line, replacing their earlier count of it
+func (repository *StocktakeRepositoryImpl) SaveCount(ctx context.Context, count domain.StocktakeCount) (domain.StocktakeCount, error) {
+	err := dbFromContext(ctx, repository.db).Omit("Employee").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "stocktake_line_id"}, {Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity", "counted_at"}),
+	}).Create(&count).Error
+	if err != nil {
+		return domain.StocktakeCount{}, err
+	}
+	return count, nil
+}
+
+// UpdateReasonCode - Store the reason code explaining the variance of a line
+func (repository *StocktakeRepositoryImpl) UpdateReasonCode(ctx context.Context, line domain.StocktakeLine) error {
+	return dbFromContext(ctx, repository.db).Model(&line).Update("reason_code", line.ReasonCode).Error
+}
+
+// UpdateStatus only touches the status and who committed it when, the snapshot is never rewritten
+func (repository *StocktakeRepositoryImpl) UpdateStatus(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+	err := dbFromContext(ctx, repository.db).Model(&stocktake).Select("status", "committed_at", "committed_by_id").Updates(map[string]interface{}{
+		"status":          stocktake.Status,
+		"committed_at":    stocktake.CommittedAt,
+		"committed_by_id": stocktake.CommittedByID,
+	}).Error
+	if err != nil {
+		return domain.Stocktake{}, err
+	}
+	return stocktake, nil
+}
+
+// FindById - Get stocktake by ID including its lines and every count
+func (repository *StocktakeRepositoryImpl) FindById(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error) {
+	var stocktake domain.Stocktake
+	err := repository.preload(dbFromContext(ctx, repository.db)).First(&stocktake, stocktakeId).Error
+	return stocktake, err
+}
+
+// FindByIdForUpdate - Get stocktake by ID and lock its row until the surrounding transaction ends
+func (repository *StocktakeRepositoryImpl) FindByIdForUpdate(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error) {
+	var stocktake domain.Stocktake
+	err := repository.preload(dbFromContext(ctx, repository.db)).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&stocktake, stocktakeId).Error
+	return stocktake, err
+}
+
+// FindAll - Get all stocktakes of a store, or of every store when storeId is 0, newest first
+func (repository *StocktakeRepositoryImpl) FindAll(ctx context.Context, storeId uint64) ([]domain.Stocktake, error) {
+	var stocktakes []domain.Stocktake
+	err := repository.preload(byStore(dbFromContext(ctx, repository.db), storeId)).Order("id desc").Find(&stocktakes).Error
+	return stocktakes, err
+}
+
+// FindOpen - Get the stocktakes still being counted, without their lines
+func (repository *StocktakeRepositoryImpl) FindOpen(ctx context.Context) ([]domain.Stocktake, error) {
+	var stocktakes []domain.Stocktake
+	err := dbFromContext(ctx, repository.db).Where("status = ?", domain.StocktakeStatusOpen).Order("id").Find(&stocktakes).Error
+	return stocktakes, err
+}
+
+func (repository *StocktakeRepositoryImpl) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Category").Preload("CommittedBy").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("stocktake_lines.product_id")
+		}).
+		Preload("Lines.Product").Preload("Lines.Counts.Employee")
+}
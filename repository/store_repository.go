@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type StoreRepository interface {
+	Save(ctx context.Context, store domain.Store) (domain.Store, error)
+	Update(ctx context.Context, store domain.Store) (domain.Store, error)
+	FindById(ctx context.Context, storeId uint64) (domain.Store, error)
+	FindByIdForUpdate(ctx context.Context, storeId uint64) (domain.Store, error)
+	FindAll(ctx context.Context) ([]domain.Store, error)
+	SavePrice(ctx context.Context, storeProduct domain.StoreProduct) error
+}
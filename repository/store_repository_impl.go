@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StoreRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStoreRepository(db *gorm.DB) StoreRepository {
+	return &StoreRepositoryImpl{db: db}
+}
+
+// Save store
+func (repository *StoreRepositoryImpl) Save(ctx context.Context, store domain.Store) (domain.Store, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&store).Error; err != nil {
+		return domain.Store{}, err
+	}
+	return store, nil
+}
+
+// Update store
+func (repository *StoreRepositoryImpl) Update(ctx context.Context, store domain.Store) (domain.Store, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&store).Error; err != nil {
+		return domain.Store{}, err
+	}
+	return store, nil
+}
+
+// FindById - Get store by ID
+func (repository *StoreRepositoryImpl) FindById(ctx context.Context, storeId uint64) (domain.Store, error) {
+	var store domain.Store
+	err := dbFromContext(ctx, repository.db).First(&store, storeId).Error
+	return store, err
+}
+
+// FindByIdForUpdate - Get store by ID and lock its row until the surrounding transaction ends
+func (repository *StoreRepositoryImpl) FindByIdForUpdate(ctx context.Context, storeId uint64) (domain.Store, error) {
+	var store domain.Store
+	err := dbFromContext(ctx, repository.db).Clauses(clause.Locking{Strength: "UPDATE"}).First(&store, storeId).Error
+	return store, err
+}
+
+// FindAll - Get all stores
+func (repository *StoreRepositoryImpl) FindAll(ctx context.Context) ([]domain.Store, error) {
+	var stores []domain.Store
+	err := dbFromContext(ctx, repository.db).Order("id").Find(&stores).Error
+	return stores, err
+}
+
+// SavePrice sets the price override of a product at a store, creating its store row without stock when the
+// store never had the product. The stock of an existing row is left alone.
+func (repository *StoreRepositoryImpl) SavePrice(ctx context.Context, storeProduct domain.StoreProduct) error {
+	return dbFromContext(ctx, repository.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "store_id"}, {Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"price"}),
+		}).
+		Create(&storeProduct).Error
+}
+
+// byStore narrows a query to the rows of one store, a storeId of 0 leaves it over the whole chain
+func byStore(query *gorm.DB, storeId uint64) *gorm.DB {
+	if storeId == 0 {
+		return query
+	}
+	return query.Where("store_id = ?", storeId)
+}
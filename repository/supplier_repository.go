@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type SupplierRepository interface {
+	Save(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error)
+	Update(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error)
+	Delete(ctx context.Context, supplier domain.Supplier) error
+	FindById(ctx context.Context, supplierId uint64) (domain.Supplier, error)
+	FindAll(ctx context.Context) ([]domain.Supplier, error)
+	CountPurchaseOrders(ctx context.Context, supplierId uint64) (int64, error)
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type SupplierRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSupplierRepository(db *gorm.DB) SupplierRepository {
+	return &SupplierRepositoryImpl{db: db}
+}
+
+// Save supplier
+func (repository *SupplierRepositoryImpl) Save(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&supplier).Error; err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+// Update supplier
+func (repository *SupplierRepositoryImpl) Update(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&supplier).Error; err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+// Delete supplier
+func (repository *SupplierRepositoryImpl) Delete(ctx context.Context, supplier domain.Supplier) error {
+	return dbFromContext(ctx, repository.db).Delete(&supplier).Error
+}
+
+// FindById - Get supplier by ID
+func (repository *SupplierRepositoryImpl) FindById(ctx context.Context, supplierId uint64) (domain.Supplier, error) {
+	var supplier domain.Supplier
+	err := dbFromContext(ctx, repository.db).First(&supplier, supplierId).Error
+	return supplier, err
+}
+
+// FindAll - Get all suppliers
+func (repository *SupplierRepositoryImpl) FindAll(ctx context.Context) ([]domain.Supplier, error) {
+	var suppliers []domain.Supplier
+	err := dbFromContext(ctx, repository.db).Order("id").Find(&suppliers).Error
+	return suppliers, err
+}
+
+// CountPurchaseOrders - Count the purchase orders placed with a supplier
+func (repository *SupplierRepositoryImpl) CountPurchaseOrders(ctx context.Context, supplierId uint64) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, repository.db).Model(&domain.PurchaseOrder{}).Where("supplier_id = ?", supplierId).Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+)
+
+type TaxRepository interface {
+	Save(ctx context.Context, tax domain.Tax) (domain.Tax, error)
+	Update(ctx context.Context, tax domain.Tax) (domain.Tax, error)
+	Delete(ctx context.Context, tax domain.Tax) error
+	FindById(ctx context.Context, taxId uint64) (domain.Tax, error)
+	FindAll(ctx context.Context) ([]domain.Tax, error)
+	CountProducts(ctx context.Context, taxId uint64) (int64, error)
+	CountAmounts(ctx context.Context) (int64, error)
+	FindSetting(ctx context.Context) (domain.StoreSetting, error)
+	SaveSetting(ctx context.Context, setting domain.StoreSetting) (domain.StoreSetting, error)
+}
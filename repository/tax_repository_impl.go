@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"gorm.io/gorm"
+)
+
+type TaxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTaxRepository(db *gorm.DB) TaxRepository {
+	return &TaxRepositoryImpl{db: db}
+}
+
+// Save tax class
+func (repository *TaxRepositoryImpl) Save(ctx context.Context, tax domain.Tax) (domain.Tax, error) {
+	if err := dbFromContext(ctx, repository.db).Create(&tax).Error; err != nil {
+		return domain.Tax{}, err
+	}
+	return tax, nil
+}
+
+// Update tax class
+func (repository *TaxRepositoryImpl) Update(ctx context.Context, tax domain.Tax) (domain.Tax, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&tax).Error; err != nil {
+		return domain.Tax{}, err
+	}
+	return tax, nil
+}
+
+// Delete tax class
+func (repository *TaxRepositoryImpl) Delete(ctx context.Context, tax domain.Tax) error {
+	return dbFromContext(ctx, repository.db).Delete(&tax).Error
+}
+
+// FindById - Get tax class by ID
+func (repository *TaxRepositoryImpl) FindById(ctx context.Context, taxId uint64) (domain.Tax, error) {
+	var tax domain.Tax
+	err := dbFromContext(ctx, repository.db).First(&tax, taxId).Error
+	return tax, err
+}
+
+// FindAll - Get all tax classes
+func (repository *TaxRepositoryImpl) FindAll(ctx context.Context) ([]domain.Tax, error) {
+	var taxes []domain.Tax
+	err := dbFromContext(ctx, repository.db).Order("id").Find(&taxes).Error
+	return taxes, err
+}
+
+// CountProducts - Count the products assigned to a tax class
+func (repository *TaxRepositoryImpl) CountProducts(ctx context.Context, taxId uint64) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, repository.db).Model(&domain.Product{}).Where("tax_id = ?", taxId).Count(&count).Error
+	return count, err
+}
+
+// amountModels hold the amounts entered or taken in the store currency, every other amount is worked out from them
+var amountModels = []interface{}{
+	&domain.Product{}, &domain.StoreProduct{}, &domain.Shift{}, &domain.LoyaltyRule{}, &domain.LoyaltyTier{},
+	&domain.LoyaltySetting{}, &domain.Promotion{}, &domain.Order{}, &domain.GiftCard{}, &domain.Payment{},
+	&domain.PurchaseOrderLine{},
+}
+
+// CountAmounts - Count the rows holding amounts in the store currency, stopping at the first table that has any
+func (repository *TaxRepositoryImpl) CountAmounts(ctx context.Context) (int64, error) {
+	for _, model := range amountModels {
+		var count int64
+		if err := dbFromContext(ctx, repository.db).Model(model).Count(&count).Error; err != nil || count > 0 {
+			return count, err
+		}
+	}
+	return 0, nil
+}
+
+// FindSetting - Get the store settings, the defaults when they were never saved
+func (repository *TaxRepositoryImpl) FindSetting(ctx context.Context) (domain.StoreSetting, error) {
+	var setting domain.StoreSetting
+	err := dbFromContext(ctx, repository.db).Order("id").First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.StoreSetting{}, nil
+	}
+	return setting, err
+}
+
+// SaveSetting - Insert or update the single store settings row
+func (repository *TaxRepositoryImpl) SaveSetting(ctx context.Context, setting domain.StoreSetting) (domain.StoreSetting, error) {
+	if err := dbFromContext(ctx, repository.db).Save(&setting).Error; err != nil {
+		return domain.StoreSetting{}, err
+	}
+	return setting, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+)
+
+type TxManager interface {
+	// WithinTransaction runs fn inside a single database transaction. Repositories called with the
+	// context handed to fn take part in that transaction, and any returned error rolls it back.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+type TxManagerImpl struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) TxManager {
+	return &TxManagerImpl{db: db}
+}
+
+func (manager *TxManagerImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return dbFromContext(ctx, manager.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// dbFromContext returns the transaction started by TxManager when there is one, otherwise db
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
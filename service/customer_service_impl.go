@@ -14,12 +14,14 @@ import (
 
 type CustomerServiceImpl struct {
 	CustomerRepository repository.CustomerRepository
+	LoyaltyService     LoyaltyService
 	Validate           *validator.Validate
 }
 
-func NewCustomerService(customerRepository repository.CustomerRepository, validate *validator.Validate) CustomerService {
+func NewCustomerService(customerRepository repository.CustomerRepository, loyaltyService LoyaltyService, validate *validator.Validate) CustomerService {
 	return &CustomerServiceImpl{
 		CustomerRepository: customerRepository,
+		LoyaltyService:     loyaltyService,
 		Validate:           validate,
 	}
 }
@@ -36,7 +38,7 @@ func (service *CustomerServiceImpl) Create(ctx context.Context, request web.Cust
 		return web.CustomerResponse{}, err
 	}
 
-	return helper.ToCustomerResponse(savedCustomer), nil
+	return service.toResponse(ctx, savedCustomer)
 }
 
 // Update Customer
@@ -58,7 +60,7 @@ func (service *CustomerServiceImpl) Update(ctx context.Context, request web.Cust
 		return web.CustomerResponse{}, err
 	}
 
-	return helper.ToCustomerResponse(updatedCustomer), nil
+	return service.toResponse(ctx, updatedCustomer)
 }
 
 // Delete Customer
@@ -82,7 +84,7 @@ func (service *CustomerServiceImpl) FindById(ctx context.Context, customerId uin
 		return web.CustomerResponse{}, err
 	}
 
-	return helper.ToCustomerResponse(customer), nil
+	return service.toResponse(ctx, customer)
 }
 
 // Find All Categories
@@ -92,5 +94,26 @@ func (service *CustomerServiceImpl) FindAll(ctx context.Context) ([]web.Customer
 		return nil, err
 	}
 
-	return helper.ToCustomerResponses(categories), nil
+	statuses, err := service.LoyaltyService.TierStatus(ctx, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	customerResponses := helper.ToCustomerResponses(categories)
+	for i := range customerResponses {
+		customerResponses[i].Tier = statuses[customerResponses[i].Id]
+	}
+	return customerResponses, nil
+}
+
+// toResponse adds the loyalty tier of the customer and their progress to the next one
+func (service *CustomerServiceImpl) toResponse(ctx context.Context, customer domain.Customer) (web.CustomerResponse, error) {
+	statuses, err := service.LoyaltyService.TierStatus(ctx, []domain.Customer{customer})
+	if err != nil {
+		return web.CustomerResponse{}, err
+	}
+
+	customerResponse := helper.ToCustomerResponse(customer)
+	customerResponse.Tier = statuses[customer.CustomerID]
+	return customerResponse, nil
 }
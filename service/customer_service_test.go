@@ -6,12 +6,22 @@ import (
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"github.com/Kahffi/go-rest-api-test/model/web"
 	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
+var customerTierTpl = web.CustomerTierResponse{
+	Basis:         domain.TierBasisSpend,
+	Value:         1500000,
+	NextTierName:  "Silver",
+	NextThreshold: 2000000,
+	Remaining:     500000,
+	Progress:      75,
+}
+
 var customerResponseTpl = web.CustomerResponse{
 	Id:         1,
 	Name:       "Harun maskiu",
@@ -19,6 +29,7 @@ var customerResponseTpl = web.CustomerResponse{
 	Phone:      "72346782364",
 	Address:    "Can't touch this",
 	LoyaltyPts: 100,
+	Tier:       customerTierTpl,
 }
 
 var customerModelTpl = domain.Customer{
@@ -30,20 +41,27 @@ var customerModelTpl = domain.Customer{
 	LoyaltyPts: 100,
 }
 
+// newTierStatusMock reports customerTierTpl as the tier of customer 1
+func newTierStatusMock(ctrl *gomock.Controller) *servicemocks.MockLoyaltyService {
+	loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+	loyaltyService.EXPECT().TierStatus(gomock.Any(), gomock.Any()).
+		Return(map[uint64]web.CustomerTierResponse{1: customerTierTpl}, nil).AnyTimes()
+	return loyaltyService
+}
+
 func TestCreateCustomer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockCustomerRepository(ctrl)
 	mockValidator := validator.New()
-	customerService := NewCustomerService(mockRepo, mockValidator)
+	customerService := NewCustomerService(mockRepo, newTierStatusMock(ctrl), mockValidator)
 
 	customerCreateReq := web.CustomerCreateRequest{
-		Name:       "Harun maskiu",
-		Email:      "gone@away.com",
-		Phone:      "72346782364",
-		Address:    "Can't touch this",
-		LoyaltyPts: 100,
+		Name:    "Harun maskiu",
+		Email:   "gone@away.com",
+		Phone:   "72346782364",
+		Address: "Can't touch this",
 	}
 
 	tests := []struct {
@@ -99,7 +117,7 @@ func TestDeleteCustomer(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockCustomerRepository(ctrl)
-	customerService := NewCustomerService(mockRepo, validator.New())
+	customerService := NewCustomerService(mockRepo, newTierStatusMock(ctrl), validator.New())
 
 	tests := []struct {
 		name       string
@@ -146,7 +164,6 @@ func TestUpdateCustomer(t *testing.T) {
 		Email:      "gone@away.com",
 		Phone:      "72346782364",
 		Address:    "Can't touch this",
-		LoyaltyPts: 100,
 	}
 
 	tests := []struct {
@@ -181,7 +198,7 @@ func TestUpdateCustomer(t *testing.T) {
 			mockCustomerRepo := mocks.NewMockCustomerRepository(ctrl)
 			tt.mock(mockCustomerRepo)
 
-			service := NewCustomerService(mockCustomerRepo, validator.New())
+			service := NewCustomerService(mockCustomerRepo, newTierStatusMock(ctrl), validator.New())
 			_, err := service.Update(context.Background(), tt.input)
 			assert.Equal(t, tt.expects, err)
 		})
@@ -220,7 +237,7 @@ func TestFindAllCustomers(t *testing.T) {
 			mockCustomerRepo := mocks.NewMockCustomerRepository(ctrl)
 			tt.mock(mockCustomerRepo)
 
-			service := NewCustomerService(mockCustomerRepo, validator.New())
+			service := NewCustomerService(mockCustomerRepo, newTierStatusMock(ctrl), validator.New())
 			result, err := service.FindAll(context.Background())
 			assert.Equal(t, tt.expects, result)
 			assert.Equal(t, tt.err, err)
@@ -263,7 +280,7 @@ func TestFindByIdCustomer(t *testing.T) {
 			mockCustomerRepo := mocks.NewMockCustomerRepository(ctrl)
 			tt.mock(mockCustomerRepo)
 
-			service := NewCustomerService(mockCustomerRepo, validator.New())
+			service := NewCustomerService(mockCustomerRepo, newTierStatusMock(ctrl), validator.New())
 			result, err := service.FindById(context.Background(), tt.input)
 			assert.Equal(t, tt.expects, result)
 			assert.Equal(t, tt.err, err)
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"time"
+)
+
+type DiscountService interface {
+	Create(ctx context.Context, request web.DiscountCreateRequest) (web.DiscountResponse, error)
+	Update(ctx context.Context, request web.DiscountUpdateRequest) (web.DiscountResponse, error)
+	Delete(ctx context.Context, discountId uint64) error
+	FindById(ctx context.Context, discountId uint64) (web.DiscountResponse, error)
+	FindAll(ctx context.Context) ([]web.DiscountResponse, error)
+	ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error
+}
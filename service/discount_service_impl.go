@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type DiscountServiceImpl struct {
+	DiscountRepository repository.DiscountRepository
+	CategoryRepository repository.CategoryRepository
+	ProductRepository  repository.ProductRepository
+	Validate           *validator.Validate
+}
+
+func NewDiscountService(discountRepository repository.DiscountRepository, categoryRepository repository.CategoryRepository,
+	productRepository repository.ProductRepository, validate *validator.Validate) DiscountService {
+	return &DiscountServiceImpl{
+		DiscountRepository: discountRepository,
+		CategoryRepository: categoryRepository,
+		ProductRepository:  productRepository,
+		Validate:           validate,
+	}
+}
+
+// Create Discount
+func (service *DiscountServiceImpl) Create(ctx context.Context, request web.DiscountCreateRequest) (web.DiscountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	discount := domain.Discount{
+		Description: request.Description,
+		DiscountPct: request.DiscountPct,
+		Scope:       request.Scope,
+		ValidFrom:   request.ValidFrom,
+		ValidUntil:  request.ValidUntil,
+	}
+	if err := service.setTargets(ctx, &discount, request.CategoryID, request.ProductIDs); err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	savedDiscount, err := service.DiscountRepository.Save(ctx, discount)
+	if err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	return helper.ToDiscountResponse(savedDiscount), nil
+}
+
+// Update Discount
+func (service *DiscountServiceImpl) Update(ctx context.Context, request web.DiscountUpdateRequest) (web.DiscountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	discount, err := service.DiscountRepository.FindById(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.DiscountResponse{}, exception.NewNotFoundError("Discount not found")
+	} else if err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	discount.Description = request.Description
+	discount.DiscountPct = request.DiscountPct
+	discount.Scope = request.Scope
+	discount.ValidFrom = request.ValidFrom
+	discount.ValidUntil = request.ValidUntil
+	if err := service.setTargets(ctx, &discount, request.CategoryID, request.ProductIDs); err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	updatedDiscount, err := service.DiscountRepository.Update(ctx, discount)
+	if err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	return helper.ToDiscountResponse(updatedDiscount), nil
+}
+
+// setTargets points the discount at the category or products its scope asks for, checking that they exist
+func (service *DiscountServiceImpl) setTargets(ctx context.Context, discount *domain.Discount, categoryId uint64, productIds []uint64) error {
+	discount.CategoryID = nil
+	discount.Products = []domain.Product{}
+
+	switch discount.Scope {
+	case domain.DiscountScopeCategory:
+		_, err := service.CategoryRepository.FindById(ctx, categoryId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Category not found")
+		} else if err != nil {
+			return err
+		}
+		discount.CategoryID = &categoryId
+	case domain.DiscountScopeProduct:
+		seen := make(map[uint64]bool)
+		for _, productId := range productIds {
+			if seen[productId] {
+				continue
+			}
+			seen[productId] = true
+
+			product, err := service.ProductRepository.FindById(ctx, productId)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return exception.NewNotFoundError(fmt.Sprintf("Product %d not found", productId))
+			} else if err != nil {
+				return err
+			}
+			discount.Products = append(discount.Products, product)
+		}
+	}
+	return nil
+}
+
+// Delete Discount, refused once it has been applied to an order
+func (service *DiscountServiceImpl) Delete(ctx context.Context, discountId uint64) error {
+	discount, err := service.DiscountRepository.FindById(ctx, discountId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Discount not found")
+	} else if err != nil {
+		return err
+	}
+
+	count, err := service.DiscountRepository.CountOrderItems(ctx, discountId)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return exception.NewConflictError(fmt.Sprintf("Discount is applied to %d order item(s)", count))
+	}
+
+	return service.DiscountRepository.Delete(ctx, discount)
+}
+
+// Find Discount By ID
+func (service *DiscountServiceImpl) FindById(ctx context.Context, discountId uint64) (web.DiscountResponse, error) {
+	discount, err := service.DiscountRepository.FindById(ctx, discountId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.DiscountResponse{}, exception.NewNotFoundError("Discount not found")
+	} else if err != nil {
+		return web.DiscountResponse{}, err
+	}
+
+	return helper.ToDiscountResponse(discount), nil
+}
+
+// Find All Discounts
+func (service *DiscountServiceImpl) FindAll(ctx context.Context) ([]web.DiscountResponse, error) {
+	discounts, err := service.DiscountRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToDiscountResponses(discounts), nil
+}
+
+// ApplyToOrder gives every line the best discount valid at the given time. Discounts don't stack: a line gets
+// the highest percentage among the order, category and product discounts that target it, the oldest
+// discount winning a tie. Lines already adjusted by a promotion get no discount on top.
+// Items must have their Product loaded and TotalPrice set.
+func (service *DiscountServiceImpl) ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error {
+	discounts, err := service.DiscountRepository.FindActive(ctx, at)
+	if err != nil {
+		return err
+	}
+
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		item.DiscountID = nil
+		item.DiscountAmount = 0
+		item.Discount = nil
+		if item.PromotionAmount > 0 {
+			continue
+		}
+
+		var best *domain.Discount
+		for j := range discounts {
+			discount := &discounts[j]
+			if !discount.IsValidAt(at) || !discount.AppliesTo(item.Product) {
+				continue
+			}
+			if best == nil || discount.DiscountPct > best.DiscountPct {
+				best = discount
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		item.DiscountID = &best.DiscountID
+		item.DiscountAmount = item.TotalPrice.Percent(best.DiscountPct)
+		item.Discount = best
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+var discountModelTpl = domain.Discount{
+	DiscountID:  1,
+	Description: "Storewide 10%",
+	DiscountPct: 10,
+	Scope:       domain.DiscountScopeOrder,
+	ValidFrom:   time.Now().AddDate(0, 0, -1),
+	ValidUntil:  time.Now().AddDate(0, 0, 1),
+}
+
+// newDiscountService builds a discount service that only needs discount lookups
+func newDiscountService(discountRepo *mocks.MockDiscountRepository) DiscountService {
+	return NewDiscountService(discountRepo, nil, nil, validator.New())
+}
+
+func TestCreateDiscount(t *testing.T) {
+	validFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := validFrom.AddDate(0, 1, 0)
+	categoryId := uint64(3)
+
+	tests := []struct {
+		name   string
+		input  web.DiscountCreateRequest
+		mock   func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository)
+		expect web.DiscountResponse
+		err    error
+	}{
+		{
+			name: "Category scope",
+			input: web.DiscountCreateRequest{Description: "Drinks", DiscountPct: 15, Scope: domain.DiscountScopeCategory,
+				CategoryID: 3, ValidFrom: validFrom, ValidUntil: validUntil},
+			mock: func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository) {
+				categoryRepo.EXPECT().FindById(gomock.Any(), uint64(3)).Return(domain.Category{Id: 3}, nil)
+				discountRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+					discount.DiscountID = 1
+					return discount, nil
+				})
+			},
+			expect: web.DiscountResponse{Id: 1, Description: "Drinks", DiscountPct: 15, Scope: domain.DiscountScopeCategory,
+				CategoryID: &categoryId, ValidFrom: validFrom, ValidUntil: validUntil},
+		},
+		{
+			name: "Product scope links each product once",
+			input: web.DiscountCreateRequest{Description: "Promo", DiscountPct: 5, Scope: domain.DiscountScopeProduct,
+				ProductIDs: []uint64{1, 1}, ValidFrom: validFrom, ValidUntil: validUntil},
+			mock: func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				discountRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, discount domain.Discount) (domain.Discount, error) {
+					discount.DiscountID = 2
+					return discount, nil
+				})
+			},
+			expect: web.DiscountResponse{Id: 2, Description: "Promo", DiscountPct: 5, Scope: domain.DiscountScopeProduct,
+				ProductIDs: []uint64{1}, ValidFrom: validFrom, ValidUntil: validUntil},
+		},
+		{
+			name: "Unknown Product",
+			input: web.DiscountCreateRequest{Description: "Promo", DiscountPct: 5, Scope: domain.DiscountScopeProduct,
+				ProductIDs: []uint64{9}, ValidFrom: validFrom, ValidUntil: validUntil},
+			mock: func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Product{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Product 9 not found"),
+		},
+		{
+			name: "Window ends before it starts",
+			input: web.DiscountCreateRequest{Description: "Promo", DiscountPct: 5, Scope: domain.DiscountScopeOrder,
+				ValidFrom: validUntil, ValidUntil: validFrom},
+			mock: func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository) {
+			},
+			err: errors.New("validation"),
+		},
+		{
+			name: "Category scope without category",
+			input: web.DiscountCreateRequest{Description: "Promo", DiscountPct: 5, Scope: domain.DiscountScopeCategory,
+				ValidFrom: validFrom, ValidUntil: validUntil},
+			mock: func(discountRepo *mocks.MockDiscountRepository, categoryRepo *mocks.MockCategoryRepository, productRepo *mocks.MockProductRepository) {
+			},
+			err: errors.New("validation"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			discountRepo := mocks.NewMockDiscountRepository(ctrl)
+			categoryRepo := mocks.NewMockCategoryRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			tt.mock(discountRepo, categoryRepo, productRepo)
+
+			service := NewDiscountService(discountRepo, categoryRepo, productRepo, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			if tt.err != nil {
+				assert.Error(t, err)
+				if _, ok := tt.err.(exception.NotFoundError); ok {
+					assert.Equal(t, tt.err, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestDeleteDiscount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	service := newDiscountService(discountRepo)
+
+	discountRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(discountModelTpl, nil)
+	discountRepo.EXPECT().CountOrderItems(gomock.Any(), uint64(1)).Return(int64(0), nil)
+	discountRepo.EXPECT().Delete(gomock.Any(), discountModelTpl).Return(nil)
+	assert.NoError(t, service.Delete(context.Background(), 1))
+
+	discountRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(discountModelTpl, nil)
+	discountRepo.EXPECT().CountOrderItems(gomock.Any(), uint64(1)).Return(int64(3), nil)
+	assert.Equal(t, exception.NewConflictError("Discount is applied to 3 order item(s)"), service.Delete(context.Background(), 1))
+
+	discountRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Discount{}, gorm.ErrRecordNotFound)
+	assert.Equal(t, exception.NewNotFoundError("Discount not found"), service.Delete(context.Background(), 2))
+}
+
+func TestApplyDiscountsToOrder(t *testing.T) {
+	now := time.Now()
+	categoryId := uint64(2)
+	orderWide := discountModelTpl
+	category := domain.Discount{DiscountID: 2, DiscountPct: 20, Scope: domain.DiscountScopeCategory, CategoryID: &categoryId,
+		ValidFrom: now.AddDate(0, 0, -1), ValidUntil: now.AddDate(0, 0, 1)}
+	sameAsCategory := domain.Discount{DiscountID: 3, DiscountPct: 20, Scope: domain.DiscountScopeProduct,
+		Products: []domain.Product{{ProductID: 1}}, ValidFrom: now.AddDate(0, 0, -1), ValidUntil: now.AddDate(0, 0, 1)}
+	expired := domain.Discount{DiscountID: 4, DiscountPct: 50, Scope: domain.DiscountScopeOrder,
+		ValidFrom: now.AddDate(0, 0, -7), ValidUntil: now.AddDate(0, 0, -1)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	discountRepo.EXPECT().FindActive(gomock.Any(), now).Return([]domain.Discount{orderWide, category, sameAsCategory, expired}, nil)
+
+	order := domain.Order{OrderItems: []domain.OrderItem{
+		{ProductID: 1, TotalPrice: money.New(10000), Product: domain.Product{ProductID: 1, CategoryId: 2}},
+		{ProductID: 5, TotalPrice: money.New(333), Product: domain.Product{ProductID: 5, CategoryId: 9}},
+	}}
+	err := newDiscountService(discountRepo).ApplyToOrder(context.Background(), &order, now)
+	assert.NoError(t, err)
+
+	// Highest percentage wins, the older discount breaks the tie
+	assert.Equal(t, uint64(2), *order.OrderItems[0].DiscountID)
+	assert.Equal(t, money.New(2000), order.OrderItems[0].DiscountAmount)
+	assert.Equal(t, uint64(1), *order.OrderItems[1].DiscountID)
+	assert.Equal(t, money.Money(3330), order.OrderItems[1].DiscountAmount)
+}
+
+func TestApplyParentDiscountToVariants(t *testing.T) {
+	now := time.Now()
+	parent := domain.Discount{DiscountID: 5, DiscountPct: 15, Scope: domain.DiscountScopeProduct,
+		Products: []domain.Product{{ProductID: 10}}, ValidFrom: now.AddDate(0, 0, -1), ValidUntil: now.AddDate(0, 0, 1)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	discountRepo.EXPECT().FindActive(gomock.Any(), now).Return([]domain.Discount{parent}, nil)
+
+	order := domain.Order{OrderItems: []domain.OrderItem{
+		{ProductID: 11, TotalPrice: money.New(50000), Product: variantModelTpl(11, "S")},
+		{ProductID: 1, TotalPrice: money.New(10000), Product: domain.Product{ProductID: 1, CategoryId: 2}},
+	}}
+	err := newDiscountService(discountRepo).ApplyToOrder(context.Background(), &order, now)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(5), *order.OrderItems[0].DiscountID)
+	assert.Equal(t, money.New(7500), order.OrderItems[0].DiscountAmount)
+	assert.Nil(t, order.OrderItems[1].DiscountID)
+}
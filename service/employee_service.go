@@ -10,5 +10,5 @@ type EmployeeService interface {
 	Update(ctx context.Context, request web.EmployeeUpdateRequest) (web.EmployeeResponse, error)
 	Delete(ctx context.Context, employeeId uint64) error
 	FindById(ctx context.Context, employeeId uint64) (web.EmployeeResponse, error)
-	FindAll(ctx context.Context) ([]web.EmployeeResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.EmployeeResponse, error)
 }
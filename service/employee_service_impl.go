@@ -14,12 +14,15 @@ import (
 
 type EmployeeServiceImpl struct {
 	EmployeeRepository repository.EmployeeRepository
+	StoreService       StoreService
 	Validate           *validator.Validate
 }
 
-func NewEmployeeService(employeeRepository repository.EmployeeRepository, validate *validator.Validate) EmployeeService {
+func NewEmployeeService(employeeRepository repository.EmployeeRepository, storeService StoreService,
+	validate *validator.Validate) EmployeeService {
 	return &EmployeeServiceImpl{
 		EmployeeRepository: employeeRepository,
+		StoreService:       storeService,
 		Validate:           validate,
 	}
 }
@@ -30,7 +33,11 @@ func (service *EmployeeServiceImpl) Create(ctx context.Context, request web.Empl
 		return web.EmployeeResponse{}, err
 	}
 
-	employee := domain.Employee{Name: request.Name}
+	if err := service.checkStore(ctx, request.StoreID); err != nil {
+		return web.EmployeeResponse{}, err
+	}
+
+	employee := domain.Employee{Name: request.Name, StoreID: request.StoreID}
 	savedEmployee, err := service.EmployeeRepository.Save(ctx, employee)
 	if err != nil {
 		return web.EmployeeResponse{}, err
@@ -52,7 +59,12 @@ func (service *EmployeeServiceImpl) Update(ctx context.Context, request web.Empl
 		return web.EmployeeResponse{}, err
 	}
 
+	if err := service.checkStore(ctx, request.StoreID); err != nil {
+		return web.EmployeeResponse{}, err
+	}
+
 	employee.Name = request.Name
+	employee.StoreID = request.StoreID
 	updatedEmployee, err := service.EmployeeRepository.Update(ctx, employee)
 	if err != nil {
 		return web.EmployeeResponse{}, err
@@ -85,12 +97,21 @@ func (service *EmployeeServiceImpl) FindById(ctx context.Context, employeeId uin
 	return helper.ToEmployeeResponse(employee), nil
 }
 
-// Find All Categories
-func (service *EmployeeServiceImpl) FindAll(ctx context.Context) ([]web.EmployeeResponse, error) {
-	categories, err := service.EmployeeRepository.FindAll(ctx)
+// Find All Employees assigned to a store, or every employee when storeId is 0
+func (service *EmployeeServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.EmployeeResponse, error) {
+	employees, err := service.EmployeeRepository.FindAll(ctx, storeId)
 	if err != nil {
 		return nil, err
 	}
 
-	return helper.ToEmployeeResponses(categories), nil
+	return helper.ToEmployeeResponses(employees), nil
+}
+
+// checkStore makes sure an employee is assigned to a store that trades, nil assigns them to the whole chain
+func (service *EmployeeServiceImpl) checkStore(ctx context.Context, storeId *uint64) error {
+	if storeId == nil {
+		return nil
+	}
+	_, err := service.StoreService.FindActive(ctx, *storeId)
+	return err
 }
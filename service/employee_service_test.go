@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"github.com/Kahffi/go-rest-api-test/exception"
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"github.com/Kahffi/go-rest-api-test/model/web"
 	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -35,8 +37,9 @@ func TestCreateEmployee(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockEmployeeRepository(ctrl)
+	mockStoreService := servicemocks.NewMockStoreService(ctrl)
 	mockValidator := validator.New()
-	employeeService := NewEmployeeService(mockRepo, mockValidator)
+	employeeService := NewEmployeeService(mockRepo, mockStoreService, mockValidator)
 
 	employeeCreateReq := web.EmployeeCreateRequest{
 		Name:      "Harun maskiu",
@@ -45,6 +48,9 @@ func TestCreateEmployee(t *testing.T) {
 		Phone:     "72346782364",
 		DateHired: "24/10/2010",
 	}
+	storeId := uint64(2)
+	storeCreateReq := employeeCreateReq
+	storeCreateReq.StoreID = &storeId
 
 	tests := []struct {
 		name      string
@@ -69,6 +75,26 @@ func TestCreateEmployee(t *testing.T) {
 			expect:    web.EmployeeResponse{},
 			expectErr: true,
 		},
+		{
+			name:  "assigned to a store",
+			input: storeCreateReq,
+			mock: func() {
+				mockStoreService.EXPECT().FindActive(gomock.Any(), uint64(2)).Return(domain.Store{StoreID: 2, Active: true}, nil)
+				mockRepo.EXPECT().Save(gomock.Any(), domain.Employee{Name: "Harun maskiu", StoreID: &storeId}).
+					Return(domain.Employee{EmployeeID: 3, Name: "Harun maskiu", StoreID: &storeId}, nil)
+			},
+			expect:    web.EmployeeResponse{Id: 3, Name: "Harun maskiu", StoreID: &storeId},
+			expectErr: false,
+		},
+		{
+			name:  "assigned to an inactive store",
+			input: storeCreateReq,
+			mock: func() {
+				mockStoreService.EXPECT().FindActive(gomock.Any(), uint64(2)).Return(domain.Store{}, exception.NewConflictError("Store JKT01 is inactive"))
+			},
+			expect:    web.EmployeeResponse{},
+			expectErr: true,
+		},
 		{
 			name:  "repository error",
 			input: employeeCreateReq,
@@ -99,7 +125,7 @@ func TestDeleteEmployee(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockEmployeeRepository(ctrl)
-	employeeService := NewEmployeeService(mockRepo, validator.New())
+	employeeService := NewEmployeeService(mockRepo, nil, validator.New())
 
 	tests := []struct {
 		name       string
@@ -181,7 +207,7 @@ func TestUpdateEmployee(t *testing.T) {
 			mockEmployeeRepo := mocks.NewMockEmployeeRepository(ctrl)
 			tt.mock(mockEmployeeRepo)
 
-			service := NewEmployeeService(mockEmployeeRepo, validator.New())
+			service := NewEmployeeService(mockEmployeeRepo, nil, validator.New())
 			_, err := service.Update(context.Background(), tt.input)
 			assert.Equal(t, tt.expects, err)
 		})
@@ -198,7 +224,7 @@ func TestFindAllEmployees(t *testing.T) {
 		{
 			name: "Success",
 			mock: func(mockEmployeeRepo *mocks.MockEmployeeRepository) {
-				mockEmployeeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Employee{employeeModelTpl}, nil)
+				mockEmployeeRepo.EXPECT().FindAll(gomock.Any(), uint64(0)).Return([]domain.Employee{employeeModelTpl}, nil)
 			},
 			expects: []web.EmployeeResponse{employeeResponseTpl},
 			err:     nil,
@@ -206,7 +232,7 @@ func TestFindAllEmployees(t *testing.T) {
 		{
 			name: "Database Error",
 			mock: func(mockEmployeeRepo *mocks.MockEmployeeRepository) {
-				mockEmployeeRepo.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("database error"))
+				mockEmployeeRepo.EXPECT().FindAll(gomock.Any(), uint64(0)).Return(nil, errors.New("database error"))
 			},
 			expects: nil,
 			err:     errors.New("database error"),
@@ -220,8 +246,8 @@ func TestFindAllEmployees(t *testing.T) {
 			mockEmployeeRepo := mocks.NewMockEmployeeRepository(ctrl)
 			tt.mock(mockEmployeeRepo)
 
-			service := NewEmployeeService(mockEmployeeRepo, validator.New())
-			result, err := service.FindAll(context.Background())
+			service := NewEmployeeService(mockEmployeeRepo, nil, validator.New())
+			result, err := service.FindAll(context.Background(), 0)
 			assert.Equal(t, tt.expects, result)
 			assert.Equal(t, tt.err, err)
 		})
@@ -263,7 +289,7 @@ func TestFindByIdEmployee(t *testing.T) {
 			mockEmployeeRepo := mocks.NewMockEmployeeRepository(ctrl)
 			tt.mock(mockEmployeeRepo)
 
-			service := NewEmployeeService(mockEmployeeRepo, validator.New())
+			service := NewEmployeeService(mockEmployeeRepo, nil, validator.New())
 			result, err := service.FindById(context.Background(), tt.input)
 			assert.Equal(t, tt.expects, result)
 			assert.Equal(t, tt.err, err)
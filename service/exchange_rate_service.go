@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"time"
+)
+
+type ExchangeRateService interface {
+	Create(ctx context.Context, request web.ExchangeRateCreateRequest) (web.ExchangeRateResponse, error)
+	Delete(ctx context.Context, exchangeRateId uint64) error
+	FindById(ctx context.Context, exchangeRateId uint64) (web.ExchangeRateResponse, error)
+	FindAll(ctx context.Context) ([]web.ExchangeRateResponse, error)
+	RateAt(ctx context.Context, currency string, at time.Time) (float64, error)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ExchangeRateServiceImpl struct {
+	ExchangeRateRepository repository.ExchangeRateRepository
+	Validate               *validator.Validate
+}
+
+func NewExchangeRateService(exchangeRateRepository repository.ExchangeRateRepository, validate *validator.Validate) ExchangeRateService {
+	return &ExchangeRateServiceImpl{
+		ExchangeRateRepository: exchangeRateRepository,
+		Validate:               validate,
+	}
+}
+
+// Create Exchange rate. Orders and payments keep the rate they were taken at, so a new rate only counts
+// from its effective date on.
+func (service *ExchangeRateServiceImpl) Create(ctx context.Context, request web.ExchangeRateCreateRequest) (web.ExchangeRateResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.ExchangeRateResponse{}, err
+	}
+	if request.Currency == money.Currency() {
+		return web.ExchangeRateResponse{}, exception.NewBadRequestError(fmt.Sprintf("%s is the store currency, its rate is always 1", request.Currency))
+	}
+
+	now := time.Now()
+	rate := domain.ExchangeRate{
+		Currency:      request.Currency,
+		Rate:          request.Rate,
+		EffectiveFrom: now,
+		CreatedAt:     now,
+	}
+	if request.EffectiveFrom != nil {
+		rate.EffectiveFrom = *request.EffectiveFrom
+	}
+	savedRate, err := service.ExchangeRateRepository.Save(ctx, rate)
+	if err != nil {
+		return web.ExchangeRateResponse{}, err
+	}
+
+	return helper.ToExchangeRateResponse(savedRate), nil
+}
+
+// Delete Exchange rate, the one before it is in effect again
+func (service *ExchangeRateServiceImpl) Delete(ctx context.Context, exchangeRateId uint64) error {
+	rate, err := service.ExchangeRateRepository.FindById(ctx, exchangeRateId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Exchange rate not found")
+	} else if err != nil {
+		return err
+	}
+
+	return service.ExchangeRateRepository.Delete(ctx, rate)
+}
+
+// Find Exchange rate By ID
+func (service *ExchangeRateServiceImpl) FindById(ctx context.Context, exchangeRateId uint64) (web.ExchangeRateResponse, error) {
+	rate, err := service.ExchangeRateRepository.FindById(ctx, exchangeRateId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ExchangeRateResponse{}, exception.NewNotFoundError("Exchange rate not found")
+	} else if err != nil {
+		return web.ExchangeRateResponse{}, err
+	}
+
+	return helper.ToExchangeRateResponse(rate), nil
+}
+
+// Find All Exchange rates
+func (service *ExchangeRateServiceImpl) FindAll(ctx context.Context) ([]web.ExchangeRateResponse, error) {
+	rates, err := service.ExchangeRateRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToExchangeRateResponses(rates), nil
+}
+
+// RateAt is what one unit of the currency is worth in the store currency at the given time. The store
+// currency is worth 1, a currency without a rate in effect cannot be used.
+func (service *ExchangeRateServiceImpl) RateAt(ctx context.Context, currency string, at time.Time) (float64, error) {
+	if currency == money.Currency() {
+		return 1, nil
+	}
+
+	rate, err := service.ExchangeRateRepository.FindEffective(ctx, currency, at)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, exception.NewBadRequestError(fmt.Sprintf("No %s exchange rate in effect on %s", currency, at.Format("2006-01-02")))
+	} else if err != nil {
+		return 0, err
+	}
+	return rate.Rate, nil
+}
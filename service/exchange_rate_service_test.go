@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func TestCreateExchangeRate(t *testing.T) {
+	effectiveFrom := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input web.ExchangeRateCreateRequest
+		mock  func(rateRepo *mocks.MockExchangeRateRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.ExchangeRateCreateRequest{Currency: "USD", Rate: 15500, EffectiveFrom: &effectiveFrom},
+			mock: func(rateRepo *mocks.MockExchangeRateRepository) {
+				rateRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+						assert.Equal(t, "USD", rate.Currency)
+						assert.Equal(t, 15500.0, rate.Rate)
+						assert.Equal(t, effectiveFrom, rate.EffectiveFrom)
+						rate.ExchangeRateID = 1
+						return rate, nil
+					})
+			},
+		},
+		{
+			name:  "Store currency",
+			input: web.ExchangeRateCreateRequest{Currency: "IDR", Rate: 1},
+			mock:  func(rateRepo *mocks.MockExchangeRateRepository) {},
+			err:   exception.NewBadRequestError("IDR is the store currency, its rate is always 1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rateRepo := mocks.NewMockExchangeRateRepository(ctrl)
+			tt.mock(rateRepo)
+
+			service := NewExchangeRateService(rateRepo, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(1), result.Id)
+				assert.Equal(t, "IDR", result.BaseCurrency)
+			}
+		})
+	}
+}
+
+func TestExchangeRateAt(t *testing.T) {
+	at := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		currency string
+		mock     func(rateRepo *mocks.MockExchangeRateRepository)
+		expected float64
+		err      error
+	}{
+		{
+			name:     "Rate in effect",
+			currency: "SGD",
+			mock: func(rateRepo *mocks.MockExchangeRateRepository) {
+				rateRepo.EXPECT().FindEffective(gomock.Any(), "SGD", at).Return(domain.ExchangeRate{Currency: "SGD", Rate: 11481.02}, nil)
+			},
+			expected: 11481.02,
+		},
+		{
+			name:     "Store currency",
+			currency: "IDR",
+			mock:     func(rateRepo *mocks.MockExchangeRateRepository) {},
+			expected: 1,
+		},
+		{
+			name:     "No rate yet",
+			currency: "USD",
+			mock: func(rateRepo *mocks.MockExchangeRateRepository) {
+				rateRepo.EXPECT().FindEffective(gomock.Any(), "USD", at).Return(domain.ExchangeRate{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewBadRequestError("No USD exchange rate in effect on 2026-10-16"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rateRepo := mocks.NewMockExchangeRateRepository(ctrl)
+			tt.mock(rateRepo)
+
+			service := NewExchangeRateService(rateRepo, validator.New())
+			rate, err := service.RateAt(context.Background(), tt.currency, at)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, rate)
+		})
+	}
+}
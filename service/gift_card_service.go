@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+)
+
+type GiftCardService interface {
+	Issue(ctx context.Context, request web.GiftCardIssueRequest) (web.GiftCardResponse, error)
+	TopUp(ctx context.Context, request web.GiftCardTopUpRequest) (web.GiftCardResponse, error)
+	FindByCode(ctx context.Context, code string) (web.GiftCardResponse, error)
+	FindAll(ctx context.Context) ([]web.GiftCardResponse, error)
+	FindLedger(ctx context.Context, code string) (web.GiftCardLedgerResponse, error)
+	ExpireBalances(ctx context.Context) (int, error)
+	CardForTender(ctx context.Context, order domain.Order, paymentType string, code string) (domain.GiftCard, error)
+	Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error
+	RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error
+	IssueStoreCredit(ctx context.Context, order domain.Order, amount money.Money) (domain.GiftCard, error)
+}
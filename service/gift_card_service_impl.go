@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"log"
+	"math/big"
+	"time"
+)
+
+// giftCardCodeAlphabet leaves out 0, O, 1 and I, which are easily mixed up when a code is typed in
+const giftCardCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+type GiftCardServiceImpl struct {
+	TxManager          repository.TxManager
+	GiftCardRepository repository.GiftCardRepository
+	CustomerRepository repository.CustomerRepository
+	Validate           *validator.Validate
+}
+
+func NewGiftCardService(txManager repository.TxManager, giftCardRepository repository.GiftCardRepository,
+	customerRepository repository.CustomerRepository, validate *validator.Validate) GiftCardService {
+	return &GiftCardServiceImpl{
+		TxManager:          txManager,
+		GiftCardRepository: giftCardRepository,
+		CustomerRepository: customerRepository,
+		Validate:           validate,
+	}
+}
+
+// Issue a gift card or store credit with a new code, the opening balance is its first ledger entry
+func (service *GiftCardServiceImpl) Issue(ctx context.Context, request web.GiftCardIssueRequest) (web.GiftCardResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.GiftCardResponse{}, err
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return web.GiftCardResponse{}, exception.NewBadRequestError("Expiry must be in the future")
+	}
+	if request.CustomerID != nil {
+		_, err := service.CustomerRepository.FindById(ctx, *request.CustomerID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.GiftCardResponse{}, exception.NewNotFoundError("Customer not found")
+		} else if err != nil {
+			return web.GiftCardResponse{}, err
+		}
+	}
+
+	reference := request.Reference
+	if reference == "" {
+		reference = "Issued"
+	}
+	card, err := service.issue(ctx, domain.GiftCard{
+		Kind:       request.Kind,
+		CustomerID: request.CustomerID,
+		ExpiresAt:  request.ExpiresAt,
+	}, request.Amount, nil, reference)
+	if err != nil {
+		return web.GiftCardResponse{}, err
+	}
+
+	return helper.ToGiftCardResponse(card), nil
+}
+
+// issue saves the card under a fresh code and books its opening balance in one transaction
+func (service *GiftCardServiceImpl) issue(ctx context.Context, card domain.GiftCard, amount money.Money, orderId *uint64, reference string) (domain.GiftCard, error) {
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		code, err := service.newCode(ctx)
+		if err != nil {
+			return err
+		}
+		card.Code = code
+
+		if card, err = service.GiftCardRepository.Save(ctx, card); err != nil {
+			return err
+		}
+		transaction, err := service.GiftCardRepository.MoveBalance(ctx, domain.GiftCardTransaction{
+			GiftCardID: card.GiftCardID,
+			Type:       domain.GiftCardTypeIssue,
+			Amount:     amount,
+			OrderID:    orderId,
+			Reference:  reference,
+		})
+		card.Balance = transaction.Amount
+		return err
+	})
+	if err != nil {
+		return domain.GiftCard{}, err
+	}
+	return card, nil
+}
+
+// newCode draws random codes until one is not taken yet, the unique index on the code catches the rest
+func (service *GiftCardServiceImpl) newCode(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < 5; attempt++ {
+		code := make([]byte, 0, 19)
+		for i := 0; i < 16; i++ {
+			if i > 0 && i%4 == 0 {
+				code = append(code, '-')
+			}
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(giftCardCodeAlphabet))))
+			if err != nil {
+				return "", err
+			}
+			code = append(code, giftCardCodeAlphabet[n.Int64()])
+		}
+
+		_, err := service.GiftCardRepository.FindByCode(ctx, string(code))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return string(code), nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+	return "", errors.New("could not find a free gift card code")
+}
+
+// TopUp adds money to a card that has not expired
+func (service *GiftCardServiceImpl) TopUp(ctx context.Context, request web.GiftCardTopUpRequest) (web.GiftCardResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.GiftCardResponse{}, err
+	}
+
+	card, err := service.findCard(ctx, request.Code)
+	if err != nil {
+		return web.GiftCardResponse{}, err
+	}
+	if card.IsExpiredAt(time.Now()) {
+		return web.GiftCardResponse{}, exception.NewConflictError("Gift card has expired")
+	}
+
+	reference := request.Reference
+	if reference == "" {
+		reference = "Top-up"
+	}
+	_, err = service.GiftCardRepository.MoveBalance(ctx, domain.GiftCardTransaction{
+		GiftCardID: card.GiftCardID,
+		Type:       domain.GiftCardTypeTopUp,
+		Amount:     request.Amount,
+		Reference:  reference,
+	})
+	if err != nil {
+		return web.GiftCardResponse{}, err
+	}
+
+	card, err = service.GiftCardRepository.FindById(ctx, card.GiftCardID)
+	if err != nil {
+		return web.GiftCardResponse{}, err
+	}
+	return helper.ToGiftCardResponse(card), nil
+}
+
+// FindByCode - Check the balance of a card
+func (service *GiftCardServiceImpl) FindByCode(ctx context.Context, code string) (web.GiftCardResponse, error) {
+	card, err := service.findCard(ctx, code)
+	if err != nil {
+		return web.GiftCardResponse{}, err
+	}
+
+	return helper.ToGiftCardResponse(card), nil
+}
+
+// FindAll - Find all gift cards and store credit
+func (service *GiftCardServiceImpl) FindAll(ctx context.Context) ([]web.GiftCardResponse, error) {
+	cards, err := service.GiftCardRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToGiftCardResponses(cards), nil
+}
+
+// FindLedger - Get the ledger of a card and check it adds up to its balance
+func (service *GiftCardServiceImpl) FindLedger(ctx context.Context, code string) (web.GiftCardLedgerResponse, error) {
+	card, err := service.findCard(ctx, code)
+	if err != nil {
+		return web.GiftCardLedgerResponse{}, err
+	}
+
+	transactions, err := service.GiftCardRepository.FindTransactions(ctx, card.GiftCardID)
+	if err != nil {
+		return web.GiftCardLedgerResponse{}, err
+	}
+	ledgerBalance, err := service.GiftCardRepository.SumByCardId(ctx, card.GiftCardID)
+	if err != nil {
+		return web.GiftCardLedgerResponse{}, err
+	}
+
+	return web.GiftCardLedgerResponse{
+		Card:          helper.ToGiftCardResponse(card),
+		LedgerBalance: ledgerBalance,
+		Consistent:    card.Balance == ledgerBalance,
+		Transactions:  helper.ToGiftCardTransactionResponses(transactions),
+	}, nil
+}
+
+// ExpireBalances writes off what is left on every expired card and returns how many cards it wrote off
+func (service *GiftCardServiceImpl) ExpireBalances(ctx context.Context) (int, error) {
+	cards, err := service.GiftCardRepository.FindExpired(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	var expired int
+	for _, card := range cards {
+		_, err := service.GiftCardRepository.MoveBalance(ctx, domain.GiftCardTransaction{
+			GiftCardID: card.GiftCardID,
+			Type:       domain.GiftCardTypeExpire,
+			Amount:     -card.Balance,
+			Reference:  "Expired",
+		})
+		// A refund or redemption got in between, the card is written off on the next run
+		if errors.Is(err, repository.ErrInsufficientBalance) {
+			log.Printf("Balance of gift card %d changed while expiring it", card.GiftCardID)
+			continue
+		} else if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// CardForTender looks up the card a gift card or store credit tender pays from and checks it may pay the order
+func (service *GiftCardServiceImpl) CardForTender(ctx context.Context, order domain.Order, paymentType string, code string) (domain.GiftCard, error) {
+	card, err := service.findCard(ctx, code)
+	if err != nil {
+		return domain.GiftCard{}, err
+	}
+	if card.Kind != paymentType {
+		return domain.GiftCard{}, exception.NewBadRequestError(fmt.Sprintf("Card %s is %s, not %s", card.Code, card.Kind, paymentType))
+	}
+	if card.IsExpiredAt(time.Now()) {
+		return domain.GiftCard{}, exception.NewConflictError("Gift card has expired")
+	}
+	if card.Kind == domain.GiftCardKindStoreCredit && (card.CustomerID == nil || *card.CustomerID != order.CustomerID) {
+		return domain.GiftCard{}, exception.NewConflictError("Store credit belongs to another customer")
+	}
+	return card, nil
+}
+
+// Redeem takes a gift card or store credit tender off its card
+func (service *GiftCardServiceImpl) Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	_, err := service.GiftCardRepository.MoveBalance(ctx, domain.GiftCardTransaction{
+		GiftCardID: *payment.GiftCardID,
+		Type:       domain.GiftCardTypeRedeem,
+		Amount:     -payment.Amount,
+		OrderID:    &order.OrderID,
+		PaymentID:  &payment.PaymentID,
+		Reference:  fmt.Sprintf("Order #%d", order.OrderID),
+	})
+	if errors.Is(err, repository.ErrInsufficientBalance) {
+		return exception.NewConflictError(fmt.Sprintf("Gift card balance does not cover %s", payment.Amount))
+	}
+	return err
+}
+
+// RefundRedemption puts a refunded or voided gift card tender back on its card, expired or not
+func (service *GiftCardServiceImpl) RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	_, err := service.GiftCardRepository.MoveBalance(ctx, domain.GiftCardTransaction{
+		GiftCardID: *payment.GiftCardID,
+		Type:       domain.GiftCardTypeRefund,
+		Amount:     payment.Amount,
+		OrderID:    &order.OrderID,
+		PaymentID:  &payment.PaymentID,
+		Reference:  fmt.Sprintf("Order #%d refunded", order.OrderID),
+	})
+	return err
+}
+
+// IssueStoreCredit issues store credit to the customer of the order for a refund
+func (service *GiftCardServiceImpl) IssueStoreCredit(ctx context.Context, order domain.Order, amount money.Money) (domain.GiftCard, error) {
+	customerId := order.CustomerID
+	return service.issue(ctx, domain.GiftCard{
+		Kind:       domain.GiftCardKindStoreCredit,
+		CustomerID: &customerId,
+	}, amount, &order.OrderID, fmt.Sprintf("Refund of order #%d", order.OrderID))
+}
+
+func (service *GiftCardServiceImpl) findCard(ctx context.Context, code string) (domain.GiftCard, error) {
+	card, err := service.GiftCardRepository.FindByCode(ctx, code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.GiftCard{}, exception.NewNotFoundError("Gift card not found")
+	}
+	return card, err
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestIssueGiftCard(t *testing.T) {
+	customerId := uint64(1)
+	past := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name    string
+		input   web.GiftCardIssueRequest
+		mock    func(giftCardRepo *mocks.MockGiftCardRepository, customerRepo *mocks.MockCustomerRepository)
+		invalid bool
+		err     error
+	}{
+		{
+			name:  "Success",
+			input: web.GiftCardIssueRequest{Kind: domain.GiftCardKindGiftCard, Amount: money.New(250000)},
+			mock: func(giftCardRepo *mocks.MockGiftCardRepository, customerRepo *mocks.MockCustomerRepository) {
+				giftCardRepo.EXPECT().FindByCode(gomock.Any(), gomock.Any()).Return(domain.GiftCard{}, gorm.ErrRecordNotFound)
+				giftCardRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, card domain.GiftCard) (domain.GiftCard, error) {
+						assert.Regexp(t, regexp.MustCompile(`^[A-Z2-9]{4}(-[A-Z2-9]{4}){3}$`), card.Code)
+						card.GiftCardID = 4
+						return card, nil
+					})
+				giftCardRepo.EXPECT().MoveBalance(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transaction domain.GiftCardTransaction) (domain.GiftCardTransaction, error) {
+						assert.Equal(t, uint64(4), transaction.GiftCardID)
+						assert.Equal(t, domain.GiftCardTypeIssue, transaction.Type)
+						assert.Equal(t, money.New(250000), transaction.Amount)
+						return transaction, nil
+					})
+			},
+		},
+		{
+			name:    "Store credit without a customer",
+			input:   web.GiftCardIssueRequest{Kind: domain.GiftCardKindStoreCredit, Amount: money.New(250000)},
+			mock:    func(giftCardRepo *mocks.MockGiftCardRepository, customerRepo *mocks.MockCustomerRepository) {},
+			invalid: true,
+		},
+		{
+			name:  "Unknown customer",
+			input: web.GiftCardIssueRequest{Kind: domain.GiftCardKindStoreCredit, Amount: money.New(250000), CustomerID: &customerId},
+			mock: func(giftCardRepo *mocks.MockGiftCardRepository, customerRepo *mocks.MockCustomerRepository) {
+				customerRepo.EXPECT().FindById(gomock.Any(), customerId).Return(domain.Customer{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Customer not found"),
+		},
+		{
+			name:  "Already expired",
+			input: web.GiftCardIssueRequest{Kind: domain.GiftCardKindGiftCard, Amount: money.New(250000), ExpiresAt: &past},
+			mock:  func(giftCardRepo *mocks.MockGiftCardRepository, customerRepo *mocks.MockCustomerRepository) {},
+			err:   exception.NewBadRequestError("Expiry must be in the future"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			giftCardRepo := mocks.NewMockGiftCardRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			tt.mock(giftCardRepo, customerRepo)
+
+			service := NewGiftCardService(newTxManagerMock(ctrl), giftCardRepo, customerRepo, validator.New())
+			result, err := service.Issue(context.Background(), tt.input)
+			if tt.invalid {
+				assert.Error(t, err)
+				return
+			}
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, money.New(250000), result.Balance)
+			}
+		})
+	}
+}
+
+func TestGiftCardForTender(t *testing.T) {
+	otherCustomerId := uint64(9)
+	yesterday := time.Now().AddDate(0, 0, -1)
+	order := domain.Order{OrderID: 7, CustomerID: 1}
+
+	tests := []struct {
+		name        string
+		card        domain.GiftCard
+		paymentType string
+		err         error
+	}{
+		{
+			name:        "Gift card",
+			card:        domain.GiftCard{GiftCardID: 4, Code: "GIFT", Kind: domain.GiftCardKindGiftCard, Balance: money.New(100)},
+			paymentType: domain.PaymentTypeGiftCard,
+		},
+		{
+			name:        "Wrong tender",
+			card:        domain.GiftCard{GiftCardID: 4, Code: "GIFT", Kind: domain.GiftCardKindGiftCard, Balance: money.New(100)},
+			paymentType: domain.PaymentTypeStoreCredit,
+			err:         exception.NewBadRequestError("Card GIFT is GiftCard, not StoreCredit"),
+		},
+		{
+			name:        "Expired",
+			card:        domain.GiftCard{GiftCardID: 4, Code: "GIFT", Kind: domain.GiftCardKindGiftCard, Balance: money.New(100), ExpiresAt: &yesterday},
+			paymentType: domain.PaymentTypeGiftCard,
+			err:         exception.NewConflictError("Gift card has expired"),
+		},
+		{
+			name:        "Store credit of another customer",
+			card:        domain.GiftCard{GiftCardID: 4, Code: "GIFT", Kind: domain.GiftCardKindStoreCredit, CustomerID: &otherCustomerId},
+			paymentType: domain.PaymentTypeStoreCredit,
+			err:         exception.NewConflictError("Store credit belongs to another customer"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			giftCardRepo := mocks.NewMockGiftCardRepository(ctrl)
+			giftCardRepo.EXPECT().FindByCode(gomock.Any(), "GIFT").Return(tt.card, nil)
+
+			service := NewGiftCardService(newTxManagerMock(ctrl), giftCardRepo, nil, validator.New())
+			_, err := service.CardForTender(context.Background(), order, tt.paymentType, "GIFT")
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestRedeemGiftCard(t *testing.T) {
+	cardId := uint64(4)
+	order := domain.Order{OrderID: 7, CustomerID: 1}
+	payment := domain.Payment{PaymentID: 3, OrderID: 7, Amount: money.New(5000), PaymentType: domain.PaymentTypeGiftCard, GiftCardID: &cardId}
+
+	tests := []struct {
+		name    string
+		moveErr error
+		err     error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:    "Concurrent redemption took the balance",
+			moveErr: repository.ErrInsufficientBalance,
+			err:     exception.NewConflictError("Gift card balance does not cover 5000.00"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			giftCardRepo := mocks.NewMockGiftCardRepository(ctrl)
+			giftCardRepo.EXPECT().MoveBalance(gomock.Any(), domain.GiftCardTransaction{
+				GiftCardID: cardId,
+				Type:       domain.GiftCardTypeRedeem,
+				Amount:     -money.New(5000),
+				OrderID:    &order.OrderID,
+				PaymentID:  &payment.PaymentID,
+				Reference:  "Order #7",
+			}).Return(domain.GiftCardTransaction{}, tt.moveErr)
+
+			service := NewGiftCardService(newTxManagerMock(ctrl), giftCardRepo, nil, validator.New())
+			assert.Equal(t, tt.err, service.Redeem(context.Background(), order, payment))
+		})
+	}
+}
+
+func TestExpireGiftCardBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	giftCardRepo := mocks.NewMockGiftCardRepository(ctrl)
+	giftCardRepo.EXPECT().FindExpired(gomock.Any(), gomock.Any()).Return([]domain.GiftCard{
+		{GiftCardID: 4, Balance: money.New(1200)},
+		{GiftCardID: 5, Balance: money.New(300)},
+	}, nil)
+	giftCardRepo.EXPECT().MoveBalance(gomock.Any(), domain.GiftCardTransaction{
+		GiftCardID: 4, Type: domain.GiftCardTypeExpire, Amount: -money.New(1200), Reference: "Expired",
+	}).Return(domain.GiftCardTransaction{}, nil)
+	giftCardRepo.EXPECT().MoveBalance(gomock.Any(), domain.GiftCardTransaction{
+		GiftCardID: 5, Type: domain.GiftCardTypeExpire, Amount: -money.New(300), Reference: "Expired",
+	}).Return(domain.GiftCardTransaction{}, repository.ErrInsufficientBalance)
+
+	service := NewGiftCardService(newTxManagerMock(ctrl), giftCardRepo, nil, validator.New())
+	expired, err := service.ExpireBalances(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type InventoryService interface {
+	Update(ctx context.Context, request web.InventoryUpdateRequest) (web.InventoryResponse, error)
+	Restock(ctx context.Context, request web.RestockRequest) (web.InventoryResponse, error)
+	FindByProductId(ctx context.Context, productId uint64, storeId uint64) (web.InventoryResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error)
+	FindLowStock(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error)
+	Adjust(ctx context.Context, request web.StockAdjustmentRequest) (web.StockMovementResponse, error)
+	FindMovements(ctx context.Context, productId uint64, storeId uint64) ([]web.StockMovementResponse, error)
+	Audit(ctx context.Context, productId uint64) (web.StockAuditResponse, error)
+}
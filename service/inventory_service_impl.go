@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+type InventoryServiceImpl struct {
+	TxManager               repository.TxManager
+	InventoryRepository     repository.InventoryRepository
+	ProductRepository       repository.ProductRepository
+	StockMovementRepository repository.StockMovementRepository
+	EmployeeRepository      repository.EmployeeRepository
+	StoreService            StoreService
+	Validate                *validator.Validate
+}
+
+func NewInventoryService(txManager repository.TxManager, inventoryRepository repository.InventoryRepository,
+	productRepository repository.ProductRepository, stockMovementRepository repository.StockMovementRepository,
+	employeeRepository repository.EmployeeRepository, storeService StoreService, validate *validator.Validate) InventoryService {
+	return &InventoryServiceImpl{
+		TxManager:               txManager,
+		InventoryRepository:     inventoryRepository,
+		ProductRepository:       productRepository,
+		StockMovementRepository: stockMovementRepository,
+		EmployeeRepository:      employeeRepository,
+		StoreService:            storeService,
+		Validate:                validate,
+	}
+}
+
+// Update the restock level of a product, creating its inventory record on first use. The level applies at
+// every store.
+func (service *InventoryServiceImpl) Update(ctx context.Context, request web.InventoryUpdateRequest) (web.InventoryResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	product, err := service.findProduct(ctx, request.ProductID)
+	if err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	inventory := inventoryOf(product)
+	inventory.RestockLevel = request.RestockLevel
+	savedInventory, err := service.InventoryRepository.Save(ctx, inventory)
+	if err != nil {
+		return web.InventoryResponse{}, err
+	}
+	product.Inventory = &savedInventory
+
+	return helper.ToInventoryResponse(product, 0), nil
+}
+
+// Restock adds the delivered quantity to the stock of a product at a store and records when it happened
+func (service *InventoryServiceImpl) Restock(ctx context.Context, request web.RestockRequest) (web.InventoryResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	if request.EmployeeID != nil {
+		if err := service.findEmployee(ctx, *request.EmployeeID); err != nil {
+			return web.InventoryResponse{}, err
+		}
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		product, err := service.findProduct(ctx, request.ProductID)
+		if err != nil {
+			return err
+		}
+		// A parent holds no stock, its variants are restocked instead
+		if product.HasVariants() {
+			return exception.NewBadRequestError(fmt.Sprintf("Product %d has variants, restock one of them", product.ProductID))
+		}
+
+		_, err = service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+			ProductID:  product.ProductID,
+			StoreID:    request.StoreID,
+			Delta:      request.Quantity,
+			Reason:     domain.StockReasonRestock,
+			Reference:  request.Reference,
+			EmployeeID: request.EmployeeID,
+		})
+		if err != nil {
+			return err
+		}
+
+		inventory := inventoryOf(product)
+		restockedAt := time.Now()
+		inventory.LastRestock = &restockedAt
+		_, err = service.InventoryRepository.Save(ctx, inventory)
+		return err
+	})
+	if err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	return service.FindByProductId(ctx, request.ProductID, request.StoreID)
+}
+
+// FindByProductId - Get the stock and restock details of a product at a store, or over the chain when
+// storeId is 0
+func (service *InventoryServiceImpl) FindByProductId(ctx context.Context, productId uint64, storeId uint64) (web.InventoryResponse, error) {
+	product, err := service.findProduct(ctx, productId)
+	if err != nil {
+		return web.InventoryResponse{}, err
+	}
+
+	return helper.ToInventoryResponse(product, storeId), nil
+}
+
+// FindAll - Get the stock and restock details of every product at a store, or over the chain when storeId is 0
+func (service *InventoryServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error) {
+	products, err := service.InventoryRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToInventoryResponses(products, storeId), nil
+}
+
+// FindLowStock - Get every product at or below its restock level at a store, or over the chain when storeId
+// is 0, the ones furthest below first
+func (service *InventoryServiceImpl) FindLowStock(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error) {
+	products, err := service.InventoryRepository.FindLowStock(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToInventoryResponses(products, storeId), nil
+}
+
+// Adjust corrects the stock of a product at a store by hand, for miscounts and for stock thrown away
+func (service *InventoryServiceImpl) Adjust(ctx context.Context, request web.StockAdjustmentRequest) (web.StockMovementResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StockMovementResponse{}, err
+	}
+	if request.Reason == domain.StockReasonWaste && request.Delta > 0 {
+		return web.StockMovementResponse{}, exception.NewBadRequestError("Waste can only take stock out")
+	}
+
+	if err := service.findEmployee(ctx, request.EmployeeID); err != nil {
+		return web.StockMovementResponse{}, err
+	}
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.StockMovementResponse{}, err
+	}
+	product, err := service.findProduct(ctx, request.ProductID)
+	if err != nil {
+		return web.StockMovementResponse{}, err
+	}
+	if product.HasVariants() {
+		return web.StockMovementResponse{}, exception.NewBadRequestError(fmt.Sprintf("Product %d has variants, adjust one of them", product.ProductID))
+	}
+
+	movement, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+		ProductID:  request.ProductID,
+		StoreID:    request.StoreID,
+		Delta:      request.Delta,
+		Reason:     request.Reason,
+		Reference:  request.Reference,
+		EmployeeID: &request.EmployeeID,
+	})
+	if errors.Is(err, repository.ErrInsufficientStock) {
+		return web.StockMovementResponse{}, exception.NewConflictError("Adjustment would take stock below zero")
+	} else if err != nil {
+		return web.StockMovementResponse{}, err
+	}
+
+	return helper.ToStockMovementResponse(movement), nil
+}
+
+// FindMovements - Get the stock movement history of a product at a store, or at every store when storeId is
+// 0, oldest first
+func (service *InventoryServiceImpl) FindMovements(ctx context.Context, productId uint64, storeId uint64) ([]web.StockMovementResponse, error) {
+	if _, err := service.findProduct(ctx, productId); err != nil {
+		return nil, err
+	}
+
+	movements, err := service.StockMovementRepository.FindByProductId(ctx, productId, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToStockMovementResponses(movements), nil
+}
+
+// Audit rebuilds the stock of a product from its movements and compares it with the stock on record, over
+// the chain and at every store
+func (service *InventoryServiceImpl) Audit(ctx context.Context, productId uint64) (web.StockAuditResponse, error) {
+	product, err := service.findProduct(ctx, productId)
+	if err != nil {
+		return web.StockAuditResponse{}, err
+	}
+
+	ledgerQty, err := service.StockMovementRepository.SumByProductId(ctx, productId)
+	if err != nil {
+		return web.StockAuditResponse{}, err
+	}
+	storeLedgerQty, err := service.StockMovementRepository.SumByStore(ctx, productId)
+	if err != nil {
+		return web.StockAuditResponse{}, err
+	}
+
+	audit := web.StockAuditResponse{
+		ProductID:  product.ProductID,
+		StockQty:   product.StockQty,
+		LedgerQty:  ledgerQty,
+		Difference: product.StockQty - ledgerQty,
+		Consistent: product.StockQty == ledgerQty,
+	}
+
+	storeIds := make([]uint64, 0, len(storeLedgerQty))
+	for storeId := range storeLedgerQty {
+		storeIds = append(storeIds, storeId)
+	}
+	for _, store := range product.Stores {
+		if _, ok := storeLedgerQty[store.StoreID]; !ok {
+			storeIds = append(storeIds, store.StoreID)
+		}
+	}
+	sort.Slice(storeIds, func(i, j int) bool { return storeIds[i] < storeIds[j] })
+
+	for _, storeId := range storeIds {
+		stockQty := product.AtStore(storeId).StockQty
+		audit.Stores = append(audit.Stores, web.StoreStockAuditResponse{
+			StoreID:    storeId,
+			StockQty:   stockQty,
+			LedgerQty:  storeLedgerQty[storeId],
+			Difference: stockQty - storeLedgerQty[storeId],
+		})
+		if stockQty != storeLedgerQty[storeId] {
+			audit.Consistent = false
+		}
+	}
+	return audit, nil
+}
+
+func (service *InventoryServiceImpl) findEmployee(ctx context.Context, employeeId uint64) error {
+	_, err := service.EmployeeRepository.FindById(ctx, employeeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Employee not found")
+	}
+	return err
+}
+
+func (service *InventoryServiceImpl) findProduct(ctx context.Context, productId uint64) (domain.Product, error) {
+	product, err := service.InventoryRepository.FindByProductId(ctx, productId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Product{}, exception.NewNotFoundError("Product not found")
+	}
+	return product, err
+}
+
+// inventoryOf returns the inventory record of product, or a new one when it has none yet
+func inventoryOf(product domain.Product) domain.Inventory {
+	if product.Inventory != nil {
+		return *product.Inventory
+	}
+	return domain.Inventory{ProductID: product.ProductID}
+}
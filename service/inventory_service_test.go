@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestUpdateInventory(t *testing.T) {
+	stocked := productModelTpl
+	stocked.Inventory = &domain.Inventory{InventoryID: 4, ProductID: 1, RestockLevel: 20}
+
+	tests := []struct {
+		name   string
+		input  web.InventoryUpdateRequest
+		mock   func(inventoryRepo *mocks.MockInventoryRepository)
+		expect web.InventoryResponse
+		err    error
+	}{
+		{
+			name:  "Creates the record on first use",
+			input: web.InventoryUpdateRequest{ProductID: 1, RestockLevel: 150},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository) {
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				inventoryRepo.EXPECT().Save(gomock.Any(), domain.Inventory{ProductID: 1, RestockLevel: 150}).
+					Return(domain.Inventory{InventoryID: 1, ProductID: 1, RestockLevel: 150}, nil)
+			},
+			expect: web.InventoryResponse{ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH", StockQty: 100,
+				RestockLevel: 150, LowStock: true, Stores: []web.StoreStockResponse{{StoreID: 1, StockQty: 100}}},
+		},
+		{
+			name:  "Updates the existing record",
+			input: web.InventoryUpdateRequest{ProductID: 1, RestockLevel: 10},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository) {
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(stocked, nil)
+				inventoryRepo.EXPECT().Save(gomock.Any(), domain.Inventory{InventoryID: 4, ProductID: 1, RestockLevel: 10}).
+					Return(domain.Inventory{InventoryID: 4, ProductID: 1, RestockLevel: 10}, nil)
+			},
+			expect: web.InventoryResponse{ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH", StockQty: 100,
+				RestockLevel: 10, Stores: []web.StoreStockResponse{{StoreID: 1, StockQty: 100}}},
+		},
+		{
+			name:  "Product Not Found",
+			input: web.InventoryUpdateRequest{ProductID: 9, RestockLevel: 10},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository) {
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(9)).Return(domain.Product{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Product not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+			tt.mock(inventoryRepo)
+
+			service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, mocks.NewMockProductRepository(ctrl), nil, nil, nil, validator.New())
+			result, err := service.Update(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestRestockInventory(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.RestockRequest
+		mock  func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository)
+		store error
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.RestockRequest{ProductID: 1, StoreID: 2, Quantity: 24, Reference: "DN-881"},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository) {
+				restocked := productModelTpl
+				restocked.StockQty = 124
+				restocked.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 100}, {StoreID: 2, StockQty: 24}}
+				gomock.InOrder(
+					inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(productModelTpl, nil),
+					productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 2, Delta: 24,
+						Reason: domain.StockReasonRestock, Reference: "DN-881"}).Return(domain.StockMovement{}, nil),
+					inventoryRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, inventory domain.Inventory) (domain.Inventory, error) {
+							assert.Equal(t, uint64(1), inventory.ProductID)
+							assert.NotNil(t, inventory.LastRestock)
+							return inventory, nil
+						}),
+					inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(restocked, nil),
+				)
+			},
+		},
+		{
+			name:  "Inactive store",
+			input: web.RestockRequest{ProductID: 1, StoreID: 3, Quantity: 24},
+			mock:  func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository) {},
+			store: exception.NewConflictError("Store BDG01 is inactive"),
+			err:   exception.NewConflictError("Store BDG01 is inactive"),
+		},
+		{
+			name:  "Product Not Found",
+			input: web.RestockRequest{ProductID: 9, StoreID: 1, Quantity: 24},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository) {
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(9)).Return(domain.Product{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Product not found"),
+		},
+		{
+			name:  "Parent Product",
+			input: web.RestockRequest{ProductID: 10, StoreID: 1, Quantity: 24},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository) {
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			},
+			err: exception.NewBadRequestError("Product 10 has variants, restock one of them"),
+		},
+		{
+			name:  "Quantity must be positive",
+			input: web.RestockRequest{ProductID: 1, StoreID: 1, Quantity: -3},
+			mock:  func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository) {},
+			err:   validator.ValidationErrors{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			storeService := servicemocks.NewMockStoreService(ctrl)
+			storeService.EXPECT().FindActive(gomock.Any(), tt.input.StoreID).Return(domain.Store{StoreID: tt.input.StoreID}, tt.store).AnyTimes()
+			tt.mock(inventoryRepo, productRepo)
+
+			service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, productRepo, nil, nil, storeService, validator.New())
+			result, err := service.Restock(context.Background(), tt.input)
+			if _, ok := tt.err.(validator.ValidationErrors); ok {
+				assert.IsType(t, tt.err, err)
+				return
+			}
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(2), result.StoreID)
+				assert.Equal(t, 24, result.StockQty)
+			}
+		})
+	}
+}
+
+func TestFindLowStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	low := productModelTpl
+	low.StockQty = 3
+	low.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 3}}
+	low.Inventory = &domain.Inventory{ProductID: 1, RestockLevel: 10}
+	inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	inventoryRepo.EXPECT().FindLowStock(gomock.Any(), uint64(0)).Return([]domain.Product{low}, nil)
+
+	service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, mocks.NewMockProductRepository(ctrl), nil, nil, nil, validator.New())
+	result, err := service.FindLowStock(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []web.InventoryResponse{{ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH", StockQty: 3,
+		RestockLevel: 10, LowStock: true, Stores: []web.StoreStockResponse{{StoreID: 1, StockQty: 3}}}}, result)
+}
+
+func TestFindLowStockAtStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Plenty over the chain, but store 2 has run out
+	low := productModelTpl
+	low.StockQty = 40
+	low.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 40}}
+	low.Inventory = &domain.Inventory{ProductID: 1, RestockLevel: 10}
+	inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	inventoryRepo.EXPECT().FindLowStock(gomock.Any(), uint64(2)).Return([]domain.Product{low}, nil)
+
+	service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, mocks.NewMockProductRepository(ctrl), nil, nil, nil, validator.New())
+	result, err := service.FindLowStock(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []web.InventoryResponse{{ProductID: 1, StoreID: 2, ProductName: "Barang mewwah", SKU: "MWH", StockQty: 0,
+		RestockLevel: 10, LowStock: true}}, result)
+}
+
+func TestAdjustStock(t *testing.T) {
+	employeeId := uint64(2)
+
+	tests := []struct {
+		name  string
+		input web.StockAdjustmentRequest
+		mock  func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.StockAdjustmentRequest{ProductID: 1, StoreID: 1, Delta: -2, Reason: domain.StockReasonWaste, Reference: "Broken", EmployeeID: 2},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				movement := domain.StockMovement{ProductID: 1, StoreID: 1, Delta: -2, Reason: domain.StockReasonWaste, Reference: "Broken", EmployeeID: &employeeId}
+				productRepo.EXPECT().MoveStock(gomock.Any(), movement).Return(movement, nil)
+			},
+		},
+		{
+			name:  "Parent Product",
+			input: web.StockAdjustmentRequest{ProductID: 10, StoreID: 1, Delta: -2, Reason: domain.StockReasonWaste, EmployeeID: 2},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			},
+			err: exception.NewBadRequestError("Product 10 has variants, adjust one of them"),
+		},
+		{
+			name:  "Waste cannot add stock",
+			input: web.StockAdjustmentRequest{ProductID: 1, StoreID: 1, Delta: 2, Reason: domain.StockReasonWaste, EmployeeID: 2},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository) {
+			},
+			err: exception.NewBadRequestError("Waste can only take stock out"),
+		},
+		{
+			name:  "Below zero",
+			input: web.StockAdjustmentRequest{ProductID: 1, StoreID: 1, Delta: -500, Reason: domain.StockReasonAdjustment, EmployeeID: 2},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+				inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), gomock.Any()).Return(domain.StockMovement{}, repository.ErrInsufficientStock)
+			},
+			err: exception.NewConflictError("Adjustment would take stock below zero"),
+		},
+		{
+			name:  "Employee Not Found",
+			input: web.StockAdjustmentRequest{ProductID: 1, StoreID: 1, Delta: 5, Reason: domain.StockReasonAdjustment, EmployeeID: 8},
+			mock: func(inventoryRepo *mocks.MockInventoryRepository, productRepo *mocks.MockProductRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindById(gomock.Any(), uint64(8)).Return(domain.Employee{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Employee not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+			storeService := servicemocks.NewMockStoreService(ctrl)
+			storeService.EXPECT().FindActive(gomock.Any(), uint64(1)).Return(domain.Store{StoreID: 1, Active: true}, nil).AnyTimes()
+			tt.mock(inventoryRepo, productRepo, employeeRepo)
+
+			service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, productRepo, nil, employeeRepo, storeService, validator.New())
+			result, err := service.Adjust(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, -2, result.Delta)
+				assert.Equal(t, &employeeId, result.EmployeeID)
+			}
+		})
+	}
+}
+
+func TestAuditStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	stockMovementRepo := mocks.NewMockStockMovementRepository(ctrl)
+	inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(productModelTpl, nil).Times(2)
+	stockMovementRepo.EXPECT().SumByProductId(gomock.Any(), uint64(1)).Return(100, nil)
+	stockMovementRepo.EXPECT().SumByStore(gomock.Any(), uint64(1)).Return(map[uint64]int{1: 100}, nil)
+	stockMovementRepo.EXPECT().SumByProductId(gomock.Any(), uint64(1)).Return(97, nil)
+	stockMovementRepo.EXPECT().SumByStore(gomock.Any(), uint64(1)).Return(map[uint64]int{1: 97}, nil)
+
+	service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, nil, stockMovementRepo, nil, nil, validator.New())
+	result, err := service.Audit(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, web.StockAuditResponse{ProductID: 1, StockQty: 100, LedgerQty: 100, Consistent: true,
+		Stores: []web.StoreStockAuditResponse{{StoreID: 1, StockQty: 100, LedgerQty: 100}}}, result)
+
+	result, err = service.Audit(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, web.StockAuditResponse{ProductID: 1, StockQty: 100, LedgerQty: 97, Difference: 3,
+		Stores: []web.StoreStockAuditResponse{{StoreID: 1, StockQty: 100, LedgerQty: 97, Difference: 3}}}, result)
+}
+
+func TestAuditStockAcrossStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The chain adds up, but 5 moved into store 2 were taken off store 1's record only
+	product := productModelTpl
+	product.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 100}}
+	inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	stockMovementRepo := mocks.NewMockStockMovementRepository(ctrl)
+	inventoryRepo.EXPECT().FindByProductId(gomock.Any(), uint64(1)).Return(product, nil)
+	stockMovementRepo.EXPECT().SumByProductId(gomock.Any(), uint64(1)).Return(100, nil)
+	stockMovementRepo.EXPECT().SumByStore(gomock.Any(), uint64(1)).Return(map[uint64]int{1: 95, 2: 5}, nil)
+
+	service := NewInventoryService(newTxManagerMock(ctrl), inventoryRepo, nil, stockMovementRepo, nil, nil, validator.New())
+	result, err := service.Audit(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, web.StockAuditResponse{ProductID: 1, StockQty: 100, LedgerQty: 100, Stores: []web.StoreStockAuditResponse{
+		{StoreID: 1, StockQty: 100, LedgerQty: 95, Difference: 5},
+		{StoreID: 2, StockQty: 0, LedgerQty: 5, Difference: -5},
+	}}, result)
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	"context"
+)
+
+type InvoiceService interface {
+	ReceiptInvoice(ctx context.Context, receiptId uint64) ([]byte, error)
+	OrderInvoice(ctx context.Context, orderId uint64) ([]byte, error)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"gorm.io/gorm"
+)
+
+type InvoiceServiceImpl struct {
+	ReceiptRepository  repository.ReceiptRepository
+	OrderRepository    repository.OrderRepository
+	CustomerRepository repository.CustomerRepository
+	TaxService         TaxService
+	StoreHeader        render.StoreHeader
+}
+
+func NewInvoiceService(receiptRepository repository.ReceiptRepository, orderRepository repository.OrderRepository,
+	customerRepository repository.CustomerRepository, taxService TaxService, storeHeader render.StoreHeader) InvoiceService {
+	return &InvoiceServiceImpl{
+		ReceiptRepository:  receiptRepository,
+		OrderRepository:    orderRepository,
+		CustomerRepository: customerRepository,
+		TaxService:         taxService,
+		StoreHeader:        storeHeader,
+	}
+}
+
+// ReceiptInvoice renders the PDF invoice of an issued receipt
+func (service *InvoiceServiceImpl) ReceiptInvoice(ctx context.Context, receiptId uint64) ([]byte, error) {
+	receipt, err := service.ReceiptRepository.FindById(ctx, receiptId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, exception.NewNotFoundError("Receipt not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	order, err := service.findOrder(ctx, receipt.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := service.findCustomer(ctx, order.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := render.Invoice{
+		Title:      "INVOICE",
+		Number:     fmt.Sprintf("INV-%06d", receipt.ReceiptID),
+		Date:       receipt.ReceiptDate,
+		OrderID:    receipt.OrderID,
+		Customer:   toInvoiceParty(customer),
+		Subtotal:   receipt.TotalAmount,
+		Discount:   receipt.Discount,
+		Tax:        receipt.Taxes,
+		Total:      receipt.FinalAmount,
+		AmountPaid: receipt.FinalAmount,
+
+		PricesIncludeTax: receipt.PricesIncludeTax,
+	}
+	for _, tax := range receipt.TaxLines {
+		invoice.Taxes = append(invoice.Taxes, render.InvoiceTax{
+			Name:          tax.TaxName,
+			Rate:          tax.TaxRate,
+			TaxableAmount: tax.TaxableAmount,
+			Amount:        tax.TaxAmount,
+		})
+	}
+	for _, item := range receipt.Items {
+		invoice.Lines = append(invoice.Lines, render.InvoiceLine{
+			Description: item.ProductName,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			TaxRate:     item.TaxRate,
+			TaxAmount:   item.TaxAmount,
+			Total:       item.TotalPrice,
+			Discount:    item.DiscountAmount + item.PromotionAmount + item.TierDiscount,
+		})
+	}
+	for _, tender := range receipt.Tenders {
+		invoice.Tenders = append(invoice.Tenders, render.InvoiceTender{PaymentType: tender.PaymentType, Amount: tender.Amount,
+			Currency: tender.Currency, ExchangeRate: tender.ExchangeRate, ForeignAmount: tender.ForeignAmount})
+	}
+
+	return render.InvoicePDF(invoice, service.StoreHeader)
+}
+
+// OrderInvoice renders the PDF invoice of an order, showing what is still due when it is not fully paid
+func (service *InvoiceServiceImpl) OrderInvoice(ctx context.Context, orderId uint64) ([]byte, error) {
+	order, err := service.findOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == domain.OrderStatusCancelled {
+		return nil, exception.NewConflictError("Cancelled orders have no invoice")
+	}
+	customer, err := service.findCustomer(ctx, order.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := render.Invoice{
+		Title:      "INVOICE",
+		Number:     fmt.Sprintf("ORD-%06d", order.OrderID),
+		Date:       order.OrderDate,
+		OrderID:    order.OrderID,
+		Customer:   toInvoiceParty(customer),
+		Subtotal:   order.Subtotal,
+		Discount:   order.Discount,
+		Tax:        order.TaxAmount,
+		Total:      order.TotalAmount,
+		AmountPaid: order.AmountPaid(),
+		BalanceDue: order.TotalAmount - order.AmountPaid(),
+
+		PricesIncludeTax: order.PricesIncludeTax,
+	}
+	calculation := service.TaxService.Calculate(orderTaxLines(order.OrderItems), order.PricesIncludeTax)
+	for _, rate := range calculation.Rates {
+		invoice.Taxes = append(invoice.Taxes, render.InvoiceTax{
+			Name:          rate.TaxName,
+			Rate:          rate.TaxRate,
+			TaxableAmount: rate.Net,
+			Amount:        rate.Tax,
+		})
+	}
+	for _, item := range order.OrderItems {
+		invoice.Lines = append(invoice.Lines, render.InvoiceLine{
+			Description: item.Product.Name,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			TaxRate:     item.TaxRate,
+			TaxAmount:   item.TaxAmount,
+			Total:       item.TotalPrice,
+			Discount:    item.Reductions(),
+		})
+	}
+	for _, payment := range order.Payments {
+		if payment.Status == domain.PaymentStatusCompleted {
+			invoice.Tenders = append(invoice.Tenders, render.InvoiceTender{PaymentType: payment.PaymentType, Amount: payment.Amount,
+				Currency: payment.Currency, ExchangeRate: payment.ExchangeRate, ForeignAmount: payment.ForeignAmount})
+		}
+	}
+
+	return render.InvoicePDF(invoice, service.StoreHeader)
+}
+
+func (service *InvoiceServiceImpl) findOrder(ctx context.Context, orderId uint64) (domain.Order, error) {
+	order, err := service.OrderRepository.FindById(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Order{}, exception.NewNotFoundError("Order not found")
+	}
+	return order, err
+}
+
+func (service *InvoiceServiceImpl) findCustomer(ctx context.Context, customerId uint64) (domain.Customer, error) {
+	customer, err := service.CustomerRepository.FindById(ctx, customerId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Customer{}, exception.NewNotFoundError("Customer not found")
+	}
+	return customer, err
+}
+
+func toInvoiceParty(customer domain.Customer) render.InvoiceParty {
+	return render.InvoiceParty{
+		Name:    customer.Name,
+		Email:   customer.Email,
+		Phone:   customer.Phone,
+		Address: customer.Address,
+	}
+}
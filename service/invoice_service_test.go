@@ -0,0 +1,92 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestReceiptInvoice(t *testing.T) {
+	receipt := domain.Receipt{
+		ReceiptID:   12,
+		OrderID:     1,
+		TotalAmount: money.New(20000),
+		Taxes:       money.New(2000),
+		FinalAmount: money.New(22000),
+		Items: []domain.ReceiptItem{
+			{ProductID: 1, ProductName: "Barang mewwah", Quantity: 2, UnitPrice: money.New(10000), TaxRate: 10, TaxAmount: money.New(2000), TotalPrice: money.New(20000)},
+		},
+		Tenders: []domain.ReceiptTender{{PaymentID: 1, PaymentType: domain.PaymentTypeCard, Amount: money.New(22000)}},
+	}
+
+	tests := []struct {
+		name string
+		mock func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository, customerRepo *mocks.MockCustomerRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository, customerRepo *mocks.MockCustomerRepository) {
+				receiptRepo.EXPECT().FindById(gomock.Any(), uint64(12)).Return(receipt, nil)
+				orderRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(paidOrderModelTpl, nil)
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Receipt Not Found",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository, customerRepo *mocks.MockCustomerRepository) {
+				receiptRepo.EXPECT().FindById(gomock.Any(), uint64(12)).Return(domain.Receipt{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Receipt not found"),
+		},
+		{
+			name: "Customer Not Found",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository, customerRepo *mocks.MockCustomerRepository) {
+				receiptRepo.EXPECT().FindById(gomock.Any(), uint64(12)).Return(receipt, nil)
+				orderRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(paidOrderModelTpl, nil)
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Customer{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Customer not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			receiptRepo := mocks.NewMockReceiptRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			tt.mock(receiptRepo, orderRepo, customerRepo)
+
+			service := NewInvoiceService(receiptRepo, orderRepo, customerRepo, NewTaxService(nil, nil), render.StoreHeader{Name: "Toko"})
+			output, err := service.ReceiptInvoice(context.Background(), 12)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.True(t, bytes.HasPrefix(output, []byte("%PDF-")))
+			}
+		})
+	}
+}
+
+func TestOrderInvoiceCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cancelledOrder := orderModelTpl
+	cancelledOrder.Status = domain.OrderStatusCancelled
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	orderRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(cancelledOrder, nil)
+
+	service := NewInvoiceService(mocks.NewMockReceiptRepository(ctrl), orderRepo, mocks.NewMockCustomerRepository(ctrl), NewTaxService(nil, nil), render.StoreHeader{})
+	_, err := service.OrderInvoice(context.Background(), 1)
+	assert.Equal(t, exception.NewConflictError("Cancelled orders have no invoice"), err)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+)
+
+type LoyaltyService interface {
+	CreateRule(ctx context.Context, request web.LoyaltyRuleCreateRequest) (web.LoyaltyRuleResponse, error)
+	UpdateRule(ctx context.Context, request web.LoyaltyRuleUpdateRequest) (web.LoyaltyRuleResponse, error)
+	DeleteRule(ctx context.Context, ruleId uint64) error
+	FindRuleById(ctx context.Context, ruleId uint64) (web.LoyaltyRuleResponse, error)
+	FindRules(ctx context.Context) ([]web.LoyaltyRuleResponse, error)
+	CreateTier(ctx context.Context, request web.LoyaltyTierCreateRequest) (web.LoyaltyTierResponse, error)
+	UpdateTier(ctx context.Context, request web.LoyaltyTierUpdateRequest) (web.LoyaltyTierResponse, error)
+	DeleteTier(ctx context.Context, tierId uint64) error
+	FindTierById(ctx context.Context, tierId uint64) (web.LoyaltyTierResponse, error)
+	FindTiers(ctx context.Context) ([]web.LoyaltyTierResponse, error)
+	ReviewTiers(ctx context.Context) (web.TierReviewResponse, error)
+	TierStatus(ctx context.Context, customers []domain.Customer) (map[uint64]web.CustomerTierResponse, error)
+	FindSettings(ctx context.Context) (web.LoyaltySettingsResponse, error)
+	UpdateSettings(ctx context.Context, request web.LoyaltySettingsUpdateRequest) (web.LoyaltySettingsResponse, error)
+	FindLedger(ctx context.Context, customerId uint64) (web.LoyaltyLedgerResponse, error)
+	Adjust(ctx context.Context, request web.PointsAdjustmentRequest) (web.LoyaltyTransactionResponse, error)
+	EarnForOrder(ctx context.Context, order domain.Order) error
+	Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error
+	RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error
+	ClawBack(ctx context.Context, order domain.Order, amount money.Money) error
+}
@@ -0,0 +1,769 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"time"
+)
+
+type LoyaltyServiceImpl struct {
+	LoyaltyRepository  repository.LoyaltyRepository
+	CustomerRepository repository.CustomerRepository
+	CategoryRepository repository.CategoryRepository
+	Validate           *validator.Validate
+}
+
+func NewLoyaltyService(loyaltyRepository repository.LoyaltyRepository, customerRepository repository.CustomerRepository,
+	categoryRepository repository.CategoryRepository, validate *validator.Validate) LoyaltyService {
+	return &LoyaltyServiceImpl{
+		LoyaltyRepository:  loyaltyRepository,
+		CustomerRepository: customerRepository,
+		CategoryRepository: categoryRepository,
+		Validate:           validate,
+	}
+}
+
+// CreateRule - Create loyalty earn rule, one per category and one for the rest
+func (service *LoyaltyServiceImpl) CreateRule(ctx context.Context, request web.LoyaltyRuleCreateRequest) (web.LoyaltyRuleResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	rule := domain.LoyaltyRule{
+		Name:        request.Name,
+		CategoryID:  request.CategoryID,
+		SpendAmount: request.SpendAmount,
+		Points:      request.Points,
+		Active:      request.Active,
+	}
+	if err := service.checkRuleCategory(ctx, &rule); err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	savedRule, err := service.LoyaltyRepository.SaveRule(ctx, rule)
+	if err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	return helper.ToLoyaltyRuleResponse(savedRule), nil
+}
+
+// UpdateRule - Update loyalty earn rule. Points already earned stay as they are.
+func (service *LoyaltyServiceImpl) UpdateRule(ctx context.Context, request web.LoyaltyRuleUpdateRequest) (web.LoyaltyRuleResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	rule, err := service.findRule(ctx, request.Id)
+	if err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	rule.Name = request.Name
+	rule.CategoryID = request.CategoryID
+	rule.Category = nil
+	rule.SpendAmount = request.SpendAmount
+	rule.Points = request.Points
+	rule.Active = request.Active
+	if err := service.checkRuleCategory(ctx, &rule); err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	updatedRule, err := service.LoyaltyRepository.UpdateRule(ctx, rule)
+	if err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	return helper.ToLoyaltyRuleResponse(updatedRule), nil
+}
+
+// checkRuleCategory makes sure the category exists and has no other rule yet
+func (service *LoyaltyServiceImpl) checkRuleCategory(ctx context.Context, rule *domain.LoyaltyRule) error {
+	if rule.CategoryID != nil {
+		category, err := service.CategoryRepository.FindById(ctx, *rule.CategoryID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Category not found")
+		} else if err != nil {
+			return err
+		}
+		rule.Category = &category
+	}
+
+	rules, err := service.LoyaltyRepository.FindRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range rules {
+		if other.LoyaltyRuleID == rule.LoyaltyRuleID {
+			continue
+		}
+		if other.CategoryID == nil && rule.CategoryID == nil {
+			return exception.NewConflictError("There already is a rule for products without a category rule")
+		}
+		if other.CategoryID != nil && rule.CategoryID != nil && *other.CategoryID == *rule.CategoryID {
+			return exception.NewConflictError(fmt.Sprintf("There already is a rule for category %d", *rule.CategoryID))
+		}
+	}
+	return nil
+}
+
+// DeleteRule - Delete loyalty earn rule
+func (service *LoyaltyServiceImpl) DeleteRule(ctx context.Context, ruleId uint64) error {
+	rule, err := service.findRule(ctx, ruleId)
+	if err != nil {
+		return err
+	}
+
+	return service.LoyaltyRepository.DeleteRule(ctx, rule)
+}
+
+// FindRuleById - Find loyalty earn rule By ID
+func (service *LoyaltyServiceImpl) FindRuleById(ctx context.Context, ruleId uint64) (web.LoyaltyRuleResponse, error) {
+	rule, err := service.findRule(ctx, ruleId)
+	if err != nil {
+		return web.LoyaltyRuleResponse{}, err
+	}
+
+	return helper.ToLoyaltyRuleResponse(rule), nil
+}
+
+// FindRules - Find all loyalty earn rules
+func (service *LoyaltyServiceImpl) FindRules(ctx context.Context) ([]web.LoyaltyRuleResponse, error) {
+	rules, err := service.LoyaltyRepository.FindRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToLoyaltyRuleResponses(rules), nil
+}
+
+// CreateTier - Create loyalty tier. Customers only move into it on the next tier review.
+func (service *LoyaltyServiceImpl) CreateTier(ctx context.Context, request web.LoyaltyTierCreateRequest) (web.LoyaltyTierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	tier := domain.LoyaltyTier{
+		Name:             request.Name,
+		SpendThreshold:   request.SpendThreshold,
+		PointsThreshold:  request.PointsThreshold,
+		DiscountPct:      request.DiscountPct,
+		PointsMultiplier: request.PointsMultiplier,
+	}
+	if err := service.checkTierName(ctx, tier); err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	savedTier, err := service.LoyaltyRepository.SaveTier(ctx, tier)
+	if err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	return helper.ToLoyaltyTierResponse(savedTier), nil
+}
+
+// UpdateTier - Update loyalty tier. Orders already priced keep the benefits they got.
+func (service *LoyaltyServiceImpl) UpdateTier(ctx context.Context, request web.LoyaltyTierUpdateRequest) (web.LoyaltyTierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	tier, err := service.findTier(ctx, request.Id)
+	if err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	tier.Name = request.Name
+	tier.SpendThreshold = request.SpendThreshold
+	tier.PointsThreshold = request.PointsThreshold
+	tier.DiscountPct = request.DiscountPct
+	tier.PointsMultiplier = request.PointsMultiplier
+	if err := service.checkTierName(ctx, tier); err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	updatedTier, err := service.LoyaltyRepository.UpdateTier(ctx, tier)
+	if err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	return helper.ToLoyaltyTierResponse(updatedTier), nil
+}
+
+// checkTierName makes sure no other tier has the same name, orders refer to tiers by name
+func (service *LoyaltyServiceImpl) checkTierName(ctx context.Context, tier domain.LoyaltyTier) error {
+	tiers, err := service.LoyaltyRepository.FindTiers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range tiers {
+		if other.LoyaltyTierID != tier.LoyaltyTierID && other.Name == tier.Name {
+			return exception.NewConflictError(fmt.Sprintf("There already is a tier named %s", tier.Name))
+		}
+	}
+	return nil
+}
+
+// DeleteTier - Delete loyalty tier
+func (service *LoyaltyServiceImpl) DeleteTier(ctx context.Context, tierId uint64) error {
+	tier, err := service.findTier(ctx, tierId)
+	if err != nil {
+		return err
+	}
+
+	return service.LoyaltyRepository.DeleteTier(ctx, tier)
+}
+
+// FindTierById - Find loyalty tier By ID
+func (service *LoyaltyServiceImpl) FindTierById(ctx context.Context, tierId uint64) (web.LoyaltyTierResponse, error) {
+	tier, err := service.findTier(ctx, tierId)
+	if err != nil {
+		return web.LoyaltyTierResponse{}, err
+	}
+
+	return helper.ToLoyaltyTierResponse(tier), nil
+}
+
+// FindTiers - Find all loyalty tiers, lowest first
+func (service *LoyaltyServiceImpl) FindTiers(ctx context.Context) ([]web.LoyaltyTierResponse, error) {
+	tiers, err := service.LoyaltyRepository.FindTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToLoyaltyTierResponses(tiers), nil
+}
+
+// ReviewTiers moves every customer into the highest tier their rolling 12-month spend or points reach,
+// up or down. Customers below the lowest threshold hold no tier.
+func (service *LoyaltyServiceImpl) ReviewTiers(ctx context.Context) (web.TierReviewResponse, error) {
+	customers, err := service.CustomerRepository.FindAll(ctx)
+	if err != nil {
+		return web.TierReviewResponse{}, err
+	}
+	tiers, err := service.LoyaltyRepository.FindTiers(ctx)
+	if err != nil {
+		return web.TierReviewResponse{}, err
+	}
+
+	return service.reviewTiers(ctx, customers, tiers)
+}
+
+func (service *LoyaltyServiceImpl) reviewTiers(ctx context.Context, customers []domain.Customer, tiers []domain.LoyaltyTier) (web.TierReviewResponse, error) {
+	review := web.TierReviewResponse{Reviewed: len(customers)}
+	if len(customers) == 0 {
+		return review, nil
+	}
+
+	setting, err := service.LoyaltyRepository.FindSetting(ctx)
+	if err != nil {
+		return web.TierReviewResponse{}, err
+	}
+	values, err := service.tierValues(ctx, setting.TierBasis, customers)
+	if err != nil {
+		return web.TierReviewResponse{}, err
+	}
+	tiers = tiersOn(tiers, setting.TierBasis)
+
+	for _, customer := range customers {
+		current := tierById(tiers, customer.LoyaltyTierID)
+		reached := tierFor(tiers, setting.TierBasis, values[customer.CustomerID])
+		if tierId(current) == tierId(reached) {
+			continue
+		}
+
+		if reached != nil {
+			customer.LoyaltyTierID = &reached.LoyaltyTierID
+		} else {
+			customer.LoyaltyTierID = nil
+		}
+		if _, err := service.CustomerRepository.UpdateTier(ctx, customer); err != nil {
+			return web.TierReviewResponse{}, err
+		}
+		if current == nil || (reached != nil && reached.ThresholdOn(setting.TierBasis) > current.ThresholdOn(setting.TierBasis)) {
+			review.Promoted++
+		} else {
+			review.Demoted++
+		}
+	}
+	return review, nil
+}
+
+// TierStatus reports the tier of every customer with their rolling 12-month value and the way to the next tier
+func (service *LoyaltyServiceImpl) TierStatus(ctx context.Context, customers []domain.Customer) (map[uint64]web.CustomerTierResponse, error) {
+	statuses := make(map[uint64]web.CustomerTierResponse, len(customers))
+	if len(customers) == 0 {
+		return statuses, nil
+	}
+
+	setting, err := service.LoyaltyRepository.FindSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := service.LoyaltyRepository.FindTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values, err := service.tierValues(ctx, setting.TierBasis, customers)
+	if err != nil {
+		return nil, err
+	}
+	tiers = tiersOn(tiers, setting.TierBasis)
+
+	for _, customer := range customers {
+		value := values[customer.CustomerID]
+		status := web.CustomerTierResponse{Basis: setting.TierBasis, Value: tierDisplay(setting.TierBasis, value)}
+
+		var floor int64
+		if current := tierById(tiers, customer.LoyaltyTierID); current != nil {
+			status.TierID = &current.LoyaltyTierID
+			status.TierName = current.Name
+			floor = current.ThresholdOn(setting.TierBasis)
+		}
+
+		var next *domain.LoyaltyTier
+		for i := range tiers {
+			threshold := tiers[i].ThresholdOn(setting.TierBasis)
+			if threshold > floor || (status.TierID == nil && threshold >= floor) {
+				next = &tiers[i]
+				break
+			}
+		}
+		if next == nil {
+			status.Progress = 100
+		} else {
+			threshold := next.ThresholdOn(setting.TierBasis)
+			status.NextTierID = &next.LoyaltyTierID
+			status.NextTierName = next.Name
+			status.NextThreshold = tierDisplay(setting.TierBasis, threshold)
+			status.Remaining = tierDisplay(setting.TierBasis, max(0, threshold-value))
+			if threshold > floor {
+				status.Progress = roundHundredths(math.Min(100, math.Max(0, float64(value-floor)/float64(threshold-floor)*100)))
+			} else {
+				status.Progress = 100
+			}
+		}
+		statuses[customer.CustomerID] = status
+	}
+	return statuses, nil
+}
+
+// tierValues adds up what tiers are measured on for every customer over the last 12 months, in minor units
+// of spend or in points
+func (service *LoyaltyServiceImpl) tierValues(ctx context.Context, basis string, customers []domain.Customer) (map[uint64]int64, error) {
+	customerIds := make([]uint64, 0, len(customers))
+	for _, customer := range customers {
+		customerIds = append(customerIds, customer.CustomerID)
+	}
+	since := time.Now().AddDate(-1, 0, 0)
+
+	if basis == domain.TierBasisPoints {
+		earned, err := service.LoyaltyRepository.SumEarnedSince(ctx, since, customerIds)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[uint64]int64, len(earned))
+		for customerId, points := range earned {
+			values[customerId] = int64(points)
+		}
+		return values, nil
+	}
+
+	spend, err := service.LoyaltyRepository.SumSpendSince(ctx, since, customerIds)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[uint64]int64, len(spend))
+	for customerId, amount := range spend {
+		values[customerId] = int64(amount)
+	}
+	return values, nil
+}
+
+// tiersOn sorts the tiers lowest threshold on basis first
+func tiersOn(tiers []domain.LoyaltyTier, basis string) []domain.LoyaltyTier {
+	sorted := append([]domain.LoyaltyTier(nil), tiers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ThresholdOn(basis) < sorted[j].ThresholdOn(basis)
+	})
+	return sorted
+}
+
+// tierFor returns the highest tier value reaches on basis, tiers are sorted lowest threshold first
+func tierFor(tiers []domain.LoyaltyTier, basis string, value int64) *domain.LoyaltyTier {
+	var reached *domain.LoyaltyTier
+	for i := range tiers {
+		if value >= tiers[i].ThresholdOn(basis) {
+			reached = &tiers[i]
+		}
+	}
+	return reached
+}
+
+func tierById(tiers []domain.LoyaltyTier, id *uint64) *domain.LoyaltyTier {
+	if id == nil {
+		return nil
+	}
+	for i := range tiers {
+		if tiers[i].LoyaltyTierID == *id {
+			return &tiers[i]
+		}
+	}
+	return nil
+}
+
+func tierId(tier *domain.LoyaltyTier) uint64 {
+	if tier == nil {
+		return 0
+	}
+	return tier.LoyaltyTierID
+}
+
+// FindSettings - Get what a point is worth when redeemed and what tiers are measured on
+func (service *LoyaltyServiceImpl) FindSettings(ctx context.Context) (web.LoyaltySettingsResponse, error) {
+	setting, err := service.LoyaltyRepository.FindSetting(ctx)
+	if err != nil {
+		return web.LoyaltySettingsResponse{}, err
+	}
+
+	return web.LoyaltySettingsResponse{PointValue: setting.PointValue, TierBasis: setting.TierBasis}, nil
+}
+
+// UpdateSettings - Set what a point is worth when redeemed and what tiers are measured on
+func (service *LoyaltyServiceImpl) UpdateSettings(ctx context.Context, request web.LoyaltySettingsUpdateRequest) (web.LoyaltySettingsResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltySettingsResponse{}, err
+	}
+
+	setting, err := service.LoyaltyRepository.FindSetting(ctx)
+	if err != nil {
+		return web.LoyaltySettingsResponse{}, err
+	}
+
+	setting.PointValue = *request.PointValue
+	if request.TierBasis != "" {
+		setting.TierBasis = request.TierBasis
+	}
+	savedSetting, err := service.LoyaltyRepository.SaveSetting(ctx, setting)
+	if err != nil {
+		return web.LoyaltySettingsResponse{}, err
+	}
+
+	return web.LoyaltySettingsResponse{PointValue: savedSetting.PointValue, TierBasis: savedSetting.TierBasis}, nil
+}
+
+// FindLedger - Get the loyalty ledger of a customer and check it adds up to their points
+func (service *LoyaltyServiceImpl) FindLedger(ctx context.Context, customerId uint64) (web.LoyaltyLedgerResponse, error) {
+	customer, err := service.findCustomer(ctx, customerId)
+	if err != nil {
+		return web.LoyaltyLedgerResponse{}, err
+	}
+
+	transactions, err := service.LoyaltyRepository.FindByCustomerId(ctx, customerId)
+	if err != nil {
+		return web.LoyaltyLedgerResponse{}, err
+	}
+	ledgerPoints, err := service.LoyaltyRepository.SumByCustomerId(ctx, customerId)
+	if err != nil {
+		return web.LoyaltyLedgerResponse{}, err
+	}
+
+	return web.LoyaltyLedgerResponse{
+		CustomerID:   customer.CustomerID,
+		Points:       customer.LoyaltyPts,
+		LedgerPoints: ledgerPoints,
+		Consistent:   customer.LoyaltyPts == ledgerPoints,
+		Transactions: helper.ToLoyaltyTransactionResponses(transactions),
+	}, nil
+}
+
+// Adjust corrects the points of a customer by hand
+func (service *LoyaltyServiceImpl) Adjust(ctx context.Context, request web.PointsAdjustmentRequest) (web.LoyaltyTransactionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.LoyaltyTransactionResponse{}, err
+	}
+	if _, err := service.findCustomer(ctx, request.CustomerID); err != nil {
+		return web.LoyaltyTransactionResponse{}, err
+	}
+
+	transaction, err := service.CustomerRepository.MovePoints(ctx, domain.LoyaltyTransaction{
+		CustomerID: request.CustomerID,
+		Type:       domain.LoyaltyTypeAdjustment,
+		Points:     request.Points,
+		Reference:  request.Reference,
+	})
+	if errors.Is(err, repository.ErrInsufficientPoints) {
+		return web.LoyaltyTransactionResponse{}, exception.NewConflictError("Adjustment would take points below zero")
+	} else if err != nil {
+		return web.LoyaltyTransactionResponse{}, err
+	}
+
+	return helper.ToLoyaltyTransactionResponse(transaction), nil
+}
+
+// EarnForOrder books the points a paid order earns. Every line earns by the rule of its category, or the
+// rule without a category, on what the customer paid for it, times the points multiplier of the tier the
+// order was priced at. The part of the order paid with points earns nothing. Points already earned for the
+// order are taken into account, so settling an order again never earns twice. The tier of the customer is
+// reviewed afterwards, so a promotion applies from the next order on.
+func (service *LoyaltyServiceImpl) EarnForOrder(ctx context.Context, order domain.Order) error {
+	rules, err := service.LoyaltyRepository.FindRules(ctx)
+	if err != nil {
+		return err
+	}
+	tiers, err := service.LoyaltyRepository.FindTiers(ctx)
+	if err != nil {
+		return err
+	}
+	multiplier := 1.0
+	for _, tier := range tiers {
+		if tier.Name == order.LoyaltyTier && tier.PointsMultiplier > 0 {
+			multiplier = tier.PointsMultiplier
+		}
+	}
+
+	categoryRules := make(map[uint64]domain.LoyaltyRule)
+	var defaultRule *domain.LoyaltyRule
+	for i, rule := range rules {
+		if !rule.Active {
+			continue
+		}
+		if rule.CategoryID == nil {
+			defaultRule = &rules[i]
+		} else {
+			categoryRules[*rule.CategoryID] = rule
+		}
+	}
+
+	// Spend is bucketed per rule so small lines under the same rule still add up to a point
+	spend := make(map[uint64]money.Money)
+	ruleById := make(map[uint64]domain.LoyaltyRule)
+	for _, item := range order.OrderItems {
+		rule, ok := categoryRules[item.Product.CategoryId]
+		if !ok {
+			if defaultRule == nil {
+				continue
+			}
+			rule = *defaultRule
+		}
+		ruleById[rule.LoyaltyRuleID] = rule
+		spend[rule.LoyaltyRuleID] += item.TotalPrice - item.Reductions()
+	}
+
+	// Only the share of the order not paid with points earns points
+	paid, total := int64(1), int64(1)
+	if order.TotalAmount > 0 {
+		var paidWithPoints money.Money
+		for _, payment := range order.Payments {
+			if payment.Status == domain.PaymentStatusCompleted && payment.PaymentType == domain.PaymentTypePoints {
+				paidWithPoints += payment.Amount
+			}
+		}
+		paid, total = int64(money.Max(0, order.TotalAmount-paidWithPoints)), int64(order.TotalAmount)
+	}
+
+	var points int
+	for ruleId, amount := range spend {
+		rule := ruleById[ruleId]
+		if earning := amount.Share(paid, total); earning > 0 {
+			points += int(earning/rule.SpendAmount) * rule.Points
+		}
+	}
+	points = int(math.Floor(float64(points) * multiplier))
+
+	earned, _, err := service.orderPoints(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+	if points > earned {
+		_, err = service.CustomerRepository.MovePoints(ctx, domain.LoyaltyTransaction{
+			CustomerID: order.CustomerID,
+			Type:       domain.LoyaltyTypeEarn,
+			Points:     points - earned,
+			OrderID:    &order.OrderID,
+			Reference:  fmt.Sprintf("Order #%d", order.OrderID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(tiers) == 0 {
+		return nil
+	}
+	customer, err := service.CustomerRepository.FindById(ctx, order.CustomerID)
+	if err != nil {
+		return err
+	}
+	_, err = service.reviewTiers(ctx, []domain.Customer{customer}, tiers)
+	return err
+}
+
+// Redeem takes the points paying for a points tender off the customer
+func (service *LoyaltyServiceImpl) Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	points, err := service.pointsFor(ctx, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	_, err = service.CustomerRepository.MovePoints(ctx, domain.LoyaltyTransaction{
+		CustomerID: order.CustomerID,
+		Type:       domain.LoyaltyTypeRedeem,
+		Points:     -points,
+		OrderID:    &order.OrderID,
+		PaymentID:  &payment.PaymentID,
+		Reference:  fmt.Sprintf("Order #%d", order.OrderID),
+	})
+	if errors.Is(err, repository.ErrInsufficientPoints) {
+		return exception.NewConflictError(fmt.Sprintf("Customer does not have the %d points needed", points))
+	}
+	return err
+}
+
+// RefundRedemption gives back the points a refunded or voided points tender took
+func (service *LoyaltyServiceImpl) RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	transactions, err := service.LoyaltyRepository.FindByOrderId(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+
+	var points int
+	for _, transaction := range transactions {
+		if transaction.PaymentID != nil && *transaction.PaymentID == payment.PaymentID {
+			points -= transaction.Points
+		}
+	}
+	if points <= 0 {
+		return nil
+	}
+
+	_, err = service.CustomerRepository.MovePoints(ctx, domain.LoyaltyTransaction{
+		CustomerID: order.CustomerID,
+		Type:       domain.LoyaltyTypeRefund,
+		Points:     points,
+		OrderID:    &order.OrderID,
+		PaymentID:  &payment.PaymentID,
+		Reference:  fmt.Sprintf("Order #%d refunded", order.OrderID),
+	})
+	return err
+}
+
+// ClawBack takes back the share of the points the order earned that amount refunds. Points the customer
+// already spent cannot be taken back, the clawback stops at their balance.
+func (service *LoyaltyServiceImpl) ClawBack(ctx context.Context, order domain.Order, amount money.Money) error {
+	if order.TotalAmount <= 0 {
+		return nil
+	}
+	earned, clawedBack, err := service.orderPoints(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+
+	points := int(math.Round(float64(earned+clawedBack) * amount.Ratio(order.TotalAmount)))
+	if points > earned {
+		points = earned
+	}
+
+	customer, err := service.CustomerRepository.FindById(ctx, order.CustomerID)
+	if err != nil {
+		return err
+	}
+	if points > customer.LoyaltyPts {
+		points = customer.LoyaltyPts
+	}
+	if points <= 0 {
+		return nil
+	}
+
+	_, err = service.CustomerRepository.MovePoints(ctx, domain.LoyaltyTransaction{
+		CustomerID: order.CustomerID,
+		Type:       domain.LoyaltyTypeClawback,
+		Points:     -points,
+		OrderID:    &order.OrderID,
+		Reference:  fmt.Sprintf("Order #%d refunded", order.OrderID),
+	})
+	return err
+}
+
+// orderPoints returns what the order still has earned, after clawbacks, and how much was clawed back
+func (service *LoyaltyServiceImpl) orderPoints(ctx context.Context, orderId uint64) (int, int, error) {
+	transactions, err := service.LoyaltyRepository.FindByOrderId(ctx, orderId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var earned, clawedBack int
+	for _, transaction := range transactions {
+		switch transaction.Type {
+		case domain.LoyaltyTypeEarn:
+			earned += transaction.Points
+		case domain.LoyaltyTypeClawback:
+			clawedBack -= transaction.Points
+		}
+	}
+	return earned - clawedBack, clawedBack, nil
+}
+
+// pointsFor converts a points tender amount to points, which only pay in whole points
+func (service *LoyaltyServiceImpl) pointsFor(ctx context.Context, amount money.Money) (int, error) {
+	setting, err := service.LoyaltyRepository.FindSetting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if setting.PointValue <= 0 {
+		return 0, exception.NewBadRequestError("Points cannot be redeemed, no point value is set")
+	}
+
+	if amount%setting.PointValue != 0 {
+		return 0, exception.NewBadRequestError(fmt.Sprintf("Points pay in steps of %s", setting.PointValue))
+	}
+	return int(amount / setting.PointValue), nil
+}
+
+// tierDisplay turns a tier value or threshold, minor units of spend or points, into the figure shown
+func tierDisplay(basis string, value int64) float64 {
+	if basis == domain.TierBasisPoints {
+		return float64(value)
+	}
+	return money.Money(value).Float64()
+}
+
+// roundHundredths rounds a tier progress percentage for display
+func roundHundredths(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func (service *LoyaltyServiceImpl) findRule(ctx context.Context, ruleId uint64) (domain.LoyaltyRule, error) {
+	rule, err := service.LoyaltyRepository.FindRuleById(ctx, ruleId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.LoyaltyRule{}, exception.NewNotFoundError("Loyalty rule not found")
+	}
+	return rule, err
+}
+
+func (service *LoyaltyServiceImpl) findTier(ctx context.Context, tierId uint64) (domain.LoyaltyTier, error) {
+	tier, err := service.LoyaltyRepository.FindTierById(ctx, tierId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.LoyaltyTier{}, exception.NewNotFoundError("Loyalty tier not found")
+	}
+	return tier, err
+}
+
+func (service *LoyaltyServiceImpl) findCustomer(ctx context.Context, customerId uint64) (domain.Customer, error) {
+	customer, err := service.CustomerRepository.FindById(ctx, customerId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Customer{}, exception.NewNotFoundError("Customer not found")
+	}
+	return customer, err
+}
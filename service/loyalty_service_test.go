@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var groceryCategoryId = uint64(32)
+
+// loyaltyRulesTpl earns 1 point per 1000 on groceries and 1 point per 5000 on everything else
+var loyaltyRulesTpl = []domain.LoyaltyRule{
+	{LoyaltyRuleID: 1, Name: "Everything", SpendAmount: money.New(5000), Points: 1, Active: true},
+	{LoyaltyRuleID: 2, Name: "Groceries", CategoryID: &groceryCategoryId, SpendAmount: money.New(1000), Points: 1, Active: true},
+}
+
+// loyaltyOrder is a paid order of 9500 in groceries and 9000 in other products for customer 1
+func loyaltyOrder() domain.Order {
+	otherProduct := productModelTpl
+	otherProduct.CategoryId = 40
+	return domain.Order{
+		OrderID:     7,
+		CustomerID:  1,
+		Status:      domain.OrderStatusPlaced,
+		TotalAmount: money.New(18500),
+		OrderItems: []domain.OrderItem{
+			{OrderItemID: 1, ProductID: 1, TotalPrice: money.New(10000), DiscountAmount: money.New(500), Product: productModelTpl},
+			{OrderItemID: 2, ProductID: 2, TotalPrice: money.New(9000), Product: otherProduct},
+		},
+		Payments: []domain.Payment{
+			{PaymentID: 1, OrderID: 7, Amount: money.New(18500), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted},
+		},
+	}
+}
+
+func TestEarnForOrder(t *testing.T) {
+	orderId := uint64(7)
+
+	tests := []struct {
+		name     string
+		order    func() domain.Order
+		rules    []domain.LoyaltyRule
+		tiers    []domain.LoyaltyTier
+		previous []domain.LoyaltyTransaction
+		expect   int
+	}{
+		{
+			name:   "Category rule and the rule for the rest",
+			order:  loyaltyOrder,
+			rules:  loyaltyRulesTpl,
+			expect: 10,
+		},
+		{
+			name: "Part paid with points earns nothing",
+			order: func() domain.Order {
+				order := loyaltyOrder()
+				order.Payments = []domain.Payment{
+					{PaymentID: 1, OrderID: 7, Amount: money.New(9250), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted},
+					{PaymentID: 2, OrderID: 7, Amount: money.New(9250), PaymentType: domain.PaymentTypePoints, Status: domain.PaymentStatusCompleted},
+				}
+				return order
+			},
+			rules:  loyaltyRulesTpl,
+			expect: 4,
+		},
+		{
+			name:   "Inactive rule earns nothing",
+			order:  loyaltyOrder,
+			rules:  []domain.LoyaltyRule{{LoyaltyRuleID: 2, CategoryID: &groceryCategoryId, SpendAmount: money.New(1000), Points: 1}},
+			expect: 0,
+		},
+		{
+			name: "Tier multiplier",
+			order: func() domain.Order {
+				order := loyaltyOrder()
+				order.LoyaltyTier = "Gold"
+				return order
+			},
+			rules:  loyaltyRulesTpl,
+			tiers:  []domain.LoyaltyTier{{LoyaltyTierID: 2, Name: "Gold", SpendThreshold: money.New(5000000), PointsMultiplier: 1.5}},
+			expect: 15,
+		},
+		{
+			name:     "Already earned",
+			order:    loyaltyOrder,
+			rules:    loyaltyRulesTpl,
+			previous: []domain.LoyaltyTransaction{{CustomerID: 1, Type: domain.LoyaltyTypeEarn, Points: 10, OrderID: &orderId}},
+			expect:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			loyaltyRepo.EXPECT().FindRules(gomock.Any()).Return(tt.rules, nil)
+			loyaltyRepo.EXPECT().FindTiers(gomock.Any()).Return(tt.tiers, nil)
+			loyaltyRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(7)).Return(tt.previous, nil)
+			if len(tt.tiers) > 0 {
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Customer{CustomerID: 1, LoyaltyTierID: &tt.tiers[0].LoyaltyTierID}, nil)
+				loyaltyRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.LoyaltySetting{TierBasis: domain.TierBasisSpend}, nil)
+				loyaltyRepo.EXPECT().SumSpendSince(gomock.Any(), gomock.Any(), []uint64{1}).Return(map[uint64]money.Money{1: money.New(6000000)}, nil)
+			}
+			if tt.expect > 0 {
+				customerRepo.EXPECT().MovePoints(gomock.Any(), domain.LoyaltyTransaction{
+					CustomerID: 1,
+					Type:       domain.LoyaltyTypeEarn,
+					Points:     tt.expect,
+					OrderID:    &orderId,
+					Reference:  "Order #7",
+				}).Return(domain.LoyaltyTransaction{}, nil)
+			}
+
+			service := NewLoyaltyService(loyaltyRepo, customerRepo, nil, validator.New())
+			assert.NoError(t, service.EarnForOrder(context.Background(), tt.order()))
+		})
+	}
+}
+
+func TestRedeemPoints(t *testing.T) {
+	tests := []struct {
+		name       string
+		amount     money.Money
+		pointValue money.Money
+		moveErr    error
+		points     int
+		err        error
+	}{
+		{
+			name:       "Success",
+			amount:     money.New(5000),
+			pointValue: money.New(100),
+			points:     50,
+		},
+		{
+			name:       "Not a whole number of points",
+			amount:     money.New(5050),
+			pointValue: money.New(100),
+			err:        exception.NewBadRequestError("Points pay in steps of 100.00"),
+		},
+		{
+			name:   "No point value",
+			amount: money.New(5000),
+			err:    exception.NewBadRequestError("Points cannot be redeemed, no point value is set"),
+		},
+		{
+			name:       "Not enough points",
+			amount:     money.New(5000),
+			pointValue: money.New(100),
+			points:     50,
+			moveErr:    repository.ErrInsufficientPoints,
+			err:        exception.NewConflictError("Customer does not have the 50 points needed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			loyaltyRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.LoyaltySetting{PointValue: tt.pointValue}, nil)
+			if tt.points > 0 {
+				customerRepo.EXPECT().MovePoints(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transaction domain.LoyaltyTransaction) (domain.LoyaltyTransaction, error) {
+						assert.Equal(t, domain.LoyaltyTypeRedeem, transaction.Type)
+						assert.Equal(t, -tt.points, transaction.Points)
+						assert.Equal(t, uint64(3), *transaction.PaymentID)
+						return transaction, tt.moveErr
+					})
+			}
+
+			service := NewLoyaltyService(loyaltyRepo, customerRepo, nil, validator.New())
+			payment := domain.Payment{PaymentID: 3, OrderID: 7, Amount: tt.amount, PaymentType: domain.PaymentTypePoints}
+			assert.Equal(t, tt.err, service.Redeem(context.Background(), loyaltyOrder(), payment))
+		})
+	}
+}
+
+func TestClawBackPoints(t *testing.T) {
+	orderId := uint64(7)
+
+	tests := []struct {
+		name     string
+		amount   money.Money
+		previous []domain.LoyaltyTransaction
+		balance  int
+		expect   int
+	}{
+		{
+			name:     "Half the order takes half the points",
+			amount:   money.New(9250),
+			previous: []domain.LoyaltyTransaction{{Type: domain.LoyaltyTypeEarn, Points: 10, OrderID: &orderId}},
+			balance:  30,
+			expect:   5,
+		},
+		{
+			name:   "Second refund takes its share of what was earned",
+			amount: money.New(9250),
+			previous: []domain.LoyaltyTransaction{
+				{Type: domain.LoyaltyTypeEarn, Points: 10, OrderID: &orderId},
+				{Type: domain.LoyaltyTypeClawback, Points: -5, OrderID: &orderId},
+			},
+			balance: 30,
+			expect:  5,
+		},
+		{
+			name:     "Stops at what the customer has left",
+			amount:   money.New(18500),
+			previous: []domain.LoyaltyTransaction{{Type: domain.LoyaltyTypeEarn, Points: 10, OrderID: &orderId}},
+			balance:  4,
+			expect:   4,
+		},
+		{
+			name:    "Nothing earned",
+			amount:  money.New(18500),
+			balance: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			loyaltyRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(7)).Return(tt.previous, nil)
+			customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Customer{CustomerID: 1, LoyaltyPts: tt.balance}, nil)
+			if tt.expect > 0 {
+				customerRepo.EXPECT().MovePoints(gomock.Any(), domain.LoyaltyTransaction{
+					CustomerID: 1,
+					Type:       domain.LoyaltyTypeClawback,
+					Points:     -tt.expect,
+					OrderID:    &orderId,
+					Reference:  "Order #7 refunded",
+				}).Return(domain.LoyaltyTransaction{}, nil)
+			}
+
+			service := NewLoyaltyService(loyaltyRepo, customerRepo, nil, validator.New())
+			assert.NoError(t, service.ClawBack(context.Background(), loyaltyOrder(), tt.amount))
+		})
+	}
+}
+
+func TestCreateLoyaltyRule(t *testing.T) {
+	drinksCategoryId := uint64(40)
+
+	tests := []struct {
+		name  string
+		input web.LoyaltyRuleCreateRequest
+		mock  func(loyaltyRepo *mocks.MockLoyaltyRepository, categoryRepo *mocks.MockCategoryRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.LoyaltyRuleCreateRequest{Name: "Drinks", CategoryID: &drinksCategoryId, SpendAmount: money.New(2000), Points: 1, Active: true},
+			mock: func(loyaltyRepo *mocks.MockLoyaltyRepository, categoryRepo *mocks.MockCategoryRepository) {
+				categoryRepo.EXPECT().FindById(gomock.Any(), drinksCategoryId).Return(domain.Category{Id: drinksCategoryId}, nil)
+				loyaltyRepo.EXPECT().FindRules(gomock.Any()).Return(loyaltyRulesTpl, nil)
+				loyaltyRepo.EXPECT().SaveRule(gomock.Any(), gomock.Any()).Return(domain.LoyaltyRule{LoyaltyRuleID: 3}, nil)
+			},
+		},
+		{
+			name:  "Second rule without a category",
+			input: web.LoyaltyRuleCreateRequest{Name: "Rest", SpendAmount: money.New(2000), Points: 1},
+			mock: func(loyaltyRepo *mocks.MockLoyaltyRepository, categoryRepo *mocks.MockCategoryRepository) {
+				loyaltyRepo.EXPECT().FindRules(gomock.Any()).Return(loyaltyRulesTpl, nil)
+			},
+			err: exception.NewConflictError("There already is a rule for products without a category rule"),
+		},
+		{
+			name:  "Second rule for a category",
+			input: web.LoyaltyRuleCreateRequest{Name: "Groceries", CategoryID: &groceryCategoryId, SpendAmount: money.New(2000), Points: 1},
+			mock: func(loyaltyRepo *mocks.MockLoyaltyRepository, categoryRepo *mocks.MockCategoryRepository) {
+				categoryRepo.EXPECT().FindById(gomock.Any(), groceryCategoryId).Return(domain.Category{Id: groceryCategoryId}, nil)
+				loyaltyRepo.EXPECT().FindRules(gomock.Any()).Return(loyaltyRulesTpl, nil)
+			},
+			err: exception.NewConflictError("There already is a rule for category 32"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+			categoryRepo := mocks.NewMockCategoryRepository(ctrl)
+			tt.mock(loyaltyRepo, categoryRepo)
+
+			service := NewLoyaltyService(loyaltyRepo, nil, categoryRepo, validator.New())
+			_, err := service.CreateRule(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+// loyaltyTiersTpl are reached at 2,000,000 and 5,000,000 of spend or at 200 and 500 points
+var loyaltyTiersTpl = []domain.LoyaltyTier{
+	{LoyaltyTierID: 1, Name: "Silver", SpendThreshold: money.New(2000000), PointsThreshold: 200, DiscountPct: 2, PointsMultiplier: 1},
+	{LoyaltyTierID: 2, Name: "Gold", SpendThreshold: money.New(5000000), PointsThreshold: 500, DiscountPct: 5, PointsMultiplier: 2},
+}
+
+func TestReviewTiers(t *testing.T) {
+	silverId, goldId := uint64(1), uint64(2)
+	customers := []domain.Customer{
+		{CustomerID: 1},
+		{CustomerID: 2, LoyaltyTierID: &goldId},
+		{CustomerID: 3, LoyaltyTierID: &silverId},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+	customerRepo := mocks.NewMockCustomerRepository(ctrl)
+	customerRepo.EXPECT().FindAll(gomock.Any()).Return(customers, nil)
+	loyaltyRepo.EXPECT().FindTiers(gomock.Any()).Return(loyaltyTiersTpl, nil)
+	loyaltyRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.LoyaltySetting{TierBasis: domain.TierBasisSpend}, nil)
+	loyaltyRepo.EXPECT().SumSpendSince(gomock.Any(), gomock.Any(), []uint64{1, 2, 3}).
+		Return(map[uint64]money.Money{1: money.New(2500000), 2: money.New(1000000), 3: money.New(3000000)}, nil)
+	customerRepo.EXPECT().UpdateTier(gomock.Any(), domain.Customer{CustomerID: 1, LoyaltyTierID: &silverId}).Return(domain.Customer{}, nil)
+	customerRepo.EXPECT().UpdateTier(gomock.Any(), domain.Customer{CustomerID: 2}).Return(domain.Customer{}, nil)
+
+	service := NewLoyaltyService(loyaltyRepo, customerRepo, nil, validator.New())
+	review, err := service.ReviewTiers(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, web.TierReviewResponse{Reviewed: 3, Promoted: 1, Demoted: 1}, review)
+}
+
+func TestTierStatus(t *testing.T) {
+	silverId, goldId := uint64(1), uint64(2)
+
+	tests := []struct {
+		name     string
+		customer domain.Customer
+		value    float64
+		expect   web.CustomerTierResponse
+	}{
+		{
+			name:     "Halfway to the next tier",
+			customer: domain.Customer{CustomerID: 1, LoyaltyTierID: &silverId},
+			value:    3500000,
+			expect: web.CustomerTierResponse{Basis: domain.TierBasisSpend, Value: 3500000, TierID: &silverId, TierName: "Silver",
+				NextTierID: &goldId, NextTierName: "Gold", NextThreshold: 5000000, Remaining: 1500000, Progress: 50},
+		},
+		{
+			name:     "No tier yet",
+			customer: domain.Customer{CustomerID: 1},
+			value:    500000,
+			expect: web.CustomerTierResponse{Basis: domain.TierBasisSpend, Value: 500000,
+				NextTierID: &silverId, NextTierName: "Silver", NextThreshold: 2000000, Remaining: 1500000, Progress: 25},
+		},
+		{
+			name:     "Top tier",
+			customer: domain.Customer{CustomerID: 1, LoyaltyTierID: &goldId},
+			value:    6000000,
+			expect:   web.CustomerTierResponse{Basis: domain.TierBasisSpend, Value: 6000000, TierID: &goldId, TierName: "Gold", Progress: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+			loyaltyRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.LoyaltySetting{TierBasis: domain.TierBasisSpend}, nil)
+			loyaltyRepo.EXPECT().FindTiers(gomock.Any()).Return(loyaltyTiersTpl, nil)
+			loyaltyRepo.EXPECT().SumSpendSince(gomock.Any(), gomock.Any(), []uint64{1}).Return(map[uint64]money.Money{1: money.FromFloat(tt.value)}, nil)
+
+			service := NewLoyaltyService(loyaltyRepo, nil, nil, validator.New())
+			statuses, err := service.TierStatus(context.Background(), []domain.Customer{tt.customer})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, statuses[1])
+		})
+	}
+}
+
+func TestTierStatusOnPoints(t *testing.T) {
+	silverId, goldId := uint64(1), uint64(2)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	loyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+	loyaltyRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.LoyaltySetting{TierBasis: domain.TierBasisPoints}, nil)
+	loyaltyRepo.EXPECT().FindTiers(gomock.Any()).Return(loyaltyTiersTpl, nil)
+	loyaltyRepo.EXPECT().SumEarnedSince(gomock.Any(), gomock.Any(), []uint64{1}).Return(map[uint64]int{1: 275}, nil)
+
+	service := NewLoyaltyService(loyaltyRepo, nil, nil, validator.New())
+	statuses, err := service.TierStatus(context.Background(), []domain.Customer{{CustomerID: 1, LoyaltyTierID: &silverId}})
+	assert.NoError(t, err)
+	assert.Equal(t, web.CustomerTierResponse{Basis: domain.TierBasisPoints, Value: 275, TierID: &silverId, TierName: "Silver",
+		NextTierID: &goldId, NextTierName: "Gold", NextThreshold: 500, Remaining: 225, Progress: 25}, statuses[1])
+}
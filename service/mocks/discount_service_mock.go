@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/discount_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDiscountService is a mock of DiscountService interface.
+type MockDiscountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiscountServiceMockRecorder
+}
+
+// MockDiscountServiceMockRecorder is the mock recorder for MockDiscountService.
+type MockDiscountServiceMockRecorder struct {
+	mock *MockDiscountService
+}
+
+// NewMockDiscountService creates a new mock instance.
+func NewMockDiscountService(ctrl *gomock.Controller) *MockDiscountService {
+	mock := &MockDiscountService{ctrl: ctrl}
+	mock.recorder = &MockDiscountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiscountService) EXPECT() *MockDiscountServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyToOrder mocks base method.
+func (m *MockDiscountService) ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyToOrder", ctx, order, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyToOrder indicates an expected call of ApplyToOrder.
+func (mr *MockDiscountServiceMockRecorder) ApplyToOrder(ctx, order, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyToOrder", reflect.TypeOf((*MockDiscountService)(nil).ApplyToOrder), ctx, order, at)
+}
+
+// Create mocks base method.
+func (m *MockDiscountService) Create(ctx context.Context, request web.DiscountCreateRequest) (web.DiscountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.DiscountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDiscountServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDiscountService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockDiscountService) Delete(ctx context.Context, discountId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, discountId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiscountServiceMockRecorder) Delete(ctx, discountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDiscountService)(nil).Delete), ctx, discountId)
+}
+
+// FindAll mocks base method.
+func (m *MockDiscountService) FindAll(ctx context.Context) ([]web.DiscountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.DiscountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDiscountServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDiscountService)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockDiscountService) FindById(ctx context.Context, discountId uint64) (web.DiscountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, discountId)
+	ret0, _ := ret[0].(web.DiscountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockDiscountServiceMockRecorder) FindById(ctx, discountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDiscountService)(nil).FindById), ctx, discountId)
+}
+
+// Update mocks base method.
+func (m *MockDiscountService) Update(ctx context.Context, request web.DiscountUpdateRequest) (web.DiscountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.DiscountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDiscountServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDiscountService)(nil).Update), ctx, request)
+}
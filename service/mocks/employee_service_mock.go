@@ -65,18 +65,18 @@ func (mr *MockEmployeeServiceMockRecorder) Delete(ctx, employeeId interface{}) *
 }
 
 // FindAll mocks base method.
-func (m *MockEmployeeService) FindAll(ctx context.Context) ([]web.EmployeeResponse, error) {
+func (m *MockEmployeeService) FindAll(ctx context.Context, storeId uint64) ([]web.EmployeeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
 	ret0, _ := ret[0].([]web.EmployeeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockEmployeeServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockEmployeeServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeService)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeService)(nil).FindAll), ctx, storeId)
 }
 
 // FindById mocks base method.
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/exchange_rate_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeRateService is a mock of ExchangeRateService interface.
+type MockExchangeRateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateServiceMockRecorder
+}
+
+// MockExchangeRateServiceMockRecorder is the mock recorder for MockExchangeRateService.
+type MockExchangeRateServiceMockRecorder struct {
+	mock *MockExchangeRateService
+}
+
+// NewMockExchangeRateService creates a new mock instance.
+func NewMockExchangeRateService(ctrl *gomock.Controller) *MockExchangeRateService {
+	mock := &MockExchangeRateService{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateService) EXPECT() *MockExchangeRateServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExchangeRateService) Create(ctx context.Context, request web.ExchangeRateCreateRequest) (web.ExchangeRateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.ExchangeRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExchangeRateServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExchangeRateService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRateService) Delete(ctx context.Context, exchangeRateId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, exchangeRateId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRateServiceMockRecorder) Delete(ctx, exchangeRateId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRateService)(nil).Delete), ctx, exchangeRateId)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateService) FindAll(ctx context.Context) ([]web.ExchangeRateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.ExchangeRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateService)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockExchangeRateService) FindById(ctx context.Context, exchangeRateId uint64) (web.ExchangeRateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, exchangeRateId)
+	ret0, _ := ret[0].(web.ExchangeRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockExchangeRateServiceMockRecorder) FindById(ctx, exchangeRateId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockExchangeRateService)(nil).FindById), ctx, exchangeRateId)
+}
+
+// RateAt mocks base method.
+func (m *MockExchangeRateService) RateAt(ctx context.Context, currency string, at time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateAt", ctx, currency, at)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateAt indicates an expected call of RateAt.
+func (mr *MockExchangeRateServiceMockRecorder) RateAt(ctx, currency, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateAt", reflect.TypeOf((*MockExchangeRateService)(nil).RateAt), ctx, currency, at)
+}
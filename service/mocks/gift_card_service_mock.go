@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/gift_card_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	money "github.com/Kahffi/go-rest-api-test/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGiftCardService is a mock of GiftCardService interface.
+type MockGiftCardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardServiceMockRecorder
+}
+
+// MockGiftCardServiceMockRecorder is the mock recorder for MockGiftCardService.
+type MockGiftCardServiceMockRecorder struct {
+	mock *MockGiftCardService
+}
+
+// NewMockGiftCardService creates a new mock instance.
+func NewMockGiftCardService(ctrl *gomock.Controller) *MockGiftCardService {
+	mock := &MockGiftCardService{ctrl: ctrl}
+	mock.recorder = &MockGiftCardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardService) EXPECT() *MockGiftCardServiceMockRecorder {
+	return m.recorder
+}
+
+// CardForTender mocks base method.
+func (m *MockGiftCardService) CardForTender(ctx context.Context, order domain.Order, paymentType, code string) (domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardForTender", ctx, order, paymentType, code)
+	ret0, _ := ret[0].(domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardForTender indicates an expected call of CardForTender.
+func (mr *MockGiftCardServiceMockRecorder) CardForTender(ctx, order, paymentType, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardForTender", reflect.TypeOf((*MockGiftCardService)(nil).CardForTender), ctx, order, paymentType, code)
+}
+
+// ExpireBalances mocks base method.
+func (m *MockGiftCardService) ExpireBalances(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireBalances", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireBalances indicates an expected call of ExpireBalances.
+func (mr *MockGiftCardServiceMockRecorder) ExpireBalances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireBalances", reflect.TypeOf((*MockGiftCardService)(nil).ExpireBalances), ctx)
+}
+
+// FindAll mocks base method.
+func (m *MockGiftCardService) FindAll(ctx context.Context) ([]web.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGiftCardServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGiftCardService)(nil).FindAll), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockGiftCardService) FindByCode(ctx context.Context, code string) (web.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(web.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockGiftCardServiceMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockGiftCardService)(nil).FindByCode), ctx, code)
+}
+
+// FindLedger mocks base method.
+func (m *MockGiftCardService) FindLedger(ctx context.Context, code string) (web.GiftCardLedgerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLedger", ctx, code)
+	ret0, _ := ret[0].(web.GiftCardLedgerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLedger indicates an expected call of FindLedger.
+func (mr *MockGiftCardServiceMockRecorder) FindLedger(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLedger", reflect.TypeOf((*MockGiftCardService)(nil).FindLedger), ctx, code)
+}
+
+// Issue mocks base method.
+func (m *MockGiftCardService) Issue(ctx context.Context, request web.GiftCardIssueRequest) (web.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, request)
+	ret0, _ := ret[0].(web.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockGiftCardServiceMockRecorder) Issue(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockGiftCardService)(nil).Issue), ctx, request)
+}
+
+// IssueStoreCredit mocks base method.
+func (m *MockGiftCardService) IssueStoreCredit(ctx context.Context, order domain.Order, amount money.Money) (domain.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueStoreCredit", ctx, order, amount)
+	ret0, _ := ret[0].(domain.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueStoreCredit indicates an expected call of IssueStoreCredit.
+func (mr *MockGiftCardServiceMockRecorder) IssueStoreCredit(ctx, order, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueStoreCredit", reflect.TypeOf((*MockGiftCardService)(nil).IssueStoreCredit), ctx, order, amount)
+}
+
+// Redeem mocks base method.
+func (m *MockGiftCardService) Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, order, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGiftCardServiceMockRecorder) Redeem(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGiftCardService)(nil).Redeem), ctx, order, payment)
+}
+
+// RefundRedemption mocks base method.
+func (m *MockGiftCardService) RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundRedemption", ctx, order, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundRedemption indicates an expected call of RefundRedemption.
+func (mr *MockGiftCardServiceMockRecorder) RefundRedemption(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundRedemption", reflect.TypeOf((*MockGiftCardService)(nil).RefundRedemption), ctx, order, payment)
+}
+
+// TopUp mocks base method.
+func (m *MockGiftCardService) TopUp(ctx context.Context, request web.GiftCardTopUpRequest) (web.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUp", ctx, request)
+	ret0, _ := ret[0].(web.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUp indicates an expected call of TopUp.
+func (mr *MockGiftCardServiceMockRecorder) TopUp(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUp", reflect.TypeOf((*MockGiftCardService)(nil).TopUp), ctx, request)
+}
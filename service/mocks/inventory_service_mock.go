@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/inventory_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInventoryService is a mock of InventoryService interface.
+type MockInventoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceMockRecorder
+}
+
+// MockInventoryServiceMockRecorder is the mock recorder for MockInventoryService.
+type MockInventoryServiceMockRecorder struct {
+	mock *MockInventoryService
+}
+
+// NewMockInventoryService creates a new mock instance.
+func NewMockInventoryService(ctrl *gomock.Controller) *MockInventoryService {
+	mock := &MockInventoryService{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockInventoryService) Adjust(ctx context.Context, request web.StockAdjustmentRequest) (web.StockMovementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, request)
+	ret0, _ := ret[0].(web.StockMovementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockInventoryServiceMockRecorder) Adjust(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockInventoryService)(nil).Adjust), ctx, request)
+}
+
+// Audit mocks base method.
+func (m *MockInventoryService) Audit(ctx context.Context, productId uint64) (web.StockAuditResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", ctx, productId)
+	ret0, _ := ret[0].(web.StockAuditResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockInventoryServiceMockRecorder) Audit(ctx, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockInventoryService)(nil).Audit), ctx, productId)
+}
+
+// FindAll mocks base method.
+func (m *MockInventoryService) FindAll(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]web.InventoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockInventoryServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInventoryService)(nil).FindAll), ctx, storeId)
+}
+
+// FindByProductId mocks base method.
+func (m *MockInventoryService) FindByProductId(ctx context.Context, productId, storeId uint64) (web.InventoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductId", ctx, productId, storeId)
+	ret0, _ := ret[0].(web.InventoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProductId indicates an expected call of FindByProductId.
+func (mr *MockInventoryServiceMockRecorder) FindByProductId(ctx, productId, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductId", reflect.TypeOf((*MockInventoryService)(nil).FindByProductId), ctx, productId, storeId)
+}
+
+// FindLowStock mocks base method.
+func (m *MockInventoryService) FindLowStock(ctx context.Context, storeId uint64) ([]web.InventoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLowStock", ctx, storeId)
+	ret0, _ := ret[0].([]web.InventoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLowStock indicates an expected call of FindLowStock.
+func (mr *MockInventoryServiceMockRecorder) FindLowStock(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLowStock", reflect.TypeOf((*MockInventoryService)(nil).FindLowStock), ctx, storeId)
+}
+
+// FindMovements mocks base method.
+func (m *MockInventoryService) FindMovements(ctx context.Context, productId, storeId uint64) ([]web.StockMovementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovements", ctx, productId, storeId)
+	ret0, _ := ret[0].([]web.StockMovementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMovements indicates an expected call of FindMovements.
+func (mr *MockInventoryServiceMockRecorder) FindMovements(ctx, productId, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovements", reflect.TypeOf((*MockInventoryService)(nil).FindMovements), ctx, productId, storeId)
+}
+
+// Restock mocks base method.
+func (m *MockInventoryService) Restock(ctx context.Context, request web.RestockRequest) (web.InventoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restock", ctx, request)
+	ret0, _ := ret[0].(web.InventoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restock indicates an expected call of Restock.
+func (mr *MockInventoryServiceMockRecorder) Restock(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restock", reflect.TypeOf((*MockInventoryService)(nil).Restock), ctx, request)
+}
+
+// Update mocks base method.
+func (m *MockInventoryService) Update(ctx context.Context, request web.InventoryUpdateRequest) (web.InventoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.InventoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInventoryServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInventoryService)(nil).Update), ctx, request)
+}
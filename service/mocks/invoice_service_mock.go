@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/invoice_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceService is a mock of InvoiceService interface.
+type MockInvoiceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceServiceMockRecorder
+}
+
+// MockInvoiceServiceMockRecorder is the mock recorder for MockInvoiceService.
+type MockInvoiceServiceMockRecorder struct {
+	mock *MockInvoiceService
+}
+
+// NewMockInvoiceService creates a new mock instance.
+func NewMockInvoiceService(ctrl *gomock.Controller) *MockInvoiceService {
+	mock := &MockInvoiceService{ctrl: ctrl}
+	mock.recorder = &MockInvoiceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceService) EXPECT() *MockInvoiceServiceMockRecorder {
+	return m.recorder
+}
+
+// OrderInvoice mocks base method.
+func (m *MockInvoiceService) OrderInvoice(ctx context.Context, orderId uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderInvoice", ctx, orderId)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderInvoice indicates an expected call of OrderInvoice.
+func (mr *MockInvoiceServiceMockRecorder) OrderInvoice(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderInvoice", reflect.TypeOf((*MockInvoiceService)(nil).OrderInvoice), ctx, orderId)
+}
+
+// ReceiptInvoice mocks base method.
+func (m *MockInvoiceService) ReceiptInvoice(ctx context.Context, receiptId uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiptInvoice", ctx, receiptId)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiptInvoice indicates an expected call of ReceiptInvoice.
+func (mr *MockInvoiceServiceMockRecorder) ReceiptInvoice(ctx, receiptId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiptInvoice", reflect.TypeOf((*MockInvoiceService)(nil).ReceiptInvoice), ctx, receiptId)
+}
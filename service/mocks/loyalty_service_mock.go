@@ -0,0 +1,332 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/loyalty_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	money "github.com/Kahffi/go-rest-api-test/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoyaltyService is a mock of LoyaltyService interface.
+type MockLoyaltyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyServiceMockRecorder
+}
+
+// MockLoyaltyServiceMockRecorder is the mock recorder for MockLoyaltyService.
+type MockLoyaltyServiceMockRecorder struct {
+	mock *MockLoyaltyService
+}
+
+// NewMockLoyaltyService creates a new mock instance.
+func NewMockLoyaltyService(ctrl *gomock.Controller) *MockLoyaltyService {
+	mock := &MockLoyaltyService{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyService) EXPECT() *MockLoyaltyServiceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockLoyaltyService) Adjust(ctx context.Context, request web.PointsAdjustmentRequest) (web.LoyaltyTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltyTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockLoyaltyServiceMockRecorder) Adjust(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockLoyaltyService)(nil).Adjust), ctx, request)
+}
+
+// ClawBack mocks base method.
+func (m *MockLoyaltyService) ClawBack(ctx context.Context, order domain.Order, amount money.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClawBack", ctx, order, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClawBack indicates an expected call of ClawBack.
+func (mr *MockLoyaltyServiceMockRecorder) ClawBack(ctx, order, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClawBack", reflect.TypeOf((*MockLoyaltyService)(nil).ClawBack), ctx, order, amount)
+}
+
+// CreateRule mocks base method.
+func (m *MockLoyaltyService) CreateRule(ctx context.Context, request web.LoyaltyRuleCreateRequest) (web.LoyaltyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockLoyaltyServiceMockRecorder) CreateRule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockLoyaltyService)(nil).CreateRule), ctx, request)
+}
+
+// CreateTier mocks base method.
+func (m *MockLoyaltyService) CreateTier(ctx context.Context, request web.LoyaltyTierCreateRequest) (web.LoyaltyTierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTier", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltyTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTier indicates an expected call of CreateTier.
+func (mr *MockLoyaltyServiceMockRecorder) CreateTier(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTier", reflect.TypeOf((*MockLoyaltyService)(nil).CreateTier), ctx, request)
+}
+
+// DeleteRule mocks base method.
+func (m *MockLoyaltyService) DeleteRule(ctx context.Context, ruleId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, ruleId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockLoyaltyServiceMockRecorder) DeleteRule(ctx, ruleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockLoyaltyService)(nil).DeleteRule), ctx, ruleId)
+}
+
+// DeleteTier mocks base method.
+func (m *MockLoyaltyService) DeleteTier(ctx context.Context, tierId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTier", ctx, tierId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTier indicates an expected call of DeleteTier.
+func (mr *MockLoyaltyServiceMockRecorder) DeleteTier(ctx, tierId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTier", reflect.TypeOf((*MockLoyaltyService)(nil).DeleteTier), ctx, tierId)
+}
+
+// EarnForOrder mocks base method.
+func (m *MockLoyaltyService) EarnForOrder(ctx context.Context, order domain.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EarnForOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EarnForOrder indicates an expected call of EarnForOrder.
+func (mr *MockLoyaltyServiceMockRecorder) EarnForOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EarnForOrder", reflect.TypeOf((*MockLoyaltyService)(nil).EarnForOrder), ctx, order)
+}
+
+// FindLedger mocks base method.
+func (m *MockLoyaltyService) FindLedger(ctx context.Context, customerId uint64) (web.LoyaltyLedgerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLedger", ctx, customerId)
+	ret0, _ := ret[0].(web.LoyaltyLedgerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLedger indicates an expected call of FindLedger.
+func (mr *MockLoyaltyServiceMockRecorder) FindLedger(ctx, customerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLedger", reflect.TypeOf((*MockLoyaltyService)(nil).FindLedger), ctx, customerId)
+}
+
+// FindRuleById mocks base method.
+func (m *MockLoyaltyService) FindRuleById(ctx context.Context, ruleId uint64) (web.LoyaltyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuleById", ctx, ruleId)
+	ret0, _ := ret[0].(web.LoyaltyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRuleById indicates an expected call of FindRuleById.
+func (mr *MockLoyaltyServiceMockRecorder) FindRuleById(ctx, ruleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuleById", reflect.TypeOf((*MockLoyaltyService)(nil).FindRuleById), ctx, ruleId)
+}
+
+// FindRules mocks base method.
+func (m *MockLoyaltyService) FindRules(ctx context.Context) ([]web.LoyaltyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRules", ctx)
+	ret0, _ := ret[0].([]web.LoyaltyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRules indicates an expected call of FindRules.
+func (mr *MockLoyaltyServiceMockRecorder) FindRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRules", reflect.TypeOf((*MockLoyaltyService)(nil).FindRules), ctx)
+}
+
+// FindSettings mocks base method.
+func (m *MockLoyaltyService) FindSettings(ctx context.Context) (web.LoyaltySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettings", ctx)
+	ret0, _ := ret[0].(web.LoyaltySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSettings indicates an expected call of FindSettings.
+func (mr *MockLoyaltyServiceMockRecorder) FindSettings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettings", reflect.TypeOf((*MockLoyaltyService)(nil).FindSettings), ctx)
+}
+
+// FindTierById mocks base method.
+func (m *MockLoyaltyService) FindTierById(ctx context.Context, tierId uint64) (web.LoyaltyTierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTierById", ctx, tierId)
+	ret0, _ := ret[0].(web.LoyaltyTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTierById indicates an expected call of FindTierById.
+func (mr *MockLoyaltyServiceMockRecorder) FindTierById(ctx, tierId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTierById", reflect.TypeOf((*MockLoyaltyService)(nil).FindTierById), ctx, tierId)
+}
+
+// FindTiers mocks base method.
+func (m *MockLoyaltyService) FindTiers(ctx context.Context) ([]web.LoyaltyTierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTiers", ctx)
+	ret0, _ := ret[0].([]web.LoyaltyTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTiers indicates an expected call of FindTiers.
+func (mr *MockLoyaltyServiceMockRecorder) FindTiers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTiers", reflect.TypeOf((*MockLoyaltyService)(nil).FindTiers), ctx)
+}
+
+// Redeem mocks base method.
+func (m *MockLoyaltyService) Redeem(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, order, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockLoyaltyServiceMockRecorder) Redeem(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockLoyaltyService)(nil).Redeem), ctx, order, payment)
+}
+
+// RefundRedemption mocks base method.
+func (m *MockLoyaltyService) RefundRedemption(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundRedemption", ctx, order, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundRedemption indicates an expected call of RefundRedemption.
+func (mr *MockLoyaltyServiceMockRecorder) RefundRedemption(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundRedemption", reflect.TypeOf((*MockLoyaltyService)(nil).RefundRedemption), ctx, order, payment)
+}
+
+// ReviewTiers mocks base method.
+func (m *MockLoyaltyService) ReviewTiers(ctx context.Context) (web.TierReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewTiers", ctx)
+	ret0, _ := ret[0].(web.TierReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewTiers indicates an expected call of ReviewTiers.
+func (mr *MockLoyaltyServiceMockRecorder) ReviewTiers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTiers", reflect.TypeOf((*MockLoyaltyService)(nil).ReviewTiers), ctx)
+}
+
+// TierStatus mocks base method.
+func (m *MockLoyaltyService) TierStatus(ctx context.Context, customers []domain.Customer) (map[uint64]web.CustomerTierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TierStatus", ctx, customers)
+	ret0, _ := ret[0].(map[uint64]web.CustomerTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TierStatus indicates an expected call of TierStatus.
+func (mr *MockLoyaltyServiceMockRecorder) TierStatus(ctx, customers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TierStatus", reflect.TypeOf((*MockLoyaltyService)(nil).TierStatus), ctx, customers)
+}
+
+// UpdateRule mocks base method.
+func (m *MockLoyaltyService) UpdateRule(ctx context.Context, request web.LoyaltyRuleUpdateRequest) (web.LoyaltyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockLoyaltyServiceMockRecorder) UpdateRule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockLoyaltyService)(nil).UpdateRule), ctx, request)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockLoyaltyService) UpdateSettings(ctx context.Context, request web.LoyaltySettingsUpdateRequest) (web.LoyaltySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockLoyaltyServiceMockRecorder) UpdateSettings(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockLoyaltyService)(nil).UpdateSettings), ctx, request)
+}
+
+// UpdateTier mocks base method.
+func (m *MockLoyaltyService) UpdateTier(ctx context.Context, request web.LoyaltyTierUpdateRequest) (web.LoyaltyTierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTier", ctx, request)
+	ret0, _ := ret[0].(web.LoyaltyTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTier indicates an expected call of UpdateTier.
+func (mr *MockLoyaltyServiceMockRecorder) UpdateTier(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTier", reflect.TypeOf((*MockLoyaltyService)(nil).UpdateTier), ctx, request)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/order_return_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderReturnService is a mock of OrderReturnService interface.
+type MockOrderReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderReturnServiceMockRecorder
+}
+
+// MockOrderReturnServiceMockRecorder is the mock recorder for MockOrderReturnService.
+type MockOrderReturnServiceMockRecorder struct {
+	mock *MockOrderReturnService
+}
+
+// NewMockOrderReturnService creates a new mock instance.
+func NewMockOrderReturnService(ctrl *gomock.Controller) *MockOrderReturnService {
+	mock := &MockOrderReturnService{ctrl: ctrl}
+	mock.recorder = &MockOrderReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderReturnService) EXPECT() *MockOrderReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrderReturnService) Create(ctx context.Context, request web.OrderReturnCreateRequest) (web.OrderReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.OrderReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrderReturnServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderReturnService)(nil).Create), ctx, request)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockOrderReturnService) FindByOrderId(ctx context.Context, orderId uint64) ([]web.OrderReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].([]web.OrderReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockOrderReturnServiceMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockOrderReturnService)(nil).FindByOrderId), ctx, orderId)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/payment_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentService is a mock of PaymentService interface.
+type MockPaymentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentServiceMockRecorder
+}
+
+// MockPaymentServiceMockRecorder is the mock recorder for MockPaymentService.
+type MockPaymentServiceMockRecorder struct {
+	mock *MockPaymentService
+}
+
+// NewMockPaymentService creates a new mock instance.
+func NewMockPaymentService(ctrl *gomock.Controller) *MockPaymentService {
+	mock := &MockPaymentService{ctrl: ctrl}
+	mock.recorder = &MockPaymentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentService) EXPECT() *MockPaymentServiceMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockPaymentService) Complete(ctx context.Context, orderId, paymentId uint64) (web.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, orderId, paymentId)
+	ret0, _ := ret[0].(web.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockPaymentServiceMockRecorder) Complete(ctx, orderId, paymentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockPaymentService)(nil).Complete), ctx, orderId, paymentId)
+}
+
+// Create mocks base method.
+func (m *MockPaymentService) Create(ctx context.Context, request web.PaymentCreateRequest) (web.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentService)(nil).Create), ctx, request)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockPaymentService) FindByOrderId(ctx context.Context, orderId uint64) ([]web.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].([]web.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockPaymentServiceMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockPaymentService)(nil).FindByOrderId), ctx, orderId)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentService) Refund(ctx context.Context, orderId, paymentId uint64) (web.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, orderId, paymentId)
+	ret0, _ := ret[0].(web.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentServiceMockRecorder) Refund(ctx, orderId, paymentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentService)(nil).Refund), ctx, orderId, paymentId)
+}
+
+// Void mocks base method.
+func (m *MockPaymentService) Void(ctx context.Context, orderId, paymentId uint64) (web.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, orderId, paymentId)
+	ret0, _ := ret[0].(web.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentServiceMockRecorder) Void(ctx, orderId, paymentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentService)(nil).Void), ctx, orderId, paymentId)
+}
@@ -65,33 +65,33 @@ func (mr *MockProductServiceMockRecorder) Delete(ctx, productId interface{}) *go
 }
 
 // FindAll mocks base method.
-func (m *MockProductService) FindAll(ctx context.Context) ([]web.ProductResponse, error) {
+func (m *MockProductService) FindAll(ctx context.Context, query web.ProductQuery) ([]web.ProductResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, query)
 	ret0, _ := ret[0].([]web.ProductResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockProductServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) FindAll(ctx, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductService)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductService)(nil).FindAll), ctx, query)
 }
 
 // FindById mocks base method.
-func (m *MockProductService) FindById(ctx context.Context, productId uint64) (web.ProductResponse, error) {
+func (m *MockProductService) FindById(ctx context.Context, productId uint64, query web.ProductQuery) (web.ProductResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindById", ctx, productId)
+	ret := m.ctrl.Call(m, "FindById", ctx, productId, query)
 	ret0, _ := ret[0].(web.ProductResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindById indicates an expected call of FindById.
-func (mr *MockProductServiceMockRecorder) FindById(ctx, productId interface{}) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) FindById(ctx, productId, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductService)(nil).FindById), ctx, productId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductService)(nil).FindById), ctx, productId, query)
 }
 
 // Update mocks base method.
@@ -108,3 +108,18 @@ func (mr *MockProductServiceMockRecorder) Update(ctx, request interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductService)(nil).Update), ctx, request)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockProductService) UpdateVariant(ctx context.Context, request web.ProductVariantUpdateRequest) (web.ProductResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, request)
+	ret0, _ := ret[0].(web.ProductResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductServiceMockRecorder) UpdateVariant(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductService)(nil).UpdateVariant), ctx, request)
+}
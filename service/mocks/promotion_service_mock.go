@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/promotion_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromotionService is a mock of PromotionService interface.
+type MockPromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionServiceMockRecorder
+}
+
+// MockPromotionServiceMockRecorder is the mock recorder for MockPromotionService.
+type MockPromotionServiceMockRecorder struct {
+	mock *MockPromotionService
+}
+
+// NewMockPromotionService creates a new mock instance.
+func NewMockPromotionService(ctrl *gomock.Controller) *MockPromotionService {
+	mock := &MockPromotionService{ctrl: ctrl}
+	mock.recorder = &MockPromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionService) EXPECT() *MockPromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyToOrder mocks base method.
+func (m *MockPromotionService) ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyToOrder", ctx, order, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyToOrder indicates an expected call of ApplyToOrder.
+func (mr *MockPromotionServiceMockRecorder) ApplyToOrder(ctx, order, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyToOrder", reflect.TypeOf((*MockPromotionService)(nil).ApplyToOrder), ctx, order, at)
+}
+
+// Create mocks base method.
+func (m *MockPromotionService) Create(ctx context.Context, request web.PromotionCreateRequest) (web.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionService) Delete(ctx context.Context, promotionId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, promotionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionServiceMockRecorder) Delete(ctx, promotionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionService)(nil).Delete), ctx, promotionId)
+}
+
+// FindAll mocks base method.
+func (m *MockPromotionService) FindAll(ctx context.Context) ([]web.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromotionServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromotionService)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockPromotionService) FindById(ctx context.Context, promotionId uint64) (web.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, promotionId)
+	ret0, _ := ret[0].(web.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPromotionServiceMockRecorder) FindById(ctx, promotionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPromotionService)(nil).FindById), ctx, promotionId)
+}
+
+// Update mocks base method.
+func (m *MockPromotionService) Update(ctx context.Context, request web.PromotionUpdateRequest) (web.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionService)(nil).Update), ctx, request)
+}
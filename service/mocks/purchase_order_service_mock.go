@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/purchase_order_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchaseOrderService is a mock of PurchaseOrderService interface.
+type MockPurchaseOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseOrderServiceMockRecorder
+}
+
+// MockPurchaseOrderServiceMockRecorder is the mock recorder for MockPurchaseOrderService.
+type MockPurchaseOrderServiceMockRecorder struct {
+	mock *MockPurchaseOrderService
+}
+
+// NewMockPurchaseOrderService creates a new mock instance.
+func NewMockPurchaseOrderService(ctrl *gomock.Controller) *MockPurchaseOrderService {
+	mock := &MockPurchaseOrderService{ctrl: ctrl}
+	mock.recorder = &MockPurchaseOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseOrderService) EXPECT() *MockPurchaseOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPurchaseOrderService) Create(ctx context.Context, request web.PurchaseOrderCreateRequest) (web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPurchaseOrderServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPurchaseOrderService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockPurchaseOrderService) Delete(ctx context.Context, purchaseOrderId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, purchaseOrderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPurchaseOrderServiceMockRecorder) Delete(ctx, purchaseOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPurchaseOrderService)(nil).Delete), ctx, purchaseOrderId)
+}
+
+// FindAll mocks base method.
+func (m *MockPurchaseOrderService) FindAll(ctx context.Context, storeId uint64) ([]web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPurchaseOrderServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPurchaseOrderService)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockPurchaseOrderService) FindById(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, purchaseOrderId)
+	ret0, _ := ret[0].(web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPurchaseOrderServiceMockRecorder) FindById(ctx, purchaseOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPurchaseOrderService)(nil).FindById), ctx, purchaseOrderId)
+}
+
+// Receive mocks base method.
+func (m *MockPurchaseOrderService) Receive(ctx context.Context, request web.PurchaseOrderReceiveRequest) (web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, request)
+	ret0, _ := ret[0].(web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockPurchaseOrderServiceMockRecorder) Receive(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockPurchaseOrderService)(nil).Receive), ctx, request)
+}
+
+// Send mocks base method.
+func (m *MockPurchaseOrderService) Send(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, purchaseOrderId)
+	ret0, _ := ret[0].(web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPurchaseOrderServiceMockRecorder) Send(ctx, purchaseOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPurchaseOrderService)(nil).Send), ctx, purchaseOrderId)
+}
+
+// Update mocks base method.
+func (m *MockPurchaseOrderService) Update(ctx context.Context, request web.PurchaseOrderUpdateRequest) (web.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPurchaseOrderServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPurchaseOrderService)(nil).Update), ctx, request)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/receipt_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptService is a mock of ReceiptService interface.
+type MockReceiptService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptServiceMockRecorder
+}
+
+// MockReceiptServiceMockRecorder is the mock recorder for MockReceiptService.
+type MockReceiptServiceMockRecorder struct {
+	mock *MockReceiptService
+}
+
+// NewMockReceiptService creates a new mock instance.
+func NewMockReceiptService(ctrl *gomock.Controller) *MockReceiptService {
+	mock := &MockReceiptService{ctrl: ctrl}
+	mock.recorder = &MockReceiptServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptService) EXPECT() *MockReceiptServiceMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockReceiptService) FindById(ctx context.Context, receiptId uint64) (web.ReceiptResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, receiptId)
+	ret0, _ := ret[0].(web.ReceiptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReceiptServiceMockRecorder) FindById(ctx, receiptId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReceiptService)(nil).FindById), ctx, receiptId)
+}
+
+// FindByOrderId mocks base method.
+func (m *MockReceiptService) FindByOrderId(ctx context.Context, orderId uint64) (web.ReceiptResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderId", ctx, orderId)
+	ret0, _ := ret[0].(web.ReceiptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderId indicates an expected call of FindByOrderId.
+func (mr *MockReceiptServiceMockRecorder) FindByOrderId(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderId", reflect.TypeOf((*MockReceiptService)(nil).FindByOrderId), ctx, orderId)
+}
+
+// Issue mocks base method.
+func (m *MockReceiptService) Issue(ctx context.Context, orderId uint64) (web.ReceiptResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, orderId)
+	ret0, _ := ret[0].(web.ReceiptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockReceiptServiceMockRecorder) Issue(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockReceiptService)(nil).Issue), ctx, orderId)
+}
+
+// Print mocks base method.
+func (m *MockReceiptService) Print(ctx context.Context, receiptId uint64, format, profileName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", ctx, receiptId, format, profileName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Print indicates an expected call of Print.
+func (mr *MockReceiptServiceMockRecorder) Print(ctx, receiptId, format, profileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockReceiptService)(nil).Print), ctx, receiptId, format, profileName)
+}
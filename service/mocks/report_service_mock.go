@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/report_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// SalesReport mocks base method.
+func (m *MockReportService) SalesReport(ctx context.Context, request web.SalesReportRequest) (web.SalesReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesReport", ctx, request)
+	ret0, _ := ret[0].(web.SalesReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesReport indicates an expected call of SalesReport.
+func (mr *MockReportServiceMockRecorder) SalesReport(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesReport", reflect.TypeOf((*MockReportService)(nil).SalesReport), ctx, request)
+}
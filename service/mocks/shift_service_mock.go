@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/shift_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShiftService is a mock of ShiftService interface.
+type MockShiftService struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftServiceMockRecorder
+}
+
+// MockShiftServiceMockRecorder is the mock recorder for MockShiftService.
+type MockShiftServiceMockRecorder struct {
+	mock *MockShiftService
+}
+
+// NewMockShiftService creates a new mock instance.
+func NewMockShiftService(ctrl *gomock.Controller) *MockShiftService {
+	mock := &MockShiftService{ctrl: ctrl}
+	mock.recorder = &MockShiftServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftService) EXPECT() *MockShiftServiceMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShiftService) Close(ctx context.Context, request web.ShiftCloseRequest) (web.ShiftReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, request)
+	ret0, _ := ret[0].(web.ShiftReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShiftServiceMockRecorder) Close(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShiftService)(nil).Close), ctx, request)
+}
+
+// FindAll mocks base method.
+func (m *MockShiftService) FindAll(ctx context.Context, storeId uint64) ([]web.ShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]web.ShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockShiftServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockShiftService)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockShiftService) FindById(ctx context.Context, shiftId uint64) (web.ShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, shiftId)
+	ret0, _ := ret[0].(web.ShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockShiftServiceMockRecorder) FindById(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockShiftService)(nil).FindById), ctx, shiftId)
+}
+
+// FindReport mocks base method.
+func (m *MockShiftService) FindReport(ctx context.Context, shiftId uint64) (web.ShiftReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReport", ctx, shiftId)
+	ret0, _ := ret[0].(web.ShiftReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReport indicates an expected call of FindReport.
+func (mr *MockShiftServiceMockRecorder) FindReport(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReport", reflect.TypeOf((*MockShiftService)(nil).FindReport), ctx, shiftId)
+}
+
+// LockOpenShift mocks base method.
+func (m *MockShiftService) LockOpenShift(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOpenShift", ctx, shiftId)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOpenShift indicates an expected call of LockOpenShift.
+func (mr *MockShiftServiceMockRecorder) LockOpenShift(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOpenShift", reflect.TypeOf((*MockShiftService)(nil).LockOpenShift), ctx, shiftId)
+}
+
+// LockRefundShift mocks base method.
+func (m *MockShiftService) LockRefundShift(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockRefundShift", ctx, shiftId)
+	ret0, _ := ret[0].(domain.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockRefundShift indicates an expected call of LockRefundShift.
+func (mr *MockShiftServiceMockRecorder) LockRefundShift(ctx, shiftId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRefundShift", reflect.TypeOf((*MockShiftService)(nil).LockRefundShift), ctx, shiftId)
+}
+
+// Open mocks base method.
+func (m *MockShiftService) Open(ctx context.Context, request web.ShiftOpenRequest) (web.ShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, request)
+	ret0, _ := ret[0].(web.ShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockShiftServiceMockRecorder) Open(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockShiftService)(nil).Open), ctx, request)
+}
+
+// RecordCashMovement mocks base method.
+func (m *MockShiftService) RecordCashMovement(ctx context.Context, request web.CashMovementRequest) (web.ShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCashMovement", ctx, request)
+	ret0, _ := ret[0].(web.ShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordCashMovement indicates an expected call of RecordCashMovement.
+func (mr *MockShiftServiceMockRecorder) RecordCashMovement(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCashMovement", reflect.TypeOf((*MockShiftService)(nil).RecordCashMovement), ctx, request)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/stock_transfer_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStockTransferService is a mock of StockTransferService interface.
+type MockStockTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockTransferServiceMockRecorder
+}
+
+// MockStockTransferServiceMockRecorder is the mock recorder for MockStockTransferService.
+type MockStockTransferServiceMockRecorder struct {
+	mock *MockStockTransferService
+}
+
+// NewMockStockTransferService creates a new mock instance.
+func NewMockStockTransferService(ctrl *gomock.Controller) *MockStockTransferService {
+	mock := &MockStockTransferService{ctrl: ctrl}
+	mock.recorder = &MockStockTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockTransferService) EXPECT() *MockStockTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStockTransferService) Create(ctx context.Context, request web.StockTransferCreateRequest) (web.StockTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.StockTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStockTransferServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStockTransferService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockStockTransferService) Delete(ctx context.Context, stockTransferId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, stockTransferId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStockTransferServiceMockRecorder) Delete(ctx, stockTransferId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStockTransferService)(nil).Delete), ctx, stockTransferId)
+}
+
+// FindAll mocks base method.
+func (m *MockStockTransferService) FindAll(ctx context.Context, storeId uint64) ([]web.StockTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]web.StockTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStockTransferServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStockTransferService)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockStockTransferService) FindById(ctx context.Context, stockTransferId uint64) (web.StockTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, stockTransferId)
+	ret0, _ := ret[0].(web.StockTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStockTransferServiceMockRecorder) FindById(ctx, stockTransferId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStockTransferService)(nil).FindById), ctx, stockTransferId)
+}
+
+// Receive mocks base method.
+func (m *MockStockTransferService) Receive(ctx context.Context, request web.StockTransferReceiveRequest) (web.StockTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, request)
+	ret0, _ := ret[0].(web.StockTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockStockTransferServiceMockRecorder) Receive(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockStockTransferService)(nil).Receive), ctx, request)
+}
+
+// Ship mocks base method.
+func (m *MockStockTransferService) Ship(ctx context.Context, request web.StockTransferShipRequest) (web.StockTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ship", ctx, request)
+	ret0, _ := ret[0].(web.StockTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ship indicates an expected call of Ship.
+func (mr *MockStockTransferServiceMockRecorder) Ship(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ship", reflect.TypeOf((*MockStockTransferService)(nil).Ship), ctx, request)
+}
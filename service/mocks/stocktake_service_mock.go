@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/stocktake_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStocktakeService is a mock of StocktakeService interface.
+type MockStocktakeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStocktakeServiceMockRecorder
+}
+
+// MockStocktakeServiceMockRecorder is the mock recorder for MockStocktakeService.
+type MockStocktakeServiceMockRecorder struct {
+	mock *MockStocktakeService
+}
+
+// NewMockStocktakeService creates a new mock instance.
+func NewMockStocktakeService(ctrl *gomock.Controller) *MockStocktakeService {
+	mock := &MockStocktakeService{ctrl: ctrl}
+	mock.recorder = &MockStocktakeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStocktakeService) EXPECT() *MockStocktakeServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockStocktakeService) Cancel(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, stocktakeId)
+	ret0, _ := ret[0].(web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStocktakeServiceMockRecorder) Cancel(ctx, stocktakeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStocktakeService)(nil).Cancel), ctx, stocktakeId)
+}
+
+// Commit mocks base method.
+func (m *MockStocktakeService) Commit(ctx context.Context, request web.StocktakeCommitRequest) (web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", ctx, request)
+	ret0, _ := ret[0].(web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockStocktakeServiceMockRecorder) Commit(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStocktakeService)(nil).Commit), ctx, request)
+}
+
+// Create mocks base method.
+func (m *MockStocktakeService) Create(ctx context.Context, request web.StocktakeCreateRequest) (web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStocktakeServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStocktakeService)(nil).Create), ctx, request)
+}
+
+// FindAll mocks base method.
+func (m *MockStocktakeService) FindAll(ctx context.Context, storeId uint64) ([]web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, storeId)
+	ret0, _ := ret[0].([]web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStocktakeServiceMockRecorder) FindAll(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStocktakeService)(nil).FindAll), ctx, storeId)
+}
+
+// FindById mocks base method.
+func (m *MockStocktakeService) FindById(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, stocktakeId)
+	ret0, _ := ret[0].(web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStocktakeServiceMockRecorder) FindById(ctx, stocktakeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStocktakeService)(nil).FindById), ctx, stocktakeId)
+}
+
+// FindVariances mocks base method.
+func (m *MockStocktakeService) FindVariances(ctx context.Context, stocktakeId uint64) (web.StocktakeVarianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVariances", ctx, stocktakeId)
+	ret0, _ := ret[0].(web.StocktakeVarianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVariances indicates an expected call of FindVariances.
+func (mr *MockStocktakeServiceMockRecorder) FindVariances(ctx, stocktakeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVariances", reflect.TypeOf((*MockStocktakeService)(nil).FindVariances), ctx, stocktakeId)
+}
+
+// RecordCounts mocks base method.
+func (m *MockStocktakeService) RecordCounts(ctx context.Context, request web.StocktakeCountRequest) (web.StocktakeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCounts", ctx, request)
+	ret0, _ := ret[0].(web.StocktakeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordCounts indicates an expected call of RecordCounts.
+func (mr *MockStocktakeServiceMockRecorder) RecordCounts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCounts", reflect.TypeOf((*MockStocktakeService)(nil).RecordCounts), ctx, request)
+}
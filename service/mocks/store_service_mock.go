@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/store_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStoreService is a mock of StoreService interface.
+type MockStoreService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreServiceMockRecorder
+}
+
+// MockStoreServiceMockRecorder is the mock recorder for MockStoreService.
+type MockStoreServiceMockRecorder struct {
+	mock *MockStoreService
+}
+
+// NewMockStoreService creates a new mock instance.
+func NewMockStoreService(ctrl *gomock.Controller) *MockStoreService {
+	mock := &MockStoreService{ctrl: ctrl}
+	mock.recorder = &MockStoreServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreService) EXPECT() *MockStoreServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStoreService) Create(ctx context.Context, request web.StoreCreateRequest) (web.StoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.StoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStoreServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStoreService)(nil).Create), ctx, request)
+}
+
+// FindActive mocks base method.
+func (m *MockStoreService) FindActive(ctx context.Context, storeId uint64) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockStoreServiceMockRecorder) FindActive(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockStoreService)(nil).FindActive), ctx, storeId)
+}
+
+// FindAll mocks base method.
+func (m *MockStoreService) FindAll(ctx context.Context) ([]web.StoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.StoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStoreServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStoreService)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockStoreService) FindById(ctx context.Context, storeId uint64) (web.StoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, storeId)
+	ret0, _ := ret[0].(web.StoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockStoreServiceMockRecorder) FindById(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockStoreService)(nil).FindById), ctx, storeId)
+}
+
+// LockActive mocks base method.
+func (m *MockStoreService) LockActive(ctx context.Context, storeId uint64) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockActive", ctx, storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockActive indicates an expected call of LockActive.
+func (mr *MockStoreServiceMockRecorder) LockActive(ctx, storeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockActive", reflect.TypeOf((*MockStoreService)(nil).LockActive), ctx, storeId)
+}
+
+// SetPrice mocks base method.
+func (m *MockStoreService) SetPrice(ctx context.Context, request web.StorePriceRequest) (web.StoreProductResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrice", ctx, request)
+	ret0, _ := ret[0].(web.StoreProductResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrice indicates an expected call of SetPrice.
+func (mr *MockStoreServiceMockRecorder) SetPrice(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrice", reflect.TypeOf((*MockStoreService)(nil).SetPrice), ctx, request)
+}
+
+// Update mocks base method.
+func (m *MockStoreService) Update(ctx context.Context, request web.StoreUpdateRequest) (web.StoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.StoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStoreServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStoreService)(nil).Update), ctx, request)
+}
@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/tax_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Kahffi/go-rest-api-test/model/domain"
+	web "github.com/Kahffi/go-rest-api-test/model/web"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxService is a mock of TaxService interface.
+type MockTaxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxServiceMockRecorder
+}
+
+// MockTaxServiceMockRecorder is the mock recorder for MockTaxService.
+type MockTaxServiceMockRecorder struct {
+	mock *MockTaxService
+}
+
+// NewMockTaxService creates a new mock instance.
+func NewMockTaxService(ctrl *gomock.Controller) *MockTaxService {
+	mock := &MockTaxService{ctrl: ctrl}
+	mock.recorder = &MockTaxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxService) EXPECT() *MockTaxServiceMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockTaxService) Calculate(lines []domain.TaxLine, pricesIncludeTax bool) domain.TaxCalculation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", lines, pricesIncludeTax)
+	ret0, _ := ret[0].(domain.TaxCalculation)
+	return ret0
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockTaxServiceMockRecorder) Calculate(lines, pricesIncludeTax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockTaxService)(nil).Calculate), lines, pricesIncludeTax)
+}
+
+// ConfigureMoney mocks base method.
+func (m *MockTaxService) ConfigureMoney(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureMoney", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigureMoney indicates an expected call of ConfigureMoney.
+func (mr *MockTaxServiceMockRecorder) ConfigureMoney(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureMoney", reflect.TypeOf((*MockTaxService)(nil).ConfigureMoney), ctx)
+}
+
+// Create mocks base method.
+func (m *MockTaxService) Create(ctx context.Context, request web.TaxCreateRequest) (web.TaxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(web.TaxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaxServiceMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaxService)(nil).Create), ctx, request)
+}
+
+// Delete mocks base method.
+func (m *MockTaxService) Delete(ctx context.Context, taxId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, taxId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxServiceMockRecorder) Delete(ctx, taxId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxService)(nil).Delete), ctx, taxId)
+}
+
+// FindAll mocks base method.
+func (m *MockTaxService) FindAll(ctx context.Context) ([]web.TaxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]web.TaxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTaxServiceMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTaxService)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockTaxService) FindById(ctx context.Context, taxId uint64) (web.TaxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, taxId)
+	ret0, _ := ret[0].(web.TaxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTaxServiceMockRecorder) FindById(ctx, taxId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTaxService)(nil).FindById), ctx, taxId)
+}
+
+// FindMoneySettings mocks base method.
+func (m *MockTaxService) FindMoneySettings(ctx context.Context) (web.MoneySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoneySettings", ctx)
+	ret0, _ := ret[0].(web.MoneySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMoneySettings indicates an expected call of FindMoneySettings.
+func (mr *MockTaxServiceMockRecorder) FindMoneySettings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoneySettings", reflect.TypeOf((*MockTaxService)(nil).FindMoneySettings), ctx)
+}
+
+// FindSettings mocks base method.
+func (m *MockTaxService) FindSettings(ctx context.Context) (web.TaxSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettings", ctx)
+	ret0, _ := ret[0].(web.TaxSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSettings indicates an expected call of FindSettings.
+func (mr *MockTaxServiceMockRecorder) FindSettings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettings", reflect.TypeOf((*MockTaxService)(nil).FindSettings), ctx)
+}
+
+// PricesIncludeTax mocks base method.
+func (m *MockTaxService) PricesIncludeTax(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PricesIncludeTax", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PricesIncludeTax indicates an expected call of PricesIncludeTax.
+func (mr *MockTaxServiceMockRecorder) PricesIncludeTax(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PricesIncludeTax", reflect.TypeOf((*MockTaxService)(nil).PricesIncludeTax), ctx)
+}
+
+// Update mocks base method.
+func (m *MockTaxService) Update(ctx context.Context, request web.TaxUpdateRequest) (web.TaxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(web.TaxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxServiceMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxService)(nil).Update), ctx, request)
+}
+
+// UpdateMoneySettings mocks base method.
+func (m *MockTaxService) UpdateMoneySettings(ctx context.Context, request web.MoneySettingsUpdateRequest) (web.MoneySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMoneySettings", ctx, request)
+	ret0, _ := ret[0].(web.MoneySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMoneySettings indicates an expected call of UpdateMoneySettings.
+func (mr *MockTaxServiceMockRecorder) UpdateMoneySettings(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMoneySettings", reflect.TypeOf((*MockTaxService)(nil).UpdateMoneySettings), ctx, request)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockTaxService) UpdateSettings(ctx context.Context, request web.TaxSettingsUpdateRequest) (web.TaxSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, request)
+	ret0, _ := ret[0].(web.TaxSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockTaxServiceMockRecorder) UpdateSettings(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockTaxService)(nil).UpdateSettings), ctx, request)
+}
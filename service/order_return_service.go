@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type OrderReturnService interface {
+	Create(ctx context.Context, request web.OrderReturnCreateRequest) (web.OrderReturnResponse, error)
+	FindByOrderId(ctx context.Context, orderId uint64) ([]web.OrderReturnResponse, error)
+}
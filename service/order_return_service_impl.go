@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type OrderReturnServiceImpl struct {
+	TxManager             repository.TxManager
+	OrderReturnRepository repository.OrderReturnRepository
+	OrderRepository       repository.OrderRepository
+	PaymentRepository     repository.PaymentRepository
+	ProductRepository     repository.ProductRepository
+	EmployeeRepository    repository.EmployeeRepository
+	LoyaltyService        LoyaltyService
+	GiftCardService       GiftCardService
+	ShiftService          ShiftService
+	Validate              *validator.Validate
+}
+
+func NewOrderReturnService(txManager repository.TxManager, orderReturnRepository repository.OrderReturnRepository,
+	orderRepository repository.OrderRepository, paymentRepository repository.PaymentRepository,
+	productRepository repository.ProductRepository, employeeRepository repository.EmployeeRepository,
+	loyaltyService LoyaltyService, giftCardService GiftCardService, shiftService ShiftService,
+	validate *validator.Validate) OrderReturnService {
+	return &OrderReturnServiceImpl{
+		TxManager:             txManager,
+		OrderReturnRepository: orderReturnRepository,
+		OrderRepository:       orderRepository,
+		PaymentRepository:     paymentRepository,
+		ProductRepository:     productRepository,
+		EmployeeRepository:    employeeRepository,
+		LoyaltyService:        loyaltyService,
+		GiftCardService:       giftCardService,
+		ShiftService:          shiftService,
+		Validate:              validate,
+	}
+}
+
+// Create takes units of a placed order back. Each returned line is refunded its share of what the
+// customer paid for the order line, so discounts and promotions stay with the units kept and tax is
+// refunded at the rate it was charged. The refund is recorded as a payment in Refunded state and the
+// loyalty points the refunded amount earned are taken back. A refund paid as store credit issues the
+// customer a new store credit card, a refund paid on a shift comes out of that register's drawer.
+func (service *OrderReturnServiceImpl) Create(ctx context.Context, request web.OrderReturnCreateRequest) (web.OrderReturnResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.OrderReturnResponse{}, err
+	}
+	if request.EmployeeID != nil {
+		_, err := service.EmployeeRepository.FindById(ctx, *request.EmployeeID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.OrderReturnResponse{}, exception.NewNotFoundError("Employee not found")
+		} else if err != nil {
+			return web.OrderReturnResponse{}, err
+		}
+	}
+
+	var savedReturn domain.OrderReturn
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The order row lock keeps two returns of the same units from both passing the quantity check
+		order, err := service.OrderRepository.FindByIdForUpdate(ctx, request.OrderID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Order not found")
+		} else if err != nil {
+			return err
+		}
+		if order.Status != domain.OrderStatusPlaced {
+			return exception.NewConflictError(fmt.Sprintf("Order cannot take returns while %s", order.Status))
+		}
+
+		previousReturns, err := service.OrderReturnRepository.FindByOrderId(ctx, order.OrderID)
+		if err != nil {
+			return err
+		}
+		returnedQty := make(map[uint64]int)
+		var refunded money.Money
+		for _, previous := range previousReturns {
+			refunded += previous.RefundAmount
+			for _, line := range previous.Lines {
+				returnedQty[line.OrderItemID] += line.Quantity
+			}
+		}
+
+		itemIndex := make(map[uint64]int, len(order.OrderItems))
+		for i, item := range order.OrderItems {
+			itemIndex[item.OrderItemID] = i
+		}
+
+		orderReturn := domain.OrderReturn{
+			OrderID:    order.OrderID,
+			EmployeeID: request.EmployeeID,
+			Reason:     request.Reason,
+			Restocked:  request.Restock,
+		}
+		seen := make(map[uint64]bool, len(request.Lines))
+		for _, requested := range request.Lines {
+			i, ok := itemIndex[requested.OrderItemID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Order item %d is not on order #%d", requested.OrderItemID, order.OrderID))
+			}
+			if seen[requested.OrderItemID] {
+				return exception.NewBadRequestError(fmt.Sprintf("Order item %d is returned more than once", requested.OrderItemID))
+			}
+			seen[requested.OrderItemID] = true
+
+			item := order.OrderItems[i]
+			returnable := item.Quantity - returnedQty[item.OrderItemID]
+			if requested.Quantity > returnable {
+				return exception.NewBadRequestError(fmt.Sprintf("Only %d unit(s) of order item %d can still be returned", returnable, item.OrderItemID))
+			}
+
+			line := returnLine(item, returnedQty[item.OrderItemID], requested.Quantity, order.PricesIncludeTax)
+			orderReturn.Lines = append(orderReturn.Lines, line)
+			orderReturn.Subtotal += line.Subtotal
+			orderReturn.Discount += line.Discount
+			orderReturn.TaxAmount += line.TaxAmount
+			orderReturn.RefundAmount += line.RefundAmount
+		}
+		refundable := order.AmountPaid() - refunded
+		if orderReturn.RefundAmount > refundable {
+			return exception.NewConflictError(fmt.Sprintf("Refund of %s exceeds the %s still refundable on the order", orderReturn.RefundAmount, refundable))
+		}
+
+		refund := domain.Payment{
+			OrderID:        order.OrderID,
+			Amount:         orderReturn.RefundAmount,
+			AmountTendered: orderReturn.RefundAmount,
+			PaymentType:    request.PaymentType,
+			PaymentDate:    time.Now(),
+			Status:         domain.PaymentStatusRefunded,
+			ShiftID:        request.ShiftID,
+		}
+		if request.ShiftID != nil {
+			shift, err := service.ShiftService.LockOpenShift(ctx, *request.ShiftID)
+			if err != nil {
+				return err
+			}
+			if shift.StoreID != order.StoreID {
+				return exception.NewBadRequestError(fmt.Sprintf("Shift #%d is not at the store of order #%d", shift.ShiftID, order.OrderID))
+			}
+		}
+		if refund.PaymentType == domain.PaymentTypeStoreCredit {
+			card, err := service.GiftCardService.IssueStoreCredit(ctx, order, orderReturn.RefundAmount)
+			if err != nil {
+				return err
+			}
+			refund.GiftCardID, refund.GiftCard = &card.GiftCardID, &card
+		}
+		refund, err = service.PaymentRepository.Save(ctx, refund)
+		if err != nil {
+			return err
+		}
+
+		orderReturn.PaymentID = refund.PaymentID
+		savedReturn, err = service.OrderReturnRepository.Save(ctx, orderReturn)
+		if err != nil {
+			return err
+		}
+		savedReturn.Payment = refund
+
+		if err := service.LoyaltyService.ClawBack(ctx, order, savedReturn.RefundAmount); err != nil {
+			return err
+		}
+
+		for i, line := range savedReturn.Lines {
+			savedReturn.Lines[i].Product = order.OrderItems[itemIndex[line.OrderItemID]].Product
+			if !request.Restock {
+				continue
+			}
+			_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+				ProductID:  line.ProductID,
+				StoreID:    order.StoreID,
+				Delta:      line.Quantity,
+				Reason:     domain.StockReasonReturn,
+				Reference:  fmt.Sprintf("Return #%d of order #%d", savedReturn.OrderReturnID, order.OrderID),
+				EmployeeID: request.EmployeeID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return web.OrderReturnResponse{}, err
+	}
+
+	return helper.ToOrderReturnResponse(savedReturn), nil
+}
+
+// Find Returns By Order ID
+func (service *OrderReturnServiceImpl) FindByOrderId(ctx context.Context, orderId uint64) ([]web.OrderReturnResponse, error) {
+	_, err := service.OrderRepository.FindById(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, exception.NewNotFoundError("Order not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	orderReturns, err := service.OrderReturnRepository.FindByOrderId(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToOrderReturnResponses(orderReturns), nil
+}
+
+// returnLine works out the share of the order item for quantity units when returned units came back
+// before. Shares are rounded cumulatively, so once every unit is back the returns add up to the item exactly.
+func returnLine(item domain.OrderItem, returned int, quantity int, pricesIncludeTax bool) domain.OrderReturnLine {
+	share := func(amount money.Money) money.Money {
+		upTo := func(units int) money.Money {
+			if units >= item.Quantity {
+				return amount
+			}
+			return amount.Share(int64(units), int64(item.Quantity))
+		}
+		return upTo(returned+quantity) - upTo(returned)
+	}
+
+	line := domain.OrderReturnLine{
+		OrderItemID: item.OrderItemID,
+		ProductID:   item.ProductID,
+		Quantity:    quantity,
+		Subtotal:    share(item.TotalPrice),
+		Discount:    share(item.Reductions()),
+		TaxAmount:   share(item.TaxAmount),
+	}
+	line.RefundAmount = line.Subtotal - line.Discount
+	if !pricesIncludeTax {
+		line.RefundAmount += line.TaxAmount
+	}
+	return line
+}
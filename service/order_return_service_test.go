@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// returnableOrder is a paid order of three units at 10000 with 10% off and 10% tax on top
+var returnableOrder = domain.Order{
+	OrderID:     7,
+	StoreID:     2,
+	Status:      domain.OrderStatusPlaced,
+	Subtotal:    money.New(30000),
+	Discount:    money.New(3000),
+	TaxAmount:   money.New(2700),
+	TotalAmount: money.New(29700),
+	OrderItems: []domain.OrderItem{
+		{OrderItemID: 11, OrderID: 7, ProductID: 1, Quantity: 3, UnitPrice: money.New(10000), TotalPrice: money.New(30000), DiscountAmount: money.New(3000), TaxAmount: money.New(2700), Product: productModelTpl},
+	},
+	Payments: []domain.Payment{
+		{PaymentID: 1, OrderID: 7, Amount: money.New(29700), Status: domain.PaymentStatusCompleted},
+	},
+}
+
+func TestCreateOrderReturn(t *testing.T) {
+	returnOne := web.OrderReturnCreateRequest{OrderID: 7, PaymentType: domain.PaymentTypeCash, Restock: true, Lines: []web.OrderReturnLineRequest{
+		{OrderItemID: 11, Quantity: 1},
+	}}
+	returnTwo := web.OrderReturnCreateRequest{OrderID: 7, PaymentType: domain.PaymentTypeCash, Lines: []web.OrderReturnLineRequest{
+		{OrderItemID: 11, Quantity: 2},
+	}}
+	previousReturn := domain.OrderReturn{OrderReturnID: 1, OrderID: 7, RefundAmount: money.New(9900), Lines: []domain.OrderReturnLine{
+		{OrderItemID: 11, ProductID: 1, Quantity: 1},
+	}}
+
+	tests := []struct {
+		name     string
+		input    web.OrderReturnCreateRequest
+		order    func() domain.Order
+		previous []domain.OrderReturn
+		mock     func(orderReturnRepo *mocks.MockOrderReturnRepository, paymentRepo *mocks.MockPaymentRepository, productRepo *mocks.MockProductRepository)
+		expect   domain.OrderReturnLine
+		err      error
+	}{
+		{
+			name:  "Discounted line refunds its share and restocks",
+			input: returnOne,
+			mock: func(orderReturnRepo *mocks.MockOrderReturnRepository, paymentRepo *mocks.MockPaymentRepository, productRepo *mocks.MockProductRepository) {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+					assert.Equal(t, domain.PaymentStatusRefunded, payment.Status)
+					assert.Equal(t, money.New(9900), payment.Amount)
+					payment.PaymentID = 2
+					return payment, nil
+				})
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{
+					ProductID: 1, StoreID: 2, Delta: 1, Reason: domain.StockReasonReturn, Reference: "Return #3 of order #7",
+				}).Return(domain.StockMovement{}, nil)
+			},
+			expect: domain.OrderReturnLine{OrderItemID: 11, ProductID: 1, Quantity: 1, Subtotal: money.New(10000), Discount: money.New(1000), TaxAmount: money.New(900), RefundAmount: money.New(9900)},
+		},
+		{
+			name:     "Last units refund the rest of the line",
+			input:    returnTwo,
+			previous: []domain.OrderReturn{previousReturn},
+			order: func() domain.Order {
+				order := returnableOrder
+				order.OrderItems = []domain.OrderItem{
+					{OrderItemID: 11, OrderID: 7, ProductID: 1, Quantity: 3, UnitPrice: money.Money(333334), TotalPrice: money.New(10000), TaxAmount: money.New(1000)},
+				}
+				return order
+			},
+			mock: func(orderReturnRepo *mocks.MockOrderReturnRepository, paymentRepo *mocks.MockPaymentRepository, productRepo *mocks.MockProductRepository) {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+					return payment, nil
+				})
+			},
+			expect: domain.OrderReturnLine{OrderItemID: 11, ProductID: 1, Quantity: 2, Subtotal: money.Money(666667), TaxAmount: money.Money(66667), RefundAmount: money.Money(733334)},
+		},
+		{
+			name:     "More than is left to return",
+			input:    returnTwo,
+			previous: []domain.OrderReturn{previousReturn, previousReturn},
+			mock: func(orderReturnRepo *mocks.MockOrderReturnRepository, paymentRepo *mocks.MockPaymentRepository, productRepo *mocks.MockProductRepository) {
+			},
+			err: exception.NewBadRequestError("Only 1 unit(s) of order item 11 can still be returned"),
+		},
+		{
+			name:  "Unpaid order",
+			input: returnOne,
+			order: func() domain.Order {
+				order := returnableOrder
+				order.Payments = nil
+				return order
+			},
+			mock: func(orderReturnRepo *mocks.MockOrderReturnRepository, paymentRepo *mocks.MockPaymentRepository, productRepo *mocks.MockProductRepository) {
+			},
+			err: exception.NewConflictError("Refund of 9900.00 exceeds the 0.00 still refundable on the order"),
+		},
+		{
+			name:  "Open order",
+			input: returnOne,
+			order: func() domain.Order {
+				order := returnableOrder
+				order.Status = domain.OrderStatusOpen
+				return order
+			},
+			err: exception.NewConflictError("Order cannot take returns while Open"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderReturnRepo := mocks.NewMockOrderReturnRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+
+			order := returnableOrder
+			if tt.order != nil {
+				order = tt.order()
+			}
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(7)).Return(order, nil)
+			if tt.mock != nil {
+				orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(7)).Return(tt.previous, nil)
+				tt.mock(orderReturnRepo, paymentRepo, productRepo)
+			}
+			if tt.err == nil {
+				orderReturnRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, orderReturn domain.OrderReturn) (domain.OrderReturn, error) {
+						orderReturn.OrderReturnID = 3
+						return orderReturn, nil
+					})
+				loyaltyService.EXPECT().ClawBack(gomock.Any(), order, tt.expect.RefundAmount).Return(nil)
+			}
+
+			service := NewOrderReturnService(newTxManagerMock(ctrl), orderReturnRepo, orderRepo, paymentRepo, productRepo, nil, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+				servicemocks.NewMockShiftService(ctrl), validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				line := result.Lines[0]
+				assert.Equal(t, tt.expect.Quantity, line.Quantity)
+				assert.Equal(t, tt.expect.Subtotal, line.Subtotal)
+				assert.Equal(t, tt.expect.Discount, line.Discount)
+				assert.Equal(t, tt.expect.TaxAmount, line.TaxAmount)
+				assert.Equal(t, tt.expect.RefundAmount, line.RefundAmount)
+				assert.Equal(t, tt.expect.RefundAmount, result.RefundAmount)
+				assert.Equal(t, domain.PaymentStatusRefunded, result.Refund.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type OrderService interface {
+	Create(ctx context.Context, request web.OrderCreateRequest) (web.OrderResponse, error)
+	Checkout(ctx context.Context, orderId uint64) (web.OrderResponse, error)
+	Cancel(ctx context.Context, orderId uint64) (web.OrderResponse, error)
+	FindById(ctx context.Context, orderId uint64) (web.OrderResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.OrderResponse, error)
+}
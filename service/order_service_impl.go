@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type OrderServiceImpl struct {
+	TxManager             repository.TxManager
+	OrderRepository       repository.OrderRepository
+	OrderReturnRepository repository.OrderReturnRepository
+	ProductRepository     repository.ProductRepository
+	CustomerRepository    repository.CustomerRepository
+	EmployeeRepository    repository.EmployeeRepository
+	StoreService          StoreService
+	DiscountService       DiscountService
+	PromotionService      PromotionService
+	TaxService            TaxService
+	ExchangeRateService   ExchangeRateService
+	Validate              *validator.Validate
+}
+
+func NewOrderService(txManager repository.TxManager, orderRepository repository.OrderRepository,
+	orderReturnRepository repository.OrderReturnRepository, productRepository repository.ProductRepository, customerRepository repository.CustomerRepository, employeeRepository repository.EmployeeRepository,
+	storeService StoreService, discountService DiscountService, promotionService PromotionService, taxService TaxService,
+	exchangeRateService ExchangeRateService, validate *validator.Validate) OrderService {
+	return &OrderServiceImpl{
+		TxManager:             txManager,
+		OrderRepository:       orderRepository,
+		OrderReturnRepository: orderReturnRepository,
+		ProductRepository:     productRepository,
+		CustomerRepository:    customerRepository,
+		EmployeeRepository:    employeeRepository,
+		StoreService:          storeService,
+		DiscountService:       discountService,
+		PromotionService:      promotionService,
+		TaxService:            taxService,
+		ExchangeRateService:   exchangeRateService,
+		Validate:              validate,
+	}
+}
+
+// Create Order at a store, pricing every line from the price the store currently sells the product at.
+// Discounts shown here are a preview, they are applied again at checkout.
+func (service *OrderServiceImpl) Create(ctx context.Context, request web.OrderCreateRequest) (web.OrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	customer, err := service.CustomerRepository.FindById(ctx, request.CustomerID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.OrderResponse{}, exception.NewNotFoundError("Customer not found")
+	} else if err != nil {
+		return web.OrderResponse{}, err
+	}
+	store, err := service.StoreService.FindActive(ctx, request.StoreID)
+	if err != nil {
+		return web.OrderResponse{}, err
+	}
+	if request.EmployeeID != nil {
+		employee, err := service.EmployeeRepository.FindById(ctx, *request.EmployeeID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.OrderResponse{}, exception.NewNotFoundError("Employee not found")
+		} else if err != nil {
+			return web.OrderResponse{}, err
+		}
+		if !employee.WorksAt(store.StoreID) {
+			return web.OrderResponse{}, exception.NewBadRequestError(fmt.Sprintf("Employee does not work at store %s", store.Code))
+		}
+	}
+
+	order := domain.Order{
+		CustomerID: request.CustomerID,
+		StoreID:    store.StoreID,
+		EmployeeID: request.EmployeeID,
+		OrderDate:  time.Now(),
+		Status:     domain.OrderStatusOpen,
+	}
+	if request.Currency != money.Currency() {
+		order.TenderCurrency = request.Currency
+	}
+
+	// Lines for the same product are merged so every product appears once per order, a line for a variant is
+	// one for the variant
+	lineIndex := make(map[uint64]int)
+	for _, item := range request.Items {
+		productId := item.ProductID
+		if item.VariantID != 0 {
+			productId = item.VariantID
+		}
+		if i, ok := lineIndex[productId]; ok {
+			order.OrderItems[i].Quantity += item.Quantity
+			continue
+		}
+
+		product, err := service.ProductRepository.FindById(ctx, productId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.OrderResponse{}, exception.NewNotFoundError(fmt.Sprintf("Product %d not found", productId))
+		} else if err != nil {
+			return web.OrderResponse{}, err
+		}
+		if item.VariantID != 0 && (product.ParentID == nil || *product.ParentID != item.ProductID) {
+			return web.OrderResponse{}, exception.NewBadRequestError(fmt.Sprintf("Product %d has no variant %d", item.ProductID, item.VariantID))
+		}
+		if product.HasVariants() {
+			return web.OrderResponse{}, exception.NewBadRequestError(fmt.Sprintf("Product %d has variants, order one of them", product.ProductID))
+		}
+		product = product.AtStore(store.StoreID)
+
+		lineIndex[productId] = len(order.OrderItems)
+		order.OrderItems = append(order.OrderItems, domain.OrderItem{
+			ProductID: product.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: product.Price,
+			TaxName:   product.Tax.Name,
+			TaxRate:   product.Tax.TaxRate,
+			Product:   product,
+		})
+	}
+
+	if err := service.priceOrder(ctx, &order, customer.LoyaltyTier, order.OrderDate); err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	savedOrder, err := service.OrderRepository.Save(ctx, order)
+	if err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	return helper.ToOrderResponse(savedOrder), nil
+}
+
+// priceOrder works out line totals, applies the promotions and then the discounts valid at the given time,
+// takes the discount of the loyalty tier, if any, off what is left and taxes the rest. With tax inclusive
+// prices the tax is part of the line totals and only reported. An order paid in a foreign currency takes
+// the exchange rate in effect at the same time.
+func (service *OrderServiceImpl) priceOrder(ctx context.Context, order *domain.Order, tier *domain.LoyaltyTier, at time.Time) error {
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		item.TotalPrice = item.UnitPrice.Times(item.Quantity)
+	}
+
+	if err := service.PromotionService.ApplyToOrder(ctx, order, at); err != nil {
+		return err
+	}
+	if err := service.DiscountService.ApplyToOrder(ctx, order, at); err != nil {
+		return err
+	}
+	applyTierDiscount(order, tier)
+
+	pricesIncludeTax, err := service.TaxService.PricesIncludeTax(ctx)
+	if err != nil {
+		return err
+	}
+
+	order.Subtotal, order.Discount = 0, 0
+	for _, item := range order.OrderItems {
+		order.Subtotal += item.TotalPrice
+		order.Discount += item.Reductions()
+	}
+
+	calculation := service.TaxService.Calculate(orderTaxLines(order.OrderItems), pricesIncludeTax)
+	for i := range order.OrderItems {
+		order.OrderItems[i].TaxAmount = calculation.Lines[i].Tax
+	}
+	order.PricesIncludeTax = pricesIncludeTax
+	order.Currency = money.Currency()
+	order.TaxAmount = calculation.Tax
+	order.TotalAmount = order.Subtotal - order.Discount
+	if !pricesIncludeTax {
+		order.TotalAmount += order.TaxAmount
+	}
+
+	order.ExchangeRate = 0
+	if order.TenderCurrency != "" {
+		order.ExchangeRate, err = service.ExchangeRateService.RateAt(ctx, order.TenderCurrency, at)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyTierDiscount takes the tier discount off every line after its other reductions and records the
+// tier on the order, so the receipt can name it
+func applyTierDiscount(order *domain.Order, tier *domain.LoyaltyTier) {
+	order.LoyaltyTier = ""
+	for i := range order.OrderItems {
+		order.OrderItems[i].TierDiscount = 0
+	}
+	if tier == nil {
+		return
+	}
+
+	order.LoyaltyTier = tier.Name
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		item.TierDiscount = (item.TotalPrice - item.DiscountAmount - item.PromotionAmount).Percent(tier.DiscountPct)
+	}
+}
+
+// Checkout Order, reserving stock for every line in the same transaction that places the order.
+// Discounts, the tier discount and the exchange rate are applied again so the order pays what is valid at
+// checkout time.
+func (service *OrderServiceImpl) Checkout(ctx context.Context, orderId uint64) (web.OrderResponse, error) {
+	var placedOrder domain.Order
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		order, err := service.OrderRepository.FindByIdForUpdate(ctx, orderId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Order not found")
+		} else if err != nil {
+			return err
+		}
+
+		if order.Status != domain.OrderStatusOpen {
+			return exception.NewConflictError(fmt.Sprintf("Order cannot be checked out while %s", order.Status))
+		}
+
+		if err := service.reserveStock(ctx, order); err != nil {
+			return err
+		}
+
+		customer, err := service.CustomerRepository.FindById(ctx, order.CustomerID)
+		if err != nil {
+			return err
+		}
+		if err := service.priceOrder(ctx, &order, customer.LoyaltyTier, time.Now()); err != nil {
+			return err
+		}
+		if order, err = service.OrderRepository.UpdatePricing(ctx, order); err != nil {
+			return err
+		}
+
+		order.Status = domain.OrderStatusPlaced
+		placedOrder, err = service.OrderRepository.UpdateStatus(ctx, order)
+		return err
+	})
+	if err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	return helper.ToOrderResponse(placedOrder), nil
+}
+
+// reserveStock locks the products of every line and books their sale at the store of the order, or reports
+// every line the store is short of
+func (service *OrderServiceImpl) reserveStock(ctx context.Context, order domain.Order) error {
+	items := order.OrderItems
+	productIds := make([]uint64, 0, len(items))
+	for _, item := range items {
+		productIds = append(productIds, item.ProductID)
+	}
+
+	products, err := service.ProductRepository.FindByIdsForUpdate(ctx, productIds)
+	if err != nil {
+		return err
+	}
+
+	available := make(map[uint64]int, len(products))
+	for _, product := range products {
+		available[product.ProductID] = product.AtStore(order.StoreID).StockQty
+	}
+
+	var shortages []web.StockShortageResponse
+	for _, item := range items {
+		if available[item.ProductID] < item.Quantity {
+			shortages = append(shortages, web.StockShortageResponse{
+				ProductID: item.ProductID,
+				Requested: item.Quantity,
+				Available: available[item.ProductID],
+			})
+		}
+	}
+	if len(shortages) > 0 {
+		return exception.NewInsufficientStockError(shortages)
+	}
+
+	for _, item := range items {
+		_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+			ProductID: item.ProductID,
+			StoreID:   order.StoreID,
+			Delta:     -item.Quantity,
+			Reason:    domain.StockReasonSale,
+			Reference: fmt.Sprintf("Order #%d", order.OrderID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Cancel Order, putting reserved stock back when the order was already placed. Units returned before are
+// not put back a second time.
+func (service *OrderServiceImpl) Cancel(ctx context.Context, orderId uint64) (web.OrderResponse, error) {
+	var cancelledOrder domain.Order
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		order, err := service.OrderRepository.FindByIdForUpdate(ctx, orderId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Order not found")
+		} else if err != nil {
+			return err
+		}
+
+		if order.Status == domain.OrderStatusCancelled {
+			return exception.NewConflictError("Order is already cancelled")
+		}
+
+		if order.AmountPaid() > 0 {
+			return exception.NewConflictError("Order has completed payments, refund or void them first")
+		}
+
+		if order.Status == domain.OrderStatusPlaced {
+			orderReturns, err := service.OrderReturnRepository.FindByOrderId(ctx, order.OrderID)
+			if err != nil {
+				return err
+			}
+			returnedQty := make(map[uint64]int)
+			for _, orderReturn := range orderReturns {
+				for _, line := range orderReturn.Lines {
+					returnedQty[line.OrderItemID] += line.Quantity
+				}
+			}
+
+			for _, item := range order.OrderItems {
+				quantity := item.Quantity - returnedQty[item.OrderItemID]
+				if quantity <= 0 {
+					continue
+				}
+				_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+					ProductID: item.ProductID,
+					StoreID:   order.StoreID,
+					Delta:     quantity,
+					Reason:    domain.StockReasonReturn,
+					Reference: fmt.Sprintf("Order #%d cancelled", order.OrderID),
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		order.Status = domain.OrderStatusCancelled
+		cancelledOrder, err = service.OrderRepository.UpdateStatus(ctx, order)
+		return err
+	})
+	if err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	return helper.ToOrderResponse(cancelledOrder), nil
+}
+
+// Find Order By ID
+func (service *OrderServiceImpl) FindById(ctx context.Context, orderId uint64) (web.OrderResponse, error) {
+	order, err := service.OrderRepository.FindById(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.OrderResponse{}, exception.NewNotFoundError("Order not found")
+	} else if err != nil {
+		return web.OrderResponse{}, err
+	}
+
+	return helper.ToOrderResponse(order), nil
+}
+
+// Find All Orders of a store, or of every store when storeId is 0
+func (service *OrderServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.OrderResponse, error) {
+	orders, err := service.OrderRepository.FindAll(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToOrderResponses(orders), nil
+}
@@ -0,0 +1,640 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var orderModelTpl = domain.Order{
+	OrderID:     1,
+	CustomerID:  1,
+	StoreID:     1,
+	Status:      domain.OrderStatusOpen,
+	TotalAmount: money.New(20000),
+	OrderItems: []domain.OrderItem{
+		{OrderItemID: 1, OrderID: 1, ProductID: 1, Quantity: 2, UnitPrice: money.New(10000), TotalPrice: money.New(20000)},
+	},
+}
+
+// newTxManagerMock runs every transaction callback directly on the caller's context
+func newTxManagerMock(ctrl *gomock.Controller) *mocks.MockTxManager {
+	txManager := mocks.NewMockTxManager(ctrl)
+	txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	return txManager
+}
+
+// noPromotions builds a promotion service that never finds an active promotion
+func noPromotions(ctrl *gomock.Controller) PromotionService {
+	promotionRepo := mocks.NewMockPromotionRepository(ctrl)
+	promotionRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return newPromotionService(promotionRepo)
+}
+
+// openStore builds a store service for which every store is open for business
+func openStore(ctrl *gomock.Controller) StoreService {
+	storeService := servicemocks.NewMockStoreService(ctrl)
+	active := func(ctx context.Context, storeId uint64) (domain.Store, error) {
+		return domain.Store{StoreID: storeId, Code: fmt.Sprintf("S%02d", storeId), Active: true}, nil
+	}
+	storeService.EXPECT().FindActive(gomock.Any(), gomock.Any()).DoAndReturn(active).AnyTimes()
+	storeService.EXPECT().LockActive(gomock.Any(), gomock.Any()).DoAndReturn(active).AnyTimes()
+	return storeService
+}
+
+// exclusiveTax builds a tax service for a store whose prices exclude tax
+func exclusiveTax(ctrl *gomock.Controller) TaxService {
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.StoreSetting{}, nil).AnyTimes()
+	return NewTaxService(taxRepo, validator.New())
+}
+
+// noReturns builds an order return repository for which no order has taken returns
+func noReturns(ctrl *gomock.Controller) *mocks.MockOrderReturnRepository {
+	orderReturnRepo := mocks.NewMockOrderReturnRepository(ctrl)
+	orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return orderReturnRepo
+}
+
+func TestCreateOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   web.OrderCreateRequest
+		mock    func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository)
+		expects domain.Order
+		err     error
+	}{
+		{
+			name: "Success prices lines from product",
+			input: web.OrderCreateRequest{CustomerID: 1, StoreID: 1, Items: []web.OrderItemCreateRequest{
+				{ProductID: 1, Quantity: 1},
+				{ProductID: 1, Quantity: 1},
+			}},
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+				orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			},
+			expects: domain.Order{
+				CustomerID:  1,
+				Status:      domain.OrderStatusOpen,
+				TotalAmount: money.New(22000),
+				OrderItems: []domain.OrderItem{
+					{ProductID: 1, Quantity: 2, UnitPrice: money.New(10000), TaxAmount: money.New(2000), TotalPrice: money.New(20000)},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:  "Discount taken off before tax",
+			input: web.OrderCreateRequest{CustomerID: 1, StoreID: 1, Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 2}}},
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return([]domain.Discount{discountModelTpl}, nil)
+				orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			},
+			expects: domain.Order{
+				CustomerID:  1,
+				Status:      domain.OrderStatusOpen,
+				TotalAmount: money.New(19800),
+				OrderItems: []domain.OrderItem{
+					{ProductID: 1, Quantity: 2, UnitPrice: money.New(10000), TaxAmount: money.New(1800), TotalPrice: money.New(20000), DiscountAmount: money.New(2000)},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:  "Customer Not Found",
+			input: web.OrderCreateRequest{CustomerID: 9, StoreID: 1, Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 1}}},
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Customer{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Customer not found"),
+		},
+		{
+			name:  "Product Not Found",
+			input: web.OrderCreateRequest{CustomerID: 1, StoreID: 1, Items: []web.OrderItemCreateRequest{{ProductID: 7, Quantity: 1}}},
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(7)).Return(domain.Product{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Product 7 not found"),
+		},
+		{
+			name:  "Validation Error",
+			input: web.OrderCreateRequest{CustomerID: 1},
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+			},
+			err: errors.New("validation"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			discountRepo := mocks.NewMockDiscountRepository(ctrl)
+			tt.mock(orderRepo, productRepo, customerRepo, discountRepo)
+
+			service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, nil, openStore(ctrl), newDiscountService(discountRepo),
+				noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			if tt.err != nil {
+				assert.Error(t, err)
+				if _, ok := tt.err.(exception.NotFoundError); ok {
+					assert.Equal(t, tt.err, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expects.TotalAmount, result.TotalAmount)
+			assert.Equal(t, tt.expects.Status, result.Status)
+			assert.Len(t, result.Items, len(tt.expects.OrderItems))
+			assert.Equal(t, tt.expects.OrderItems[0].Quantity, result.Items[0].Quantity)
+			assert.Equal(t, tt.expects.OrderItems[0].UnitPrice, result.Items[0].UnitPrice)
+			assert.Equal(t, tt.expects.OrderItems[0].TaxAmount, result.Items[0].TaxAmount)
+			assert.Equal(t, tt.expects.OrderItems[0].DiscountAmount, result.Items[0].DiscountAmount)
+		})
+	}
+}
+
+func TestCreateOrderTaxInclusive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	productRepo := mocks.NewMockProductRepository(ctrl)
+	customerRepo := mocks.NewMockCustomerRepository(ctrl)
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+	productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+	discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.StoreSetting{PricesIncludeTax: true}, nil)
+	orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+		return order, nil
+	})
+
+	service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, nil, openStore(ctrl), newDiscountService(discountRepo),
+		noPromotions(ctrl), NewTaxService(taxRepo, validator.New()), nil, validator.New())
+	result, err := service.Create(context.Background(), web.OrderCreateRequest{CustomerID: 1, StoreID: 1,
+		Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 1}}})
+	assert.NoError(t, err)
+	assert.True(t, result.PricesIncludeTax)
+	assert.Equal(t, money.New(10000), result.TotalAmount)
+	assert.Equal(t, money.Money(90909), result.TaxAmount)
+	assert.Equal(t, "VAT 10%", result.Items[0].TaxName)
+}
+
+func TestCreateOrderInForeignCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	productRepo := mocks.NewMockProductRepository(ctrl)
+	customerRepo := mocks.NewMockCustomerRepository(ctrl)
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	rateService := servicemocks.NewMockExchangeRateService(ctrl)
+	customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+	productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+	discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+	rateService.EXPECT().RateAt(gomock.Any(), "USD", gomock.Any()).Return(15500.0, nil)
+	orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+		return order, nil
+	})
+
+	service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, nil, openStore(ctrl), newDiscountService(discountRepo),
+		noPromotions(ctrl), exclusiveTax(ctrl), rateService, validator.New())
+	result, err := service.Create(context.Background(), web.OrderCreateRequest{CustomerID: 1, StoreID: 1, Currency: "USD",
+		Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 2}}})
+	assert.NoError(t, err)
+	assert.Equal(t, money.New(22000), result.TotalAmount)
+	assert.Equal(t, "IDR", result.Currency)
+	assert.Equal(t, "USD", result.TenderCurrency)
+	assert.Equal(t, 15500.0, result.ExchangeRate)
+	assert.Equal(t, money.Money(142), result.TenderTotal)
+}
+
+func TestCheckoutOrder(t *testing.T) {
+	placedOrder := orderModelTpl
+	placedOrder.Status = domain.OrderStatusPlaced
+
+	tests := []struct {
+		name string
+		mock func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{productModelTpl}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 1, Delta: -2,
+					Reason: domain.StockReasonSale, Reference: "Order #1"}).Return(domain.StockMovement{}, nil)
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+				orderRepo.EXPECT().UpdatePricing(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			},
+			err: nil,
+		},
+		{
+			name: "Reprices with discount valid at checkout",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{productModelTpl}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 1, Delta: -2,
+					Reason: domain.StockReasonSale, Reference: "Order #1"}).Return(domain.StockMovement{}, nil)
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return([]domain.Discount{discountModelTpl}, nil)
+				orderRepo.EXPECT().UpdatePricing(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					assert.Equal(t, money.New(2000), order.Discount)
+					assert.Equal(t, money.New(18000), order.TotalAmount)
+					assert.Equal(t, &discountModelTpl.DiscountID, order.OrderItems[0].DiscountID)
+					return order, nil
+				})
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			},
+			err: nil,
+		},
+		{
+			name: "Tier discount after the other discounts",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				goldCustomer := customerModelTpl
+				goldCustomer.LoyaltyTier = &domain.LoyaltyTier{LoyaltyTierID: 2, Name: "Gold", SpendThreshold: money.New(5000000), DiscountPct: 5, PointsMultiplier: 2}
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{productModelTpl}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 1, Delta: -2,
+					Reason: domain.StockReasonSale, Reference: "Order #1"}).Return(domain.StockMovement{}, nil)
+				customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(goldCustomer, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return([]domain.Discount{discountModelTpl}, nil)
+				orderRepo.EXPECT().UpdatePricing(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					assert.Equal(t, money.New(900), order.OrderItems[0].TierDiscount)
+					assert.Equal(t, money.New(2900), order.Discount)
+					assert.Equal(t, money.New(17100), order.TotalAmount)
+					assert.Equal(t, "Gold", order.LoyaltyTier)
+					return order, nil
+				})
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			},
+			err: nil,
+		},
+		{
+			name: "Insufficient Stock",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				lowStock := productModelTpl
+				lowStock.StockQty = 1
+				lowStock.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 1}}
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{lowStock}, nil)
+			},
+			err: exception.NewInsufficientStockError([]web.StockShortageResponse{
+				{ProductID: 1, Requested: 2, Available: 1},
+			}),
+		},
+		{
+			name: "Insufficient Stock at the store of the order",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				elsewhere := productModelTpl
+				elsewhere.Stores = []domain.StoreProduct{{StoreID: 2, StockQty: 100}}
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{elsewhere}, nil)
+			},
+			err: exception.NewInsufficientStockError([]web.StockShortageResponse{
+				{ProductID: 1, Requested: 2, Available: 0},
+			}),
+		},
+		{
+			name: "Already Placed",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+			},
+			err: exception.NewConflictError("Order cannot be checked out while Placed"),
+		},
+		{
+			name: "Stock Update Fails",
+			mock: func(orderRepo *mocks.MockOrderRepository, productRepo *mocks.MockProductRepository, customerRepo *mocks.MockCustomerRepository, discountRepo *mocks.MockDiscountRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{productModelTpl}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), gomock.Any()).Return(domain.StockMovement{}, errors.New("database error"))
+			},
+			err: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			discountRepo := mocks.NewMockDiscountRepository(ctrl)
+			tt.mock(orderRepo, productRepo, customerRepo, discountRepo)
+
+			service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, nil,
+				openStore(ctrl), newDiscountService(discountRepo), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+			result, err := service.Checkout(context.Background(), 1)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, domain.OrderStatusPlaced, result.Status)
+			}
+		})
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	placedOrder := orderModelTpl
+	placedOrder.Status = domain.OrderStatusPlaced
+	cancelledOrder := orderModelTpl
+	cancelledOrder.Status = domain.OrderStatusCancelled
+
+	tests := []struct {
+		name string
+		mock func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+			productRepo *mocks.MockProductRepository)
+		err error
+	}{
+		{
+			name: "Open Order",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), cancelledOrder).Return(cancelledOrder, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Placed Order Restocks",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+				orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(nil, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 1, Delta: 2,
+					Reason: domain.StockReasonReturn, Reference: "Order #1 cancelled"}).Return(domain.StockMovement{}, nil)
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), cancelledOrder).Return(cancelledOrder, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Placed Order Restocks What Was Not Returned",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+				orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return([]domain.OrderReturn{
+					{OrderReturnID: 1, OrderID: 1, Lines: []domain.OrderReturnLine{{OrderItemID: 1, ProductID: 1, Quantity: 1}}},
+				}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), domain.StockMovement{ProductID: 1, StoreID: 1, Delta: 1,
+					Reason: domain.StockReasonReturn, Reference: "Order #1 cancelled"}).Return(domain.StockMovement{}, nil)
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), cancelledOrder).Return(cancelledOrder, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Placed Order Fully Returned",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+				orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return([]domain.OrderReturn{
+					{OrderReturnID: 1, OrderID: 1, Lines: []domain.OrderReturnLine{{OrderItemID: 1, ProductID: 1, Quantity: 1}}},
+					{OrderReturnID: 2, OrderID: 1, Lines: []domain.OrderReturnLine{{OrderItemID: 1, ProductID: 1, Quantity: 1}}},
+				}, nil)
+				orderRepo.EXPECT().UpdateStatus(gomock.Any(), cancelledOrder).Return(cancelledOrder, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Already Cancelled",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(cancelledOrder, nil)
+			},
+			err: exception.NewConflictError("Order is already cancelled"),
+		},
+		{
+			name: "Has Completed Payments",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				paidOrder := placedOrder
+				paidOrder.Payments = []domain.Payment{{Amount: money.New(20000), Status: domain.PaymentStatusCompleted}}
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(paidOrder, nil)
+			},
+			err: exception.NewConflictError("Order has completed payments, refund or void them first"),
+		},
+		{
+			name: "Not Found",
+			mock: func(orderRepo *mocks.MockOrderRepository, orderReturnRepo *mocks.MockOrderReturnRepository,
+				productRepo *mocks.MockProductRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(domain.Order{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Order not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			orderReturnRepo := mocks.NewMockOrderReturnRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			tt.mock(orderRepo, orderReturnRepo, productRepo)
+
+			service := NewOrderService(newTxManagerMock(ctrl), orderRepo, orderReturnRepo, productRepo, mocks.NewMockCustomerRepository(ctrl), nil,
+				openStore(ctrl), newDiscountService(mocks.NewMockDiscountRepository(ctrl)), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+			result, err := service.Cancel(context.Background(), 1)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, domain.OrderStatusCancelled, result.Status)
+			}
+		})
+	}
+}
+
+func TestFindAllOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	orderRepo.EXPECT().FindAll(gomock.Any(), uint64(0)).Return([]domain.Order{orderModelTpl}, nil)
+
+	service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, mocks.NewMockProductRepository(ctrl), mocks.NewMockCustomerRepository(ctrl), nil,
+		openStore(ctrl), newDiscountService(mocks.NewMockDiscountRepository(ctrl)), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+	result, err := service.FindAll(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, orderModelTpl.TotalAmount, result[0].TotalAmount)
+}
+
+func TestCreateOrderUnknownEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	customerRepo := mocks.NewMockCustomerRepository(ctrl)
+	customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+	employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+	employeeRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Employee{}, gorm.ErrRecordNotFound)
+
+	employeeId := uint64(9)
+	service := NewOrderService(newTxManagerMock(ctrl), mocks.NewMockOrderRepository(ctrl), nil, mocks.NewMockProductRepository(ctrl), customerRepo,
+		employeeRepo, openStore(ctrl), newDiscountService(mocks.NewMockDiscountRepository(ctrl)), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+	_, err := service.Create(context.Background(), web.OrderCreateRequest{
+		CustomerID: 1, StoreID: 1, EmployeeID: &employeeId, Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 1}},
+	})
+	assert.Equal(t, exception.NewNotFoundError("Employee not found"), err)
+}
+
+func TestCreateOrderAtStore(t *testing.T) {
+	storePrice := money.New(9000)
+	otherStoreId := uint64(2)
+
+	tests := []struct {
+		name      string
+		employee  domain.Employee
+		unitPrice money.Money
+		err       error
+	}{
+		{
+			name:      "Sells at the store price by staff of the store",
+			employee:  domain.Employee{EmployeeID: 4, StoreID: &otherStoreId},
+			unitPrice: storePrice,
+		},
+		{
+			name:      "Chain-wide staff work anywhere",
+			employee:  domain.Employee{EmployeeID: 4},
+			unitPrice: storePrice,
+		},
+		{
+			name:     "Staff of another store",
+			employee: domain.Employee{EmployeeID: 4, StoreID: &orderModelTpl.StoreID},
+			err:      exception.NewBadRequestError("Employee does not work at store S02"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+			discountRepo := mocks.NewMockDiscountRepository(ctrl)
+
+			product := productModelTpl
+			product.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 100}, {StoreID: 2, StockQty: 0, Price: &storePrice}}
+			customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+			employeeRepo.EXPECT().FindById(gomock.Any(), uint64(4)).Return(tt.employee, nil)
+			if tt.err == nil {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(product, nil)
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+				orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			}
+
+			service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, employeeRepo, openStore(ctrl),
+				newDiscountService(discountRepo), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), web.OrderCreateRequest{CustomerID: 1, StoreID: 2,
+				EmployeeID: &tt.employee.EmployeeID, Items: []web.OrderItemCreateRequest{{ProductID: 1, Quantity: 1}}})
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(2), result.StoreID)
+				assert.Equal(t, tt.unitPrice, result.Items[0].UnitPrice)
+			}
+		})
+	}
+}
+
+func TestCreateOrderOfVariants(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []web.OrderItemCreateRequest
+		mock  func(productRepo *mocks.MockProductRepository)
+		lines int
+		err   error
+	}{
+		{
+			name:  "Variant of the product and the same variant scanned by itself",
+			items: []web.OrderItemCreateRequest{{ProductID: 10, VariantID: 12, Quantity: 1}, {ProductID: 12, Quantity: 2}},
+			mock: func(productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(12)).Return(variantModelTpl(12, "M"), nil)
+			},
+			lines: 1,
+		},
+		{
+			name:  "Product with variants",
+			items: []web.OrderItemCreateRequest{{ProductID: 10, Quantity: 1}},
+			mock: func(productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			},
+			err: exception.NewBadRequestError("Product 10 has variants, order one of them"),
+		},
+		{
+			name:  "Variant of another product",
+			items: []web.OrderItemCreateRequest{{ProductID: 1, VariantID: 12, Quantity: 1}},
+			mock: func(productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(12)).Return(variantModelTpl(12, "M"), nil)
+			},
+			err: exception.NewBadRequestError("Product 1 has no variant 12"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			customerRepo := mocks.NewMockCustomerRepository(ctrl)
+			discountRepo := mocks.NewMockDiscountRepository(ctrl)
+
+			customerRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(customerModelTpl, nil)
+			tt.mock(productRepo)
+			if tt.err == nil {
+				discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, nil)
+				orderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, order domain.Order) (domain.Order, error) {
+					return order, nil
+				})
+			}
+
+			service := NewOrderService(newTxManagerMock(ctrl), orderRepo, nil, productRepo, customerRepo, nil, openStore(ctrl),
+				newDiscountService(discountRepo), noPromotions(ctrl), exclusiveTax(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), web.OrderCreateRequest{CustomerID: 1, StoreID: 1, Items: tt.items})
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Len(t, result.Items, tt.lines)
+				assert.Equal(t, uint64(12), result.Items[0].ProductID)
+				assert.Equal(t, uint64(10), *result.Items[0].ParentID)
+				assert.Equal(t, 3, result.Items[0].Quantity)
+			}
+		})
+	}
+}
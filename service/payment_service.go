@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type PaymentService interface {
+	Create(ctx context.Context, request web.PaymentCreateRequest) (web.PaymentResponse, error)
+	Complete(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error)
+	Refund(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error)
+	Void(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error)
+	FindByOrderId(ctx context.Context, orderId uint64) ([]web.PaymentResponse, error)
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type PaymentServiceImpl struct {
+	TxManager             repository.TxManager
+	PaymentRepository     repository.PaymentRepository
+	OrderRepository       repository.OrderRepository
+	OrderReturnRepository repository.OrderReturnRepository
+	ReceiptService        ReceiptService
+	LoyaltyService        LoyaltyService
+	GiftCardService       GiftCardService
+	ShiftService          ShiftService
+	ExchangeRateService   ExchangeRateService
+	Validate              *validator.Validate
+}
+
+func NewPaymentService(txManager repository.TxManager, paymentRepository repository.PaymentRepository,
+	orderRepository repository.OrderRepository, orderReturnRepository repository.OrderReturnRepository,
+	receiptService ReceiptService, loyaltyService LoyaltyService, giftCardService GiftCardService, shiftService ShiftService,
+	exchangeRateService ExchangeRateService, validate *validator.Validate) PaymentService {
+	return &PaymentServiceImpl{
+		TxManager:             txManager,
+		PaymentRepository:     paymentRepository,
+		OrderRepository:       orderRepository,
+		OrderReturnRepository: orderReturnRepository,
+		ReceiptService:        receiptService,
+		LoyaltyService:        loyaltyService,
+		GiftCardService:       giftCardService,
+		ShiftService:          shiftService,
+		ExchangeRateService:   exchangeRateService,
+		Validate:              validate,
+	}
+}
+
+// Create Payment against the outstanding balance of an order. Points, gift card and store credit tenders
+// are completed at once, what they redeem is taken off the customer or card in the same transaction.
+func (service *PaymentServiceImpl) Create(ctx context.Context, request web.PaymentCreateRequest) (web.PaymentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PaymentResponse{}, err
+	}
+
+	var savedPayment domain.Payment
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The order row lock serialises concurrent payments so the balance check below stays valid
+		order, err := service.findOrderForUpdate(ctx, request.OrderID)
+		if err != nil {
+			return err
+		}
+
+		if order.Status == domain.OrderStatusCancelled {
+			return exception.NewConflictError("Cannot pay a cancelled order")
+		}
+		// Discounts are settled at checkout, so an open order has no final total to pay against yet
+		if order.Status != domain.OrderStatusPlaced {
+			return exception.NewConflictError("Order must be checked out before it can be paid")
+		}
+
+		balance := order.TotalAmount - order.AmountCommitted()
+		if balance <= 0 {
+			return exception.NewConflictError("Order has no outstanding balance")
+		}
+
+		// A foreign tender is valued in the store currency and handled as that value from here on
+		tendered, rate := request.Amount, float64(0)
+		foreign := request.Currency != "" && request.Currency != money.Currency()
+		if foreign {
+			if request.PaymentType != domain.PaymentTypeCash && request.PaymentType != domain.PaymentTypeCard {
+				return exception.NewBadRequestError(fmt.Sprintf("Only cash and card can be tendered in %s", request.Currency))
+			}
+			if rate, err = service.tenderRate(ctx, order, request.Currency); err != nil {
+				return err
+			}
+			tendered = request.Amount.ToBase(rate)
+			// Handing over the balance as quoted in the foreign currency settles it, whatever the conversion rounds to
+			if request.Amount == balance.FromBase(rate) {
+				tendered = balance
+			}
+		}
+
+		// Only cash may be over-tendered, the surplus is handed back as change
+		amount, changeDue := tendered, money.Money(0)
+		if tendered > balance {
+			if request.PaymentType != domain.PaymentTypeCash {
+				return exception.NewConflictError(fmt.Sprintf("Payment of %s exceeds outstanding balance of %s", tendered, balance))
+			}
+			amount, changeDue = balance, tendered-balance
+		}
+
+		payment := domain.Payment{
+			OrderID:        order.OrderID,
+			Amount:         amount,
+			AmountTendered: tendered,
+			ChangeDue:      changeDue,
+			PaymentType:    request.PaymentType,
+			PaymentDate:    time.Now(),
+			Status:         request.Status,
+			ShiftID:        request.ShiftID,
+		}
+		if foreign {
+			payment.Currency, payment.ExchangeRate, payment.ForeignAmount = request.Currency, rate, request.Amount
+		}
+		if request.ShiftID != nil {
+			shift, err := service.ShiftService.LockOpenShift(ctx, *request.ShiftID)
+			if err != nil {
+				return err
+			}
+			if shift.StoreID != order.StoreID {
+				return exception.NewBadRequestError(fmt.Sprintf("Shift #%d is not at the store of order #%d", shift.ShiftID, order.OrderID))
+			}
+		}
+		if payment.UsesGiftCard() {
+			card, err := service.GiftCardService.CardForTender(ctx, order, request.PaymentType, request.GiftCardCode)
+			if err != nil {
+				return err
+			}
+			payment.GiftCardID, payment.GiftCard = &card.GiftCardID, &card
+		}
+		if payment.PaymentType == domain.PaymentTypePoints || payment.UsesGiftCard() {
+			payment.Status = domain.PaymentStatusCompleted
+		} else if payment.Status == "" {
+			payment.Status = domain.PaymentStatusPending
+		}
+
+		savedPayment, err = service.PaymentRepository.Save(ctx, payment)
+		if err != nil {
+			return err
+		}
+
+		if savedPayment.PaymentType == domain.PaymentTypePoints {
+			if err := service.LoyaltyService.Redeem(ctx, order, savedPayment); err != nil {
+				return err
+			}
+		} else if savedPayment.UsesGiftCard() {
+			if err := service.GiftCardService.Redeem(ctx, order, savedPayment); err != nil {
+				return err
+			}
+		}
+
+		return service.settleWhenPaid(ctx, order, savedPayment)
+	})
+	if err != nil {
+		return web.PaymentResponse{}, err
+	}
+
+	return helper.ToPaymentResponse(savedPayment), nil
+}
+
+// tenderRate is the rate a foreign tender is taken at: the one the order was priced with when the order is
+// paid in that currency, the rate in effect now otherwise
+func (service *PaymentServiceImpl) tenderRate(ctx context.Context, order domain.Order, currency string) (float64, error) {
+	if order.TenderCurrency == currency && order.ExchangeRate > 0 {
+		return order.ExchangeRate, nil
+	}
+	return service.ExchangeRateService.RateAt(ctx, currency, time.Now())
+}
+
+// Complete Payment
+func (service *PaymentServiceImpl) Complete(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error) {
+	return service.transition(ctx, orderId, paymentId, domain.PaymentStatusCompleted)
+}
+
+// Refund Payment
+func (service *PaymentServiceImpl) Refund(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error) {
+	return service.transition(ctx, orderId, paymentId, domain.PaymentStatusRefunded)
+}
+
+// Void Payment
+func (service *PaymentServiceImpl) Void(ctx context.Context, orderId uint64, paymentId uint64) (web.PaymentResponse, error) {
+	return service.transition(ctx, orderId, paymentId, domain.PaymentStatusVoided)
+}
+
+// Find Payments By Order ID
+func (service *PaymentServiceImpl) FindByOrderId(ctx context.Context, orderId uint64) ([]web.PaymentResponse, error) {
+	_, err := service.OrderRepository.FindById(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, exception.NewNotFoundError("Order not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	payments, err := service.PaymentRepository.FindByOrderId(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToPaymentResponses(payments), nil
+}
+
+// transition moves a payment of the order to status when the payment state machine allows it. A payment
+// taken on a shift is only completed or voided while that shift is open, a refund is paid out on the shift
+// open on its register. Completed payments of a settled order stay as they are.
+func (service *PaymentServiceImpl) transition(ctx context.Context, orderId uint64, paymentId uint64, status string) (web.PaymentResponse, error) {
+	var updatedPayment domain.Payment
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		order, err := service.findOrderForUpdate(ctx, orderId)
+		if err != nil {
+			return err
+		}
+
+		payment, err := service.PaymentRepository.FindById(ctx, paymentId)
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && payment.OrderID != orderId) {
+			return exception.NewNotFoundError("Payment not found")
+		} else if err != nil {
+			return err
+		}
+
+		if !payment.CanTransitionTo(status) {
+			return exception.NewConflictError(fmt.Sprintf("Payment cannot move from %s to %s", payment.Status, status))
+		}
+
+		if payment.ShiftID != nil && status == domain.PaymentStatusRefunded {
+			shift, err := service.ShiftService.LockRefundShift(ctx, *payment.ShiftID)
+			if err != nil {
+				return err
+			}
+			payment.RefundShiftID = &shift.ShiftID
+		} else if payment.ShiftID != nil {
+			if _, err := service.ShiftService.LockOpenShift(ctx, *payment.ShiftID); err != nil {
+				return err
+			}
+		}
+
+		wasCompleted := payment.Status == domain.PaymentStatusCompleted
+		// A settled order is in the sales figures, what goes back from it goes back through a return
+		if wasCompleted && order.PaymentStatus() == domain.OrderPaymentPaid {
+			return exception.NewConflictError("Order is settled, take the units back with a return instead")
+		}
+		if wasCompleted {
+			// Returns already paid part of the order back through payments of their own
+			refundable, err := service.refundable(ctx, order)
+			if err != nil {
+				return err
+			}
+			if payment.Amount > refundable {
+				return exception.NewConflictError(fmt.Sprintf("Payment of %s exceeds the %s still refundable on the order", payment.Amount, refundable))
+			}
+		}
+
+		payment.Status = status
+		updatedPayment, err = service.PaymentRepository.UpdateStatus(ctx, payment)
+		if err != nil {
+			return err
+		}
+
+		// Money going back out undoes the loyalty side of the payment as well
+		if wasCompleted && payment.PaymentType == domain.PaymentTypePoints {
+			if err := service.LoyaltyService.RefundRedemption(ctx, order, updatedPayment); err != nil {
+				return err
+			}
+		} else if wasCompleted {
+			if updatedPayment.UsesGiftCard() {
+				if err := service.GiftCardService.RefundRedemption(ctx, order, updatedPayment); err != nil {
+					return err
+				}
+			}
+			if err := service.LoyaltyService.ClawBack(ctx, order, updatedPayment.Amount); err != nil {
+				return err
+			}
+		}
+
+		return service.settleWhenPaid(ctx, order, updatedPayment)
+	})
+	if err != nil {
+		return web.PaymentResponse{}, err
+	}
+
+	return helper.ToPaymentResponse(updatedPayment), nil
+}
+
+// settleWhenPaid issues the receipt and earns the loyalty points once payment completes the order. order
+// is the state read before payment changed.
+func (service *PaymentServiceImpl) settleWhenPaid(ctx context.Context, order domain.Order, payment domain.Payment) error {
+	if payment.Status != domain.PaymentStatusCompleted {
+		return nil
+	}
+	if order.AmountPaid()+payment.Amount < order.TotalAmount {
+		return nil
+	}
+
+	if _, err := service.ReceiptService.Issue(ctx, order.OrderID); err != nil {
+		return err
+	}
+
+	paidOrder := order
+	paidOrder.Payments = append([]domain.Payment(nil), order.Payments...)
+	found := false
+	for i := range paidOrder.Payments {
+		if paidOrder.Payments[i].PaymentID == payment.PaymentID {
+			paidOrder.Payments[i], found = payment, true
+		}
+	}
+	if !found {
+		paidOrder.Payments = append(paidOrder.Payments, payment)
+	}
+	return service.LoyaltyService.EarnForOrder(ctx, paidOrder)
+}
+
+// refundable is what the completed payments of the order still hold once the refunds of its returns are taken off
+func (service *PaymentServiceImpl) refundable(ctx context.Context, order domain.Order) (money.Money, error) {
+	orderReturns, err := service.OrderReturnRepository.FindByOrderId(ctx, order.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	refundable := order.AmountPaid()
+	for _, orderReturn := range orderReturns {
+		refundable -= orderReturn.RefundAmount
+	}
+	return refundable, nil
+}
+
+func (service *PaymentServiceImpl) findOrderForUpdate(ctx context.Context, orderId uint64) (domain.Order, error) {
+	order, err := service.OrderRepository.FindByIdForUpdate(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Order{}, exception.NewNotFoundError("Order not found")
+	}
+	return order, err
+}
@@ -0,0 +1,674 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var paymentModelTpl = domain.Payment{
+	PaymentID:   1,
+	OrderID:     1,
+	Amount:      money.New(5000),
+	PaymentType: domain.PaymentTypeCash,
+	Status:      domain.PaymentStatusPending,
+}
+
+func TestCreatePayment(t *testing.T) {
+	partlyPaidOrder := orderModelTpl
+	partlyPaidOrder.Status = domain.OrderStatusPlaced
+	partlyPaidOrder.Payments = []domain.Payment{
+		{PaymentID: 2, OrderID: 1, Amount: money.New(15000), Status: domain.PaymentStatusCompleted},
+		{PaymentID: 3, OrderID: 1, Amount: money.New(5000), Status: domain.PaymentStatusVoided},
+	}
+	cancelledOrder := orderModelTpl
+	cancelledOrder.Status = domain.OrderStatusCancelled
+
+	tests := []struct {
+		name  string
+		input web.PaymentCreateRequest
+		mock  func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCash},
+			mock: func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(partlyPaidOrder, nil)
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(paymentModelTpl, nil)
+			},
+			err: nil,
+		},
+		{
+			name:  "Exceeds Balance",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(6000), PaymentType: domain.PaymentTypeCard},
+			mock: func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(partlyPaidOrder, nil)
+			},
+			err: exception.NewConflictError("Payment of 6000.00 exceeds outstanding balance of 5000.00"),
+		},
+		{
+			name:  "Cancelled Order",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCash},
+			mock: func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(cancelledOrder, nil)
+			},
+			err: exception.NewConflictError("Cannot pay a cancelled order"),
+		},
+		{
+			name:  "Open Order",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCash},
+			mock: func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(orderModelTpl, nil)
+			},
+			err: exception.NewConflictError("Order must be checked out before it can be paid"),
+		},
+		{
+			name:  "Order Not Found",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCash},
+			mock: func(paymentRepo *mocks.MockPaymentRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(domain.Order{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Order not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			receiptService := servicemocks.NewMockReceiptService(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+			tt.mock(paymentRepo, orderRepo)
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), receiptService, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+				servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+			_, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestPaymentTransitions(t *testing.T) {
+	completedPayment := paymentModelTpl
+	completedPayment.Status = domain.PaymentStatusCompleted
+	otherOrderPayment := paymentModelTpl
+	otherOrderPayment.OrderID = 2
+	settlingPayment := completedPayment
+	settlingPayment.Amount = money.New(15000)
+
+	tests := []struct {
+		name    string
+		payment domain.Payment
+		returns []domain.OrderReturn
+		action  func(service PaymentService) (web.PaymentResponse, error)
+		status  string
+		err     error
+	}{
+		{
+			name:    "Complete Pending",
+			payment: paymentModelTpl,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Complete(context.Background(), 1, 1)
+			},
+			status: domain.PaymentStatusCompleted,
+		},
+		{
+			name:    "Refund Completed",
+			payment: completedPayment,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Refund(context.Background(), 1, 1)
+			},
+			status: domain.PaymentStatusRefunded,
+		},
+		{
+			name:    "Refund Settled Order",
+			payment: settlingPayment,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Refund(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Order is settled, take the units back with a return instead"),
+		},
+		{
+			name:    "Void Settled Order",
+			payment: settlingPayment,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Void(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Order is settled, take the units back with a return instead"),
+		},
+		{
+			name:    "Refund After Return",
+			payment: completedPayment,
+			returns: []domain.OrderReturn{{OrderReturnID: 1, OrderID: 1, PaymentID: 3, RefundAmount: money.New(6000)}},
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Refund(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Payment of 5000.00 exceeds the 4000.00 still refundable on the order"),
+		},
+		{
+			name:    "Void After Return",
+			payment: completedPayment,
+			returns: []domain.OrderReturn{{OrderReturnID: 1, OrderID: 1, PaymentID: 3, RefundAmount: money.New(5000)}},
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Void(context.Background(), 1, 1)
+			},
+			status: domain.PaymentStatusVoided,
+		},
+		{
+			name:    "Refund Pending",
+			payment: paymentModelTpl,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Refund(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Payment cannot move from Pending to Refunded"),
+		},
+		{
+			name:    "Payment Of Other Order",
+			payment: otherOrderPayment,
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Void(context.Background(), 1, 1)
+			},
+			err: exception.NewNotFoundError("Payment not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			orderReturnRepo := mocks.NewMockOrderReturnRepository(ctrl)
+			receiptService := servicemocks.NewMockReceiptService(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+			order := orderModelTpl
+			order.Status = domain.OrderStatusPlaced
+			order.Payments = []domain.Payment{
+				tt.payment,
+				{PaymentID: 2, OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted},
+			}
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(order, nil)
+			paymentRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(tt.payment, nil)
+			orderReturnRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(tt.returns, nil).AnyTimes()
+			if tt.err == nil {
+				paymentRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						return payment, nil
+					})
+			}
+			if tt.err == nil && tt.payment.Status == domain.PaymentStatusCompleted {
+				loyaltyService.EXPECT().ClawBack(gomock.Any(), order, tt.payment.Amount).Return(nil)
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, orderReturnRepo, receiptService, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+				servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+			result, err := tt.action(service)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, tt.status, result.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentTransitionsOnShift(t *testing.T) {
+	takenOn := uint64(3)
+	pendingPayment := paymentModelTpl
+	pendingPayment.ShiftID = &takenOn
+	completedPayment := pendingPayment
+	completedPayment.Status = domain.PaymentStatusCompleted
+
+	tests := []struct {
+		name    string
+		payment domain.Payment
+		mock    func(shiftService *servicemocks.MockShiftService)
+		action  func(service PaymentService) (web.PaymentResponse, error)
+		err     error
+	}{
+		{
+			name:    "Complete on a closed shift",
+			payment: pendingPayment,
+			mock: func(shiftService *servicemocks.MockShiftService) {
+				shiftService.EXPECT().LockOpenShift(gomock.Any(), takenOn).Return(domain.Shift{}, exception.NewConflictError("Shift #3 is closed"))
+			},
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Complete(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Shift #3 is closed"),
+		},
+		{
+			name:    "Void on a closed shift",
+			payment: completedPayment,
+			mock: func(shiftService *servicemocks.MockShiftService) {
+				shiftService.EXPECT().LockOpenShift(gomock.Any(), takenOn).Return(domain.Shift{}, exception.NewConflictError("Shift #3 is closed"))
+			},
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Void(context.Background(), 1, 1)
+			},
+			err: exception.NewConflictError("Shift #3 is closed"),
+		},
+		{
+			name:    "Refund on the next shift",
+			payment: completedPayment,
+			mock: func(shiftService *servicemocks.MockShiftService) {
+				shiftService.EXPECT().LockRefundShift(gomock.Any(), takenOn).Return(domain.Shift{ShiftID: 4}, nil)
+			},
+			action: func(service PaymentService) (web.PaymentResponse, error) {
+				return service.Refund(context.Background(), 1, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+			shiftService := servicemocks.NewMockShiftService(ctrl)
+			order := orderModelTpl
+			order.Status = domain.OrderStatusPlaced
+			order.Payments = []domain.Payment{tt.payment}
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(order, nil)
+			paymentRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(tt.payment, nil)
+			tt.mock(shiftService)
+			if tt.err == nil {
+				paymentRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						assert.Equal(t, takenOn, *payment.ShiftID)
+						assert.Equal(t, uint64(4), *payment.RefundShiftID)
+						return payment, nil
+					})
+				loyaltyService.EXPECT().ClawBack(gomock.Any(), order, tt.payment.Amount).Return(nil)
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), servicemocks.NewMockReceiptService(ctrl), loyaltyService,
+				servicemocks.NewMockGiftCardService(ctrl), shiftService, nil, validator.New())
+			_, err := tt.action(service)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCreateSplitTenderPayment(t *testing.T) {
+	partlyPaidOrder := orderModelTpl
+	partlyPaidOrder.Status = domain.OrderStatusPlaced
+	partlyPaidOrder.Payments = []domain.Payment{
+		{PaymentID: 2, OrderID: 1, Amount: money.New(12000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted},
+		{PaymentID: 3, OrderID: 1, Amount: money.New(3000), PaymentType: domain.PaymentTypeQRIS, Status: domain.PaymentStatusPending},
+	}
+	settledOrder := orderModelTpl
+	settledOrder.Status = domain.OrderStatusPlaced
+	settledOrder.Payments = []domain.Payment{
+		{PaymentID: 2, OrderID: 1, Amount: money.New(20000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted},
+	}
+
+	tests := []struct {
+		name    string
+		order   domain.Order
+		input   web.PaymentCreateRequest
+		expects web.PaymentResponse
+		err     error
+	}{
+		{
+			name:  "Cash Over-tender Gives Change",
+			order: partlyPaidOrder,
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(10000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted},
+			expects: web.PaymentResponse{
+				OrderID:        1,
+				Amount:         money.New(5000),
+				AmountTendered: money.New(10000),
+				ChangeDue:      money.New(5000),
+				PaymentType:    domain.PaymentTypeCash,
+				Status:         domain.PaymentStatusCompleted,
+			},
+		},
+		{
+			name:  "Card Settles Exact Remainder",
+			order: partlyPaidOrder,
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCard},
+			expects: web.PaymentResponse{
+				OrderID:        1,
+				Amount:         money.New(5000),
+				AmountTendered: money.New(5000),
+				PaymentType:    domain.PaymentTypeCard,
+				Status:         domain.PaymentStatusPending,
+			},
+		},
+		{
+			name:  "Nothing Outstanding",
+			order: settledOrder,
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(1000), PaymentType: domain.PaymentTypeCash},
+			err:   exception.NewConflictError("Order has no outstanding balance"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			receiptService := servicemocks.NewMockReceiptService(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(tt.order, nil)
+			if tt.err == nil {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						return payment, nil
+					})
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), receiptService, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+				servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				result.PaymentDate = tt.expects.PaymentDate
+				assert.Equal(t, tt.expects, result)
+			}
+		})
+	}
+}
+
+func TestCreateForeignCurrencyPayment(t *testing.T) {
+	usdOrder := orderModelTpl
+	usdOrder.Status = domain.OrderStatusPlaced
+	usdOrder.TotalAmount = money.New(155000)
+	usdOrder.TenderCurrency = "USD"
+	usdOrder.ExchangeRate = 15500
+
+	tests := []struct {
+		name    string
+		input   web.PaymentCreateRequest
+		mock    func(rateService *servicemocks.MockExchangeRateService)
+		expects web.PaymentResponse
+		err     error
+	}{
+		{
+			name:  "Cash At Order Rate Gives Change In Store Currency",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(20), PaymentType: domain.PaymentTypeCash, Currency: "USD"},
+			mock:  func(rateService *servicemocks.MockExchangeRateService) {},
+			expects: web.PaymentResponse{
+				OrderID:        1,
+				Amount:         money.New(155000),
+				AmountTendered: money.New(310000),
+				ChangeDue:      money.New(155000),
+				PaymentType:    domain.PaymentTypeCash,
+				Status:         domain.PaymentStatusPending,
+				Currency:       "USD",
+				ExchangeRate:   15500,
+				ForeignAmount:  money.New(20),
+			},
+		},
+		{
+			name:  "Card At Current Rate Settles Quoted Balance",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.Money(1350), PaymentType: domain.PaymentTypeCard, Currency: "SGD"},
+			mock: func(rateService *servicemocks.MockExchangeRateService) {
+				rateService.EXPECT().RateAt(gomock.Any(), "SGD", gomock.Any()).Return(11481.02, nil)
+			},
+			expects: web.PaymentResponse{
+				OrderID:        1,
+				Amount:         money.New(155000),
+				AmountTendered: money.New(155000),
+				PaymentType:    domain.PaymentTypeCard,
+				Status:         domain.PaymentStatusPending,
+				Currency:       "SGD",
+				ExchangeRate:   11481.02,
+				ForeignAmount:  money.Money(1350),
+			},
+		},
+		{
+			name:  "Points In Foreign Currency",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(10), PaymentType: domain.PaymentTypePoints, Currency: "USD"},
+			mock:  func(rateService *servicemocks.MockExchangeRateService) {},
+			err:   exception.NewBadRequestError("Only cash and card can be tendered in USD"),
+		},
+		{
+			name:  "No Rate In Effect",
+			input: web.PaymentCreateRequest{OrderID: 1, Amount: money.New(10), PaymentType: domain.PaymentTypeCash, Currency: "EUR"},
+			mock: func(rateService *servicemocks.MockExchangeRateService) {
+				rateService.EXPECT().RateAt(gomock.Any(), "EUR", gomock.Any()).
+					Return(0.0, exception.NewBadRequestError("No EUR exchange rate in effect on 2026-10-16"))
+			},
+			err: exception.NewBadRequestError("No EUR exchange rate in effect on 2026-10-16"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			rateService := servicemocks.NewMockExchangeRateService(ctrl)
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(usdOrder, nil)
+			tt.mock(rateService)
+			if tt.err == nil {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						return payment, nil
+					})
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), servicemocks.NewMockReceiptService(ctrl),
+				servicemocks.NewMockLoyaltyService(ctrl), servicemocks.NewMockGiftCardService(ctrl), servicemocks.NewMockShiftService(ctrl),
+				rateService, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				result.PaymentDate = tt.expects.PaymentDate
+				assert.Equal(t, tt.expects, result)
+			}
+		})
+	}
+}
+
+func TestCompletingFinalPaymentIssuesReceipt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	order := orderModelTpl
+	order.Payments = []domain.Payment{
+		{PaymentID: 2, OrderID: 1, Amount: money.New(15000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted},
+		paymentModelTpl,
+	}
+
+	paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	receiptService := servicemocks.NewMockReceiptService(ctrl)
+	loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+	orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(order, nil)
+	paymentRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(paymentModelTpl, nil)
+	paymentRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+			return payment, nil
+		})
+	receiptService.EXPECT().Issue(gomock.Any(), uint64(1)).Return(web.ReceiptResponse{Id: 1, OrderID: 1}, nil)
+	loyaltyService.EXPECT().EarnForOrder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, order domain.Order) error {
+			assert.Equal(t, domain.PaymentStatusCompleted, order.Payments[1].Status)
+			return nil
+		})
+
+	service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), receiptService, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+		servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+	result, err := service.Complete(context.Background(), 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusCompleted, result.Status)
+}
+
+func TestPointsPaymentRedeems(t *testing.T) {
+	placedOrder := orderModelTpl
+	placedOrder.Status = domain.OrderStatusPlaced
+
+	tests := []struct {
+		name       string
+		redeemErr  error
+		err        error
+		withStatus string
+	}{
+		{
+			name:       "Completed at once",
+			withStatus: domain.PaymentStatusPending,
+		},
+		{
+			name:      "Not enough points",
+			redeemErr: exception.NewConflictError("Customer does not have the 500 points needed"),
+			err:       exception.NewConflictError("Customer does not have the 500 points needed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			receiptService := servicemocks.NewMockReceiptService(ctrl)
+			loyaltyService := servicemocks.NewMockLoyaltyService(ctrl)
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+			paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+					assert.Equal(t, domain.PaymentStatusCompleted, payment.Status)
+					return payment, nil
+				})
+			loyaltyService.EXPECT().Redeem(gomock.Any(), placedOrder, gomock.Any()).Return(tt.redeemErr)
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), receiptService, loyaltyService, servicemocks.NewMockGiftCardService(ctrl),
+				servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+			_, err := service.Create(context.Background(), web.PaymentCreateRequest{
+				OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypePoints, Status: tt.withStatus,
+			})
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestGiftCardPaymentRedeems(t *testing.T) {
+	placedOrder := orderModelTpl
+	placedOrder.Status = domain.OrderStatusPlaced
+	card := domain.GiftCard{GiftCardID: 4, Code: "ABCD-EFGH-JKLM-NPQR", Kind: domain.GiftCardKindGiftCard, Balance: money.New(8000)}
+
+	tests := []struct {
+		name      string
+		cardErr   error
+		redeemErr error
+		err       error
+	}{
+		{
+			name: "Completed at once",
+		},
+		{
+			name:    "Expired card",
+			cardErr: exception.NewConflictError("Gift card has expired"),
+			err:     exception.NewConflictError("Gift card has expired"),
+		},
+		{
+			name:      "Balance too low",
+			redeemErr: exception.NewConflictError("Gift card balance does not cover 5000.00"),
+			err:       exception.NewConflictError("Gift card balance does not cover 5000.00"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			giftCardService := servicemocks.NewMockGiftCardService(ctrl)
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+			giftCardService.EXPECT().CardForTender(gomock.Any(), placedOrder, domain.PaymentTypeGiftCard, card.Code).Return(card, tt.cardErr)
+			if tt.cardErr == nil {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						assert.Equal(t, domain.PaymentStatusCompleted, payment.Status)
+						assert.Equal(t, card.GiftCardID, *payment.GiftCardID)
+						return payment, nil
+					})
+				giftCardService.EXPECT().Redeem(gomock.Any(), placedOrder, gomock.Any()).Return(tt.redeemErr)
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), servicemocks.NewMockReceiptService(ctrl),
+				servicemocks.NewMockLoyaltyService(ctrl), giftCardService, servicemocks.NewMockShiftService(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), web.PaymentCreateRequest{
+				OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeGiftCard, GiftCardCode: card.Code,
+			})
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, card.Code, result.GiftCardCode)
+			}
+		})
+	}
+}
+
+func TestPaymentOnShift(t *testing.T) {
+	placedOrder := orderModelTpl
+	placedOrder.Status = domain.OrderStatusPlaced
+	shiftId := uint64(3)
+
+	tests := []struct {
+		name     string
+		shift    domain.Shift
+		shiftErr error
+		err      error
+	}{
+		{
+			name:  "Open shift",
+			shift: domain.Shift{ShiftID: shiftId, StoreID: 1},
+		},
+		{
+			name:     "Closed shift",
+			shiftErr: exception.NewConflictError("Shift #3 is closed"),
+			err:      exception.NewConflictError("Shift #3 is closed"),
+		},
+		{
+			name:  "Shift at another store",
+			shift: domain.Shift{ShiftID: shiftId, StoreID: 2},
+			err:   exception.NewBadRequestError("Shift #3 is not at the store of order #1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			shiftService := servicemocks.NewMockShiftService(ctrl)
+			orderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(placedOrder, nil)
+			shiftService.EXPECT().LockOpenShift(gomock.Any(), shiftId).Return(tt.shift, tt.shiftErr)
+			if tt.err == nil {
+				paymentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+						assert.Equal(t, shiftId, *payment.ShiftID)
+						return payment, nil
+					})
+			}
+
+			service := NewPaymentService(newTxManagerMock(ctrl), paymentRepo, orderRepo, noReturns(ctrl), servicemocks.NewMockReceiptService(ctrl),
+				servicemocks.NewMockLoyaltyService(ctrl), servicemocks.NewMockGiftCardService(ctrl), shiftService, nil, validator.New())
+			result, err := service.Create(context.Background(), web.PaymentCreateRequest{
+				OrderID: 1, Amount: money.New(5000), PaymentType: domain.PaymentTypeCash, ShiftID: &shiftId,
+			})
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, shiftId, *result.ShiftID)
+			}
+		})
+	}
+}
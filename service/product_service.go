@@ -8,7 +8,8 @@ import (
 type ProductService interface {
 	Create(ctx context.Context, request web.ProductCreateRequest) (web.ProductResponse, error)
 	Update(ctx context.Context, request web.ProductUpdateRequest) (web.ProductResponse, error)
+	UpdateVariant(ctx context.Context, request web.ProductVariantUpdateRequest) (web.ProductResponse, error)
 	Delete(ctx context.Context, productId uint64) error
-	FindById(ctx context.Context, productId uint64) (web.ProductResponse, error)
-	FindAll(ctx context.Context) ([]web.ProductResponse, error)
+	FindById(ctx context.Context, productId uint64, query web.ProductQuery) (web.ProductResponse, error)
+	FindAll(ctx context.Context, query web.ProductQuery) ([]web.ProductResponse, error)
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/Kahffi/go-rest-api-test/exception"
 	"github.com/Kahffi/go-rest-api-test/helper"
 	"github.com/Kahffi/go-rest-api-test/model/domain"
@@ -10,35 +11,195 @@ import (
 	"github.com/Kahffi/go-rest-api-test/repository"
 	"github.com/go-playground/validator/v10"
 	"gorm.io/gorm"
+	"strings"
+	"time"
 )
 
 type ProductServiceImpl struct {
-	ProductRepository repository.ProductRepository
-	Validate          *validator.Validate
+	ProductRepository   repository.ProductRepository
+	TaxRepository       repository.TaxRepository
+	StoreService        StoreService
+	ExchangeRateService ExchangeRateService
+	Validate            *validator.Validate
 }
 
-func NewProductService(productRepository repository.ProductRepository, validate *validator.Validate) ProductService {
+func NewProductService(productRepository repository.ProductRepository, taxRepository repository.TaxRepository,
+	storeService StoreService, exchangeRateService ExchangeRateService, validate *validator.Validate) ProductService {
 	return &ProductServiceImpl{
-		ProductRepository: productRepository,
-		Validate:          validate,
+		ProductRepository:   productRepository,
+		TaxRepository:       taxRepository,
+		StoreService:        storeService,
+		ExchangeRateService: exchangeRateService,
+		Validate:            validate,
 	}
 }
 
-// Create Product
+// Create Product with its opening stock at one store, other stores start without stock. A product with
+// options is created as a parent with one variant for every combination of option values, and the opening
+// stock goes into the variants.
 func (service *ProductServiceImpl) Create(ctx context.Context, request web.ProductCreateRequest) (web.ProductResponse, error) {
 	if err := service.Validate.Struct(request); err != nil {
 		return web.ProductResponse{}, err
 	}
 
-	product := domain.Product{Name: request.Name}
+	tax, err := service.findTax(ctx, request.TaxID)
+	if err != nil {
+		return web.ProductResponse{}, err
+	}
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.ProductResponse{}, err
+	}
+
+	product := domain.Product{
+		Name:        request.Name,
+		Description: request.Description,
+		Price:       request.Price,
+		StockQty:    request.StockQty,
+		CategoryId:  uint64(request.CategoryID),
+		SKU:         request.SKU,
+		Barcode:     request.Barcode,
+		TaxID:       &tax.TaxID,
+		Stores:      []domain.StoreProduct{{StoreID: request.StoreID, StockQty: request.StockQty}},
+	}
+	if len(request.Options) > 0 {
+		product.StockQty = 0
+		product.Stores = nil
+		if product.Options, product.Variants, err = generateVariants(product, request); err != nil {
+			return web.ProductResponse{}, err
+		}
+	} else if len(request.Variants) > 0 {
+		return web.ProductResponse{}, exception.NewBadRequestError("Variants need the options they differ on")
+	}
+
 	savedProduct, err := service.ProductRepository.Save(ctx, product)
 	if err != nil {
 		return web.ProductResponse{}, err
 	}
+	savedProduct.Tax = tax
+	for i := range savedProduct.Variants {
+		savedProduct.Variants[i].Tax = tax
+	}
 
 	return helper.ToProductResponse(savedProduct), nil
 }
 
+// maxVariants bounds how many variants the options of one product may generate
+const maxVariants = 100
+
+// generateVariants builds the options of parent and one variant for every combination of their values, in
+// the order the options and values were given. A variant takes what its request in request.Variants sets and
+// defaults the rest.
+func generateVariants(parent domain.Product, request web.ProductCreateRequest) ([]domain.ProductOption, []domain.Product, error) {
+	options := make([]domain.ProductOption, 0, len(request.Options))
+	combinations := [][]domain.VariantOptionValue{nil}
+	seenOptions := make(map[string]bool, len(request.Options))
+	for _, optionRequest := range request.Options {
+		if seenOptions[optionRequest.Name] {
+			return nil, nil, exception.NewBadRequestError(fmt.Sprintf("Option %s is given more than once", optionRequest.Name))
+		}
+		seenOptions[optionRequest.Name] = true
+
+		option := domain.ProductOption{Name: optionRequest.Name}
+		seenValues := make(map[string]bool, len(optionRequest.Values))
+		for _, value := range optionRequest.Values {
+			if seenValues[value] {
+				return nil, nil, exception.NewBadRequestError(fmt.Sprintf("Option %s has the value %s more than once", optionRequest.Name, value))
+			}
+			seenValues[value] = true
+			option.Values = append(option.Values, domain.ProductOptionValue{Value: value})
+		}
+		options = append(options, option)
+
+		if len(combinations)*len(option.Values) > maxVariants {
+			return nil, nil, exception.NewBadRequestError(fmt.Sprintf("The options make more than %d variants", maxVariants))
+		}
+		next := make([][]domain.VariantOptionValue, 0, len(combinations)*len(option.Values))
+		for _, combination := range combinations {
+			for _, value := range option.Values {
+				next = append(next, append(append([]domain.VariantOptionValue(nil), combination...),
+					domain.VariantOptionValue{Option: option.Name, Value: value.Value}))
+			}
+		}
+		combinations = next
+	}
+
+	variantRequests := make(map[string]web.ProductVariantRequest, len(request.Variants))
+	for _, variantRequest := range request.Variants {
+		key, ok := variantKey(options, variantRequest.Options)
+		if !ok {
+			return nil, nil, exception.NewBadRequestError(fmt.Sprintf("Variant %v does not match the options of the product", variantRequest.Options))
+		}
+		if _, ok := variantRequests[key]; ok {
+			return nil, nil, exception.NewBadRequestError(fmt.Sprintf("Variant %s is given more than once", key))
+		}
+		variantRequests[key] = variantRequest
+	}
+
+	variants := make([]domain.Product, 0, len(combinations))
+	seenSKUs := map[string]bool{parent.SKU: true}
+	for _, combination := range combinations {
+		variant := domain.Product{
+			Description:  parent.Description,
+			Price:        parent.Price,
+			CategoryId:   parent.CategoryId,
+			TaxID:        parent.TaxID,
+			OptionValues: combination,
+		}
+		variant.Name = fmt.Sprintf("%s (%s)", parent.Name, variant.VariantLabel())
+
+		values := make([]string, 0, len(combination))
+		for _, optionValue := range combination {
+			values = append(values, strings.ToUpper(strings.ReplaceAll(optionValue.Value, " ", "")))
+		}
+		variant.SKU = parent.SKU + "-" + strings.Join(values, "-")
+
+		stockQty := 0
+		if variantRequest, ok := variantRequests[variant.VariantLabel()]; ok {
+			if variantRequest.SKU != "" {
+				variant.SKU = variantRequest.SKU
+			}
+			variant.Barcode = variantRequest.Barcode
+			if variantRequest.Price != nil {
+				variant.PriceOverride = variantRequest.Price
+				variant.Price = *variantRequest.Price
+			}
+			stockQty = variantRequest.StockQty
+		}
+		if seenSKUs[variant.SKU] {
+			return nil, nil, exception.NewBadRequestError(fmt.Sprintf("SKU %s is used more than once", variant.SKU))
+		}
+		seenSKUs[variant.SKU] = true
+		variant.Stores = []domain.StoreProduct{{StoreID: request.StoreID, StockQty: stockQty}}
+
+		variants = append(variants, variant)
+	}
+	return options, variants, nil
+}
+
+// variantKey is the label of the variant with the given value on every option, ok is false when the values
+// do not name exactly one value of every option
+func variantKey(options []domain.ProductOption, values map[string]string) (string, bool) {
+	if len(values) != len(options) {
+		return "", false
+	}
+	labelled := domain.Product{}
+	for _, option := range options {
+		value, ok := values[option.Name]
+		if !ok {
+			return "", false
+		}
+		known := false
+		for _, optionValue := range option.Values {
+			known = known || optionValue.Value == value
+		}
+		if !known {
+			return "", false
+		}
+		labelled.OptionValues = append(labelled.OptionValues, domain.VariantOptionValue{Option: option.Name, Value: value})
+	}
+	return labelled.VariantLabel(), true
+}
+
 // Update Product
 func (service *ProductServiceImpl) Update(ctx context.Context, request web.ProductUpdateRequest) (web.ProductResponse, error) {
 	if err := service.Validate.Struct(request); err != nil {
@@ -52,7 +213,23 @@ func (service *ProductServiceImpl) Update(ctx context.Context, request web.Produ
 		return web.ProductResponse{}, err
 	}
 
+	if product.ParentID != nil {
+		return web.ProductResponse{}, exception.NewBadRequestError(fmt.Sprintf("Product %d is a variant, change it through product %d", product.ProductID, *product.ParentID))
+	}
+
+	tax, err := service.findTax(ctx, request.TaxID)
+	if err != nil {
+		return web.ProductResponse{}, err
+	}
+
 	product.Name = request.Name
+	product.Description = request.Description
+	product.Price = request.Price
+	product.CategoryId = uint64(request.CategoryID)
+	product.SKU = request.SKU
+	product.Barcode = request.Barcode
+	product.TaxID = &tax.TaxID
+	product.Tax = tax
 	updatedProduct, err := service.ProductRepository.Update(ctx, product)
 	if err != nil {
 		return web.ProductResponse{}, err
@@ -61,6 +238,57 @@ func (service *ProductServiceImpl) Update(ctx context.Context, request web.Produ
 	return helper.ToProductResponse(updatedProduct), nil
 }
 
+// UpdateVariant changes the SKU, barcode and price of one variant of a product
+func (service *ProductServiceImpl) UpdateVariant(ctx context.Context, request web.ProductVariantUpdateRequest) (web.ProductResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.ProductResponse{}, err
+	}
+
+	product, err := service.ProductRepository.FindById(ctx, request.ProductID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ProductResponse{}, exception.NewNotFoundError("Product not found")
+	} else if err != nil {
+		return web.ProductResponse{}, err
+	}
+
+	index := -1
+	for i, variant := range product.Variants {
+		if variant.ProductID == request.VariantID {
+			index = i
+		} else if variant.SKU == request.SKU {
+			return web.ProductResponse{}, exception.NewConflictError(fmt.Sprintf("SKU %s is already used by variant %d", request.SKU, variant.ProductID))
+		}
+	}
+	if index == -1 {
+		return web.ProductResponse{}, exception.NewNotFoundError("Variant not found")
+	}
+
+	variant := product.Variants[index]
+	variant.SKU = request.SKU
+	variant.Barcode = request.Barcode
+	variant.PriceOverride = request.Price
+	variant.Price = product.Price
+	if request.Price != nil {
+		variant.Price = *request.Price
+	}
+	updatedVariant, err := service.ProductRepository.Update(ctx, variant)
+	if err != nil {
+		return web.ProductResponse{}, err
+	}
+	product.Variants[index] = updatedVariant
+
+	return helper.ToProductResponse(product), nil
+}
+
+// findTax looks up the tax class a product is assigned to
+func (service *ProductServiceImpl) findTax(ctx context.Context, taxId uint64) (domain.Tax, error) {
+	tax, err := service.TaxRepository.FindById(ctx, taxId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Tax{}, exception.NewNotFoundError("Tax not found")
+	}
+	return tax, err
+}
+
 // Delete Product
 func (service *ProductServiceImpl) Delete(ctx context.Context, productId uint64) error {
 	product, err := service.ProductRepository.FindById(ctx, productId)
@@ -73,8 +301,17 @@ func (service *ProductServiceImpl) Delete(ctx context.Context, productId uint64)
 	return service.ProductRepository.Delete(ctx, product)
 }
 
-// Find Product By ID
-func (service *ProductServiceImpl) FindById(ctx context.Context, productId uint64) (web.ProductResponse, error) {
+// Find Product By ID as a store sells it when one is asked for, with the price converted when a currency is
+// asked for
+func (service *ProductServiceImpl) FindById(ctx context.Context, productId uint64, query web.ProductQuery) (web.ProductResponse, error) {
+	rate, err := service.rate(ctx, query.Currency)
+	if err != nil {
+		return web.ProductResponse{}, err
+	}
+	if err := service.findStore(ctx, query.StoreID); err != nil {
+		return web.ProductResponse{}, err
+	}
+
 	product, err := service.ProductRepository.FindById(ctx, productId)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return web.ProductResponse{}, exception.NewNotFoundError("Product not found")
@@ -82,15 +319,71 @@ func (service *ProductServiceImpl) FindById(ctx context.Context, productId uint6
 		return web.ProductResponse{}, err
 	}
 
-	return helper.ToProductResponse(product), nil
+	return convertPrice(productAtStore(product, query.StoreID), query.Currency, rate), nil
 }
 
-// Find All Categories
-func (service *ProductServiceImpl) FindAll(ctx context.Context) ([]web.ProductResponse, error) {
-	categories, err := service.ProductRepository.FindAll(ctx)
+// Find All Products, variants nested under their parent
+func (service *ProductServiceImpl) FindAll(ctx context.Context, query web.ProductQuery) ([]web.ProductResponse, error) {
+	rate, err := service.rate(ctx, query.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := service.findStore(ctx, query.StoreID); err != nil {
+		return nil, err
+	}
+
+	products, err := service.ProductRepository.FindCatalog(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return helper.ToProductResponses(categories), nil
+	var productResponses []web.ProductResponse
+	for _, product := range products {
+		productResponses = append(productResponses, convertPrice(productAtStore(product, query.StoreID), query.Currency, rate))
+	}
+	return productResponses, nil
+}
+
+// findStore checks the store products are asked for exists, no store is asked for when storeId is 0
+func (service *ProductServiceImpl) findStore(ctx context.Context, storeId uint64) error {
+	if storeId == 0 {
+		return nil
+	}
+	_, err := service.StoreService.FindById(ctx, storeId)
+	return err
+}
+
+// productAtStore is the response with the price and stock of the store, or of the chain when storeId is 0
+func productAtStore(product domain.Product, storeId uint64) web.ProductResponse {
+	if storeId == 0 {
+		return helper.ToProductResponse(product)
+	}
+	response := helper.ToProductResponse(product.AtStore(storeId))
+	response.StoreID = storeId
+	return response
+}
+
+// rate is the exchange rate in effect now of the currency prices are asked in, 0 when none is asked for
+func (service *ProductServiceImpl) rate(ctx context.Context, currency string) (float64, error) {
+	if currency == "" {
+		return 0, nil
+	}
+	if err := service.Validate.Var(currency, "len=3,uppercase"); err != nil {
+		return 0, exception.NewBadRequestError(fmt.Sprintf("Currency %q is not an ISO currency code", currency))
+	}
+	return service.ExchangeRateService.RateAt(ctx, currency, time.Now())
+}
+
+// convertPrice adds the price in currency at rate to the response
+func convertPrice(response web.ProductResponse, currency string, rate float64) web.ProductResponse {
+	if currency == "" {
+		return response
+	}
+	response.Currency = currency
+	response.ExchangeRate = rate
+	response.ConvertedPrice = response.Price.FromBase(rate)
+	for i := range response.Variants {
+		response.Variants[i].ConvertedPrice = response.Variants[i].Price.FromBase(rate)
+	}
+	return response
 }
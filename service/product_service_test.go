@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"github.com/Kahffi/go-rest-api-test/exception"
 	"github.com/Kahffi/go-rest-api-test/model/domain"
 	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
 	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 	"testing"
 )
 
@@ -16,10 +20,11 @@ var productResponseTpl = web.ProductResponse{
 	Id:          1,
 	Name:        "Barang mewwah",
 	Description: "mewah bingit",
-	Price:       10000,
+	Price:       money.New(10000),
 	StockQty:    100,
 	CategoryID:  32,
 	SKU:         "MWH",
+	TaxID:       1,
 	TaxRate:     10,
 }
 
@@ -27,12 +32,14 @@ var productModelTpl = domain.Product{
 	ProductID:   1,
 	Name:        "Barang mewwah",
 	Description: "mewah bingit",
-	Price:       10000,
+	Price:       money.New(10000),
 	StockQty:    100,
 	CategoryId:  32,
 	SKU:         "MWH",
-	TaxRate:     10,
+	TaxID:       &taxModelTpl.TaxID,
 	Category:    domain.Category{},
+	Tax:         taxModelTpl,
+	Stores:      []domain.StoreProduct{{StoreID: 1, ProductID: 1, StockQty: 100}},
 }
 
 func TestCreateProduct(t *testing.T) {
@@ -40,17 +47,19 @@ func TestCreateProduct(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockTaxRepo := mocks.NewMockTaxRepository(ctrl)
 	mockValidator := validator.New()
-	productService := NewProductService(mockRepo, mockValidator)
+	productService := NewProductService(mockRepo, mockTaxRepo, openStore(ctrl), nil, mockValidator)
 
 	productCreateReq := web.ProductCreateRequest{
 		Name:        "Barang mewwah",
 		Description: "mewah bingit",
-		Price:       10000,
+		Price:       money.New(10000),
 		StockQty:    100,
 		CategoryID:  32,
 		SKU:         "MWH",
-		TaxRate:     10,
+		TaxID:       1,
+		StoreID:     1,
 	}
 
 	tests := []struct {
@@ -64,7 +73,11 @@ func TestCreateProduct(t *testing.T) {
 			name:  "success",
 			input: productCreateReq,
 			mock: func() {
-				mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(productModelTpl, nil)
+				mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
+				mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, product domain.Product) (domain.Product, error) {
+					assert.Equal(t, []domain.StoreProduct{{StoreID: 1, StockQty: 100}}, product.Stores)
+					return productModelTpl, nil
+				})
 			},
 			expect:    productResponseTpl,
 			expectErr: false,
@@ -76,10 +89,20 @@ func TestCreateProduct(t *testing.T) {
 			expect:    web.ProductResponse{},
 			expectErr: true,
 		},
+		{
+			name:  "tax not found",
+			input: productCreateReq,
+			mock: func() {
+				mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Tax{}, gorm.ErrRecordNotFound)
+			},
+			expect:    web.ProductResponse{},
+			expectErr: true,
+		},
 		{
 			name:  "repository error",
 			input: productCreateReq,
 			mock: func() {
+				mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
 				mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(domain.Product{}, errors.New("database error"))
 			},
 			expect:    web.ProductResponse{},
@@ -106,7 +129,7 @@ func TestDeleteProduct(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockProductRepository(ctrl)
-	productService := NewProductService(mockRepo, validator.New())
+	productService := NewProductService(mockRepo, nil, nil, nil, validator.New())
 
 	tests := []struct {
 		name      string
@@ -151,36 +174,54 @@ func TestUpdateProduct(t *testing.T) {
 		Id:          1,
 		Name:        "Barang mewwah",
 		Description: "mewah bingit",
-		Price:       10000,
+		Price:       money.New(10000),
 		StockQty:    100,
 		CategoryID:  32,
 		SKU:         "MWH",
-		TaxRate:     10,
+		TaxID:       1,
 	}
 
 	tests := []struct {
 		name    string
-		mock    func(mockProductRepo *mocks.MockProductRepository)
+		mock    func(mockProductRepo *mocks.MockProductRepository, mockTaxRepo *mocks.MockTaxRepository)
 		input   web.ProductUpdateRequest
 		expects error
 	}{
 		{
 			name: "Success",
-			mock: func(mockProductRepo *mocks.MockProductRepository) {
+			mock: func(mockProductRepo *mocks.MockProductRepository, mockTaxRepo *mocks.MockTaxRepository) {
 				mockProductRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
 				mockProductRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(productModelTpl, nil)
 			},
 			input:   productUpdateReqTpl,
 			expects: nil,
 		},
+		{
+			name: "Tax Not Found",
+			mock: func(mockProductRepo *mocks.MockProductRepository, mockTaxRepo *mocks.MockTaxRepository) {
+				mockProductRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Tax{}, gorm.ErrRecordNotFound)
+			},
+			input:   productUpdateReqTpl,
+			expects: exception.NewNotFoundError("Tax not found"),
+		},
 		{
 			name: "Product Not Found",
-			mock: func(mockProductRepo *mocks.MockProductRepository) {
+			mock: func(mockProductRepo *mocks.MockProductRepository, mockTaxRepo *mocks.MockTaxRepository) {
 				mockProductRepo.EXPECT().FindById(gomock.Any(), productModelTpl.ProductID).Return(domain.Product{}, errors.New("product not found"))
 			},
 			input:   productUpdateReqTpl,
 			expects: errors.New("product not found"),
 		},
+		{
+			name: "Variant is changed through its parent",
+			mock: func(mockProductRepo *mocks.MockProductRepository, mockTaxRepo *mocks.MockTaxRepository) {
+				mockProductRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(variantModelTpl(11, "S"), nil)
+			},
+			input:   productUpdateReqTpl,
+			expects: exception.NewBadRequestError("Product 11 is a variant, change it through product 10"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -188,9 +229,10 @@ func TestUpdateProduct(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockProductRepo := mocks.NewMockProductRepository(ctrl)
-			tt.mock(mockProductRepo)
+			mockTaxRepo := mocks.NewMockTaxRepository(ctrl)
+			tt.mock(mockProductRepo, mockTaxRepo)
 
-			service := NewProductService(mockProductRepo, validator.New())
+			service := NewProductService(mockProductRepo, mockTaxRepo, nil, nil, validator.New())
 			_, err := service.Update(context.Background(), tt.input)
 			assert.Equal(t, tt.expects, err)
 		})
@@ -207,7 +249,7 @@ func TestFindAllProducts(t *testing.T) {
 		{
 			name: "Success",
 			mock: func(mockProductRepo *mocks.MockProductRepository) {
-				mockProductRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Product{productModelTpl}, nil)
+				mockProductRepo.EXPECT().FindCatalog(gomock.Any()).Return([]domain.Product{productModelTpl}, nil)
 			},
 			expects: []web.ProductResponse{productResponseTpl},
 			err:     nil,
@@ -215,7 +257,7 @@ func TestFindAllProducts(t *testing.T) {
 		{
 			name: "Database Error",
 			mock: func(mockProductRepo *mocks.MockProductRepository) {
-				mockProductRepo.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("database error"))
+				mockProductRepo.EXPECT().FindCatalog(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expects: nil,
 			err:     errors.New("database error"),
@@ -229,8 +271,8 @@ func TestFindAllProducts(t *testing.T) {
 			mockProductRepo := mocks.NewMockProductRepository(ctrl)
 			tt.mock(mockProductRepo)
 
-			service := NewProductService(mockProductRepo, validator.New())
-			result, err := service.FindAll(context.Background())
+			service := NewProductService(mockProductRepo, nil, nil, nil, validator.New())
+			result, err := service.FindAll(context.Background(), web.ProductQuery{})
 			assert.Equal(t, tt.expects, result)
 			assert.Equal(t, tt.err, err)
 		})
@@ -272,10 +314,344 @@ func TestFindByIdProduct(t *testing.T) {
 			mockProductRepo := mocks.NewMockProductRepository(ctrl)
 			tt.mock(mockProductRepo)
 
-			service := NewProductService(mockProductRepo, validator.New())
-			result, err := service.FindById(context.Background(), tt.input)
+			service := NewProductService(mockProductRepo, nil, nil, nil, validator.New())
+			result, err := service.FindById(context.Background(), tt.input, web.ProductQuery{})
+			assert.Equal(t, tt.expects, result)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFindProductInCurrency(t *testing.T) {
+	convertedResponse := productResponseTpl
+	convertedResponse.Currency = "USD"
+	convertedResponse.ExchangeRate = 15500
+	convertedResponse.ConvertedPrice = money.Money(65)
+
+	tests := []struct {
+		name     string
+		currency string
+		mock     func(mockProductRepo *mocks.MockProductRepository, rateService *servicemocks.MockExchangeRateService)
+		expects  web.ProductResponse
+		err      error
+	}{
+		{
+			name:     "Converted",
+			currency: "USD",
+			mock: func(mockProductRepo *mocks.MockProductRepository, rateService *servicemocks.MockExchangeRateService) {
+				rateService.EXPECT().RateAt(gomock.Any(), "USD", gomock.Any()).Return(15500.0, nil)
+				mockProductRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+			},
+			expects: convertedResponse,
+		},
+		{
+			name:     "No Rate",
+			currency: "SGD",
+			mock: func(mockProductRepo *mocks.MockProductRepository, rateService *servicemocks.MockExchangeRateService) {
+				rateService.EXPECT().RateAt(gomock.Any(), "SGD", gomock.Any()).
+					Return(0.0, exception.NewBadRequestError("No SGD exchange rate in effect on 2026-10-16"))
+			},
+			err: exception.NewBadRequestError("No SGD exchange rate in effect on 2026-10-16"),
+		},
+		{
+			name:     "Not A Currency Code",
+			currency: "DOLLAR",
+			mock: func(mockProductRepo *mocks.MockProductRepository, rateService *servicemocks.MockExchangeRateService) {
+			},
+			err: exception.NewBadRequestError(`Currency "DOLLAR" is not an ISO currency code`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProductRepo := mocks.NewMockProductRepository(ctrl)
+			rateService := servicemocks.NewMockExchangeRateService(ctrl)
+			tt.mock(mockProductRepo, rateService)
+
+			service := NewProductService(mockProductRepo, nil, nil, rateService, validator.New())
+			result, err := service.FindById(context.Background(), 1, web.ProductQuery{Currency: tt.currency})
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expects, result)
+		})
+	}
+}
+
+func TestFindProductAtStore(t *testing.T) {
+	storePrice := money.New(9500)
+	atStoreResponse := productResponseTpl
+	atStoreResponse.StoreID = 2
+	atStoreResponse.Price = storePrice
+	atStoreResponse.StockQty = 30
+
+	tests := []struct {
+		name    string
+		storeId uint64
+		mock    func(mockProductRepo *mocks.MockProductRepository, storeService *servicemocks.MockStoreService)
+		expects web.ProductResponse
+		err     error
+	}{
+		{
+			name:    "Store price and stock",
+			storeId: 2,
+			mock: func(mockProductRepo *mocks.MockProductRepository, storeService *servicemocks.MockStoreService) {
+				product := productModelTpl
+				product.StockQty = 130
+				product.Stores = []domain.StoreProduct{{StoreID: 1, StockQty: 100}, {StoreID: 2, StockQty: 30, Price: &storePrice}}
+				storeService.EXPECT().FindById(gomock.Any(), uint64(2)).Return(web.StoreResponse{Id: 2}, nil)
+				mockProductRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(product, nil)
+			},
+			expects: atStoreResponse,
+		},
+		{
+			name:    "Store Not Found",
+			storeId: 9,
+			mock: func(mockProductRepo *mocks.MockProductRepository, storeService *servicemocks.MockStoreService) {
+				storeService.EXPECT().FindById(gomock.Any(), uint64(9)).Return(web.StoreResponse{}, exception.NewNotFoundError("Store not found"))
+			},
+			err: exception.NewNotFoundError("Store not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProductRepo := mocks.NewMockProductRepository(ctrl)
+			storeService := servicemocks.NewMockStoreService(ctrl)
+			tt.mock(mockProductRepo, storeService)
+
+			service := NewProductService(mockProductRepo, nil, storeService, nil, validator.New())
+			result, err := service.FindById(context.Background(), 1, web.ProductQuery{StoreID: tt.storeId})
+			assert.Equal(t, tt.err, err)
 			assert.Equal(t, tt.expects, result)
+		})
+	}
+}
+
+// parentModelTpl is a T-shirt sold in two sizes, variantModelTpl builds one of its variants
+func parentModelTpl() domain.Product {
+	return domain.Product{
+		ProductID:  10,
+		Name:       "Kaos polos hitam",
+		Price:      money.New(50000),
+		CategoryId: 32,
+		SKU:        "KAOS",
+		TaxID:      &taxModelTpl.TaxID,
+		Tax:        taxModelTpl,
+		Options: []domain.ProductOption{{ProductOptionID: 1, ProductID: 10, Name: "Size", Values: []domain.ProductOptionValue{
+			{ProductOptionValueID: 1, ProductOptionID: 1, Value: "S"}, {ProductOptionValueID: 2, ProductOptionID: 1, Value: "M"},
+		}}},
+		Variants: []domain.Product{variantModelTpl(11, "S"), variantModelTpl(12, "M")},
+	}
+}
+
+func variantModelTpl(id uint64, size string) domain.Product {
+	parentId := uint64(10)
+	return domain.Product{
+		ProductID:    id,
+		ParentID:     &parentId,
+		Name:         "Kaos polos hitam (" + size + ")",
+		Price:        money.New(50000),
+		CategoryId:   32,
+		SKU:          "KAOS-" + size,
+		TaxID:        &taxModelTpl.TaxID,
+		Tax:          taxModelTpl,
+		Stores:       []domain.StoreProduct{{StoreID: 1, ProductID: id, StockQty: 4}, {StoreID: 2, ProductID: id, StockQty: 1}},
+		StockQty:     5,
+		OptionValues: []domain.VariantOptionValue{{ProductID: id, Option: "Size", Value: size}},
+	}
+}
+
+func TestCreateProductWithVariants(t *testing.T) {
+	price := money.New(55000)
+	request := web.ProductCreateRequest{
+		Name:       "Kaos polos hitam",
+		Price:      money.New(50000),
+		CategoryID: 32,
+		SKU:        "KAOS",
+		TaxID:      1,
+		StoreID:    1,
+		Options: []web.ProductOptionRequest{
+			{Name: "Size", Values: []string{"S", "M", "XL"}},
+			{Name: "Colour", Values: []string{"Black", "Off White"}},
+		},
+		Variants: []web.ProductVariantRequest{
+			{Options: map[string]string{"Size": "XL", "Colour": "Off White"}, SKU: "KAOS-XLOW", Barcode: "8991234567890", Price: &price, StockQty: 5},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input func() web.ProductCreateRequest
+		save  bool
+		err   error
+	}{
+		{
+			name:  "One variant for every combination",
+			input: func() web.ProductCreateRequest { return request },
+			save:  true,
+		},
+		{
+			name: "Variant that matches no combination",
+			input: func() web.ProductCreateRequest {
+				invalid := request
+				invalid.Variants = []web.ProductVariantRequest{{Options: map[string]string{"Size": "XXL", "Colour": "Black"}}}
+				return invalid
+			},
+			err: exception.NewBadRequestError("Variant map[Colour:Black Size:XXL] does not match the options of the product"),
+		},
+		{
+			name: "Option given twice",
+			input: func() web.ProductCreateRequest {
+				invalid := request
+				invalid.Options = []web.ProductOptionRequest{{Name: "Size", Values: []string{"S"}}, {Name: "Size", Values: []string{"M"}}}
+				return invalid
+			},
+			err: exception.NewBadRequestError("Option Size is given more than once"),
+		},
+		{
+			name: "SKU of the parent reused",
+			input: func() web.ProductCreateRequest {
+				invalid := request
+				invalid.Variants = []web.ProductVariantRequest{{Options: map[string]string{"Size": "S", "Colour": "Black"}, SKU: "KAOS"}}
+				return invalid
+			},
+			err: exception.NewBadRequestError("SKU KAOS is used more than once"),
+		},
+		{
+			name: "Variants without options",
+			input: func() web.ProductCreateRequest {
+				invalid := request
+				invalid.Options = nil
+				invalid.StockQty = 1
+				return invalid
+			},
+			err: exception.NewBadRequestError("Variants need the options they differ on"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRepo := mocks.NewMockProductRepository(ctrl)
+			mockTaxRepo := mocks.NewMockTaxRepository(ctrl)
+			mockTaxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
+			if tt.save {
+				mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, product domain.Product) (domain.Product, error) {
+					assert.Nil(t, product.Stores)
+					assert.Len(t, product.Options, 2)
+					assert.Len(t, product.Variants, 6)
+
+					first := product.Variants[0]
+					assert.Equal(t, "Kaos polos hitam (S / Black)", first.Name)
+					assert.Equal(t, "KAOS-S-BLACK", first.SKU)
+					assert.Equal(t, money.New(50000), first.Price)
+					assert.Nil(t, first.PriceOverride)
+					assert.Equal(t, []domain.StoreProduct{{StoreID: 1, StockQty: 0}}, first.Stores)
+
+					last := product.Variants[5]
+					assert.Equal(t, "KAOS-XLOW", last.SKU)
+					assert.Equal(t, "8991234567890", last.Barcode)
+					assert.Equal(t, price, last.Price)
+					assert.Equal(t, []domain.StoreProduct{{StoreID: 1, StockQty: 5}}, last.Stores)
+					assert.Equal(t, "KAOS-M-OFFWHITE", product.Variants[3].SKU)
+
+					for i := range product.Variants {
+						product.Variants[i].StockQty = product.Variants[i].Stores[0].StockQty
+					}
+					return product, nil
+				})
+			}
+
+			service := NewProductService(mockRepo, mockTaxRepo, openStore(ctrl), nil, validator.New())
+			result, err := service.Create(context.Background(), tt.input())
 			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, 5, result.StockQty)
+				assert.Equal(t, []web.ProductOptionResponse{{Name: "Size", Values: []string{"S", "M", "XL"}}, {Name: "Colour", Values: []string{"Black", "Off White"}}}, result.Options)
+				assert.Equal(t, map[string]string{"Size": "XL", "Colour": "Off White"}, result.Variants[5].Options)
+			}
 		})
 	}
 }
+
+func TestUpdateVariant(t *testing.T) {
+	price := money.New(45000)
+
+	tests := []struct {
+		name  string
+		input web.ProductVariantUpdateRequest
+		price money.Money
+		err   error
+	}{
+		{
+			name:  "Own price",
+			input: web.ProductVariantUpdateRequest{ProductID: 10, VariantID: 11, SKU: "KAOS-S2", Barcode: "8991234567891", Price: &price},
+			price: price,
+		},
+		{
+			name:  "Back to the price of the parent",
+			input: web.ProductVariantUpdateRequest{ProductID: 10, VariantID: 11, SKU: "KAOS-S"},
+			price: money.New(50000),
+		},
+		{
+			name:  "SKU of another variant",
+			input: web.ProductVariantUpdateRequest{ProductID: 10, VariantID: 11, SKU: "KAOS-M"},
+			err:   exception.NewConflictError("SKU KAOS-M is already used by variant 12"),
+		},
+		{
+			name:  "Variant of another product",
+			input: web.ProductVariantUpdateRequest{ProductID: 10, VariantID: 99, SKU: "KAOS-X"},
+			err:   exception.NewNotFoundError("Variant not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRepo := mocks.NewMockProductRepository(ctrl)
+			mockRepo.EXPECT().FindById(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			if tt.err == nil {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, variant domain.Product) (domain.Product, error) {
+					assert.Equal(t, uint64(11), variant.ProductID)
+					assert.Equal(t, tt.input.Price, variant.PriceOverride)
+					return variant, nil
+				})
+			}
+
+			service := NewProductService(mockRepo, nil, nil, nil, validator.New())
+			result, err := service.UpdateVariant(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, tt.input.SKU, result.Variants[0].SKU)
+				assert.Equal(t, tt.price, result.Variants[0].Price)
+				assert.Equal(t, tt.input.Barcode, result.Variants[0].Barcode)
+			}
+		})
+	}
+}
+
+func TestFindProductWithVariantsAtStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockRepo.EXPECT().FindById(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil).Times(2)
+	storeService := servicemocks.NewMockStoreService(ctrl)
+	storeService.EXPECT().FindById(gomock.Any(), uint64(2)).Return(web.StoreResponse{Id: 2}, nil)
+
+	service := NewProductService(mockRepo, nil, storeService, nil, validator.New())
+	chain, err := service.FindById(context.Background(), 10, web.ProductQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, chain.StockQty)
+	assert.Len(t, chain.Variants, 2)
+
+	atStore, err := service.FindById(context.Background(), 10, web.ProductQuery{StoreID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, atStore.StockQty)
+	assert.Equal(t, 1, atStore.Variants[1].StockQty)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"time"
+)
+
+type PromotionService interface {
+	Create(ctx context.Context, request web.PromotionCreateRequest) (web.PromotionResponse, error)
+	Update(ctx context.Context, request web.PromotionUpdateRequest) (web.PromotionResponse, error)
+	Delete(ctx context.Context, promotionId uint64) error
+	FindById(ctx context.Context, promotionId uint64) (web.PromotionResponse, error)
+	FindAll(ctx context.Context) ([]web.PromotionResponse, error)
+	ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error
+}
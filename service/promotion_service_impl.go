@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type PromotionServiceImpl struct {
+	PromotionRepository repository.PromotionRepository
+	ProductRepository   repository.ProductRepository
+	Validate            *validator.Validate
+}
+
+func NewPromotionService(promotionRepository repository.PromotionRepository, productRepository repository.ProductRepository,
+	validate *validator.Validate) PromotionService {
+	return &PromotionServiceImpl{
+		PromotionRepository: promotionRepository,
+		ProductRepository:   productRepository,
+		Validate:            validate,
+	}
+}
+
+// Create Promotion
+func (service *PromotionServiceImpl) Create(ctx context.Context, request web.PromotionCreateRequest) (web.PromotionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	promotion := domain.Promotion{
+		Name:        request.Name,
+		Type:        request.Type,
+		BuyQty:      request.BuyQty,
+		FreeQty:     request.FreeQty,
+		BundlePrice: request.BundlePrice,
+		MinQty:      request.MinQty,
+		DiscountPct: request.DiscountPct,
+		ValidFrom:   request.ValidFrom,
+		ValidUntil:  request.ValidUntil,
+	}
+	if err := service.setProducts(ctx, &promotion, request.ProductIDs); err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	savedPromotion, err := service.PromotionRepository.Save(ctx, promotion)
+	if err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	return helper.ToPromotionResponse(savedPromotion), nil
+}
+
+// Update Promotion
+func (service *PromotionServiceImpl) Update(ctx context.Context, request web.PromotionUpdateRequest) (web.PromotionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	promotion, err := service.PromotionRepository.FindById(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.PromotionResponse{}, exception.NewNotFoundError("Promotion not found")
+	} else if err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	promotion.Name = request.Name
+	promotion.Type = request.Type
+	promotion.BuyQty = request.BuyQty
+	promotion.FreeQty = request.FreeQty
+	promotion.BundlePrice = request.BundlePrice
+	promotion.MinQty = request.MinQty
+	promotion.DiscountPct = request.DiscountPct
+	promotion.ValidFrom = request.ValidFrom
+	promotion.ValidUntil = request.ValidUntil
+	if err := service.setProducts(ctx, &promotion, request.ProductIDs); err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	updatedPromotion, err := service.PromotionRepository.Update(ctx, promotion)
+	if err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	return helper.ToPromotionResponse(updatedPromotion), nil
+}
+
+// setProducts links the promotion to its products, checking that they exist and that a bundle has more than one
+func (service *PromotionServiceImpl) setProducts(ctx context.Context, promotion *domain.Promotion, productIds []uint64) error {
+	promotion.Products = []domain.Product{}
+	seen := make(map[uint64]bool)
+	for _, productId := range productIds {
+		if seen[productId] {
+			continue
+		}
+		seen[productId] = true
+
+		product, err := service.ProductRepository.FindById(ctx, productId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError(fmt.Sprintf("Product %d not found", productId))
+		} else if err != nil {
+			return err
+		}
+		promotion.Products = append(promotion.Products, product)
+	}
+
+	if promotion.Type == domain.PromotionTypeBundle && len(promotion.Products) < 2 {
+		return exception.NewBadRequestError("A bundle needs at least two different products")
+	}
+	return nil
+}
+
+// Delete Promotion
+func (service *PromotionServiceImpl) Delete(ctx context.Context, promotionId uint64) error {
+	promotion, err := service.PromotionRepository.FindById(ctx, promotionId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Promotion not found")
+	} else if err != nil {
+		return err
+	}
+
+	return service.PromotionRepository.Delete(ctx, promotion)
+}
+
+// Find Promotion By ID
+func (service *PromotionServiceImpl) FindById(ctx context.Context, promotionId uint64) (web.PromotionResponse, error) {
+	promotion, err := service.PromotionRepository.FindById(ctx, promotionId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.PromotionResponse{}, exception.NewNotFoundError("Promotion not found")
+	} else if err != nil {
+		return web.PromotionResponse{}, err
+	}
+
+	return helper.ToPromotionResponse(promotion), nil
+}
+
+// Find All Promotions
+func (service *PromotionServiceImpl) FindAll(ctx context.Context) ([]web.PromotionResponse, error) {
+	promotions, err := service.PromotionRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToPromotionResponses(promotions), nil
+}
+
+// ApplyToOrder evaluates the promotions valid at the given time against the order lines and records an
+// adjustment for every line a promotion changes. Items must have TotalPrice set.
+//
+// A unit can only be used by one promotion. When rules compete for the same units the one saving the
+// customer the most is applied first, the oldest promotion winning a tie, and the remaining units are
+// offered to the other rules again until none of them saves anything.
+func (service *PromotionServiceImpl) ApplyToOrder(ctx context.Context, order *domain.Order, at time.Time) error {
+	promotions, err := service.PromotionRepository.FindActive(ctx, at)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(promotions, func(i, j int) bool { return promotions[i].PromotionID < promotions[j].PromotionID })
+
+	lines := make(map[uint64]*promotionLine)
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		item.PromotionAmount = 0
+		lines[item.ProductID] = &promotionLine{item: item, remaining: item.Quantity}
+	}
+	order.Adjustments = nil
+
+	applied := make(map[uint64]bool)
+	for {
+		var best *promotionCandidate
+		for i := range promotions {
+			promotion := &promotions[i]
+			if applied[promotion.PromotionID] || at.Before(promotion.ValidFrom) || at.After(promotion.ValidUntil) {
+				continue
+			}
+			candidate := evaluatePromotion(promotion, lines)
+			if candidate != nil && (best == nil || candidate.saving > best.saving) {
+				best = candidate
+			}
+		}
+		if best == nil {
+			break
+		}
+
+		applied[best.promotion.PromotionID] = true
+		for _, adjustment := range best.adjustments {
+			line := lines[adjustment.ProductID]
+			line.remaining -= adjustment.Quantity
+			line.item.PromotionAmount += adjustment.Amount
+			order.Adjustments = append(order.Adjustments, adjustment)
+		}
+	}
+	return nil
+}
+
+// promotionLine tracks how many units of an order line are still free for promotions
+type promotionLine struct {
+	item      *domain.OrderItem
+	remaining int
+}
+
+type promotionCandidate struct {
+	promotion   *domain.Promotion
+	saving      money.Money
+	adjustments []domain.OrderAdjustment
+}
+
+// promotionMembers gathers, for every product of the promotion, the order lines with units still available
+// that sell it. A product with variants is sold through the lines of its variants.
+func promotionMembers(promotion *domain.Promotion, lines map[uint64]*promotionLine) [][]*promotionLine {
+	members := make([][]*promotionLine, 0, len(promotion.Products))
+	for _, product := range promotion.Products {
+		var member []*promotionLine
+		for _, line := range lines {
+			parentId := line.item.Product.ParentID
+			if line.remaining > 0 && (line.item.ProductID == product.ProductID || (parentId != nil && *parentId == product.ProductID)) {
+				member = append(member, line)
+			}
+		}
+		sort.Slice(member, func(i, j int) bool { return member[i].item.ProductID < member[j].item.ProductID })
+		members = append(members, member)
+	}
+	return members
+}
+
+// evaluatePromotion works out what the promotion would save on the units still available, nil when nothing.
+// Variants of a product are counted line by line, a bundle takes each of its products from the first line
+// that still has a unit of it.
+func evaluatePromotion(promotion *domain.Promotion, lines map[uint64]*promotionLine) *promotionCandidate {
+	members := promotionMembers(promotion, lines)
+	var products []*promotionLine
+	seen := make(map[uint64]bool)
+	for _, member := range members {
+		for _, line := range member {
+			if !seen[line.item.ProductID] {
+				seen[line.item.ProductID] = true
+				products = append(products, line)
+			}
+		}
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].item.ProductID < products[j].item.ProductID })
+
+	candidate := &promotionCandidate{promotion: promotion}
+	adjust := func(line *promotionLine, quantity int, amount money.Money, explanation string) {
+		if amount <= 0 {
+			return
+		}
+		candidate.saving += amount
+		candidate.adjustments = append(candidate.adjustments, domain.OrderAdjustment{
+			ProductID:     line.item.ProductID,
+			PromotionID:   promotion.PromotionID,
+			PromotionName: promotion.Name,
+			Quantity:      quantity,
+			Amount:        amount,
+			Explanation:   explanation,
+		})
+	}
+
+	switch promotion.Type {
+	case domain.PromotionTypeBuyXGetY:
+		setSize := promotion.BuyQty + promotion.FreeQty
+		if promotion.FreeQty <= 0 || setSize <= 0 {
+			return nil
+		}
+		for _, line := range products {
+			sets := line.remaining / setSize
+			if sets == 0 {
+				continue
+			}
+			free := sets * promotion.FreeQty
+			adjust(line, sets*setSize, line.item.UnitPrice.Times(free),
+				fmt.Sprintf("Buy %d get %d free: %d free", promotion.BuyQty, promotion.FreeQty, free))
+		}
+	case domain.PromotionTypeBundle:
+		if len(members) < 2 {
+			return nil
+		}
+		// Bundles made of the same lines are grouped, sets is how many of each group there are
+		var groups [][]*promotionLine
+		sets := make(map[string]int)
+		remaining := make(map[uint64]int, len(products))
+		for _, line := range products {
+			remaining[line.item.ProductID] = line.remaining
+		}
+		for {
+			bundle := make([]*promotionLine, 0, len(members))
+			for _, member := range members {
+				for _, line := range member {
+					if remaining[line.item.ProductID] > 0 {
+						remaining[line.item.ProductID]--
+						bundle = append(bundle, line)
+						break
+					}
+				}
+			}
+			if len(bundle) < len(members) {
+				break
+			}
+			sort.Slice(bundle, func(i, j int) bool { return bundle[i].item.ProductID < bundle[j].item.ProductID })
+			key := fmt.Sprint(bundleIds(bundle))
+			if sets[key] == 0 {
+				groups = append(groups, bundle)
+			}
+			sets[key]++
+		}
+
+		quantities := make(map[uint64]int)
+		savings := make(map[uint64]money.Money)
+		total := 0
+		for _, bundle := range groups {
+			count := sets[fmt.Sprint(bundleIds(bundle))]
+			var regular money.Money
+			unitPrices := make([]money.Money, len(bundle))
+			for i, line := range bundle {
+				regular += line.item.UnitPrice
+				unitPrices[i] = line.item.UnitPrice
+			}
+			saving := (regular - promotion.BundlePrice).Times(count)
+			if saving <= 0 {
+				continue
+			}
+			total += count
+			// The saving is shared out in proportion to the unit prices and always adds up to it exactly
+			for i, share := range money.Allocate(saving, unitPrices) {
+				quantities[bundle[i].item.ProductID] += count
+				savings[bundle[i].item.ProductID] += share
+			}
+		}
+		explanation := fmt.Sprintf("%d bundle(s) at %s", total, promotion.BundlePrice)
+		for _, line := range products {
+			if quantities[line.item.ProductID] > 0 {
+				adjust(line, quantities[line.item.ProductID], savings[line.item.ProductID], explanation)
+			}
+		}
+	case domain.PromotionTypeQuantityBreak:
+		if promotion.MinQty <= 0 {
+			return nil
+		}
+		for _, line := range products {
+			if line.remaining < promotion.MinQty {
+				continue
+			}
+			adjust(line, line.remaining, line.item.UnitPrice.Times(line.remaining).Percent(promotion.DiscountPct),
+				fmt.Sprintf("%s%% off %d or more", strconv.FormatFloat(promotion.DiscountPct, 'f', -1, 64), promotion.MinQty))
+		}
+	}
+
+	if len(candidate.adjustments) == 0 {
+		return nil
+	}
+	return candidate
+}
+
+// bundleIds lists the products of the lines a bundle is made of
+func bundleIds(bundle []*promotionLine) []uint64 {
+	ids := make([]uint64, len(bundle))
+	for i, line := range bundle {
+		ids[i] = line.item.ProductID
+	}
+	return ids
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// newPromotionService builds a promotion service that only needs promotion lookups
+func newPromotionService(promotionRepo *mocks.MockPromotionRepository) PromotionService {
+	return NewPromotionService(promotionRepo, nil, validator.New())
+}
+
+func promotionTpl(id uint64, promotionType string, productIds ...uint64) domain.Promotion {
+	promotion := domain.Promotion{
+		PromotionID: id,
+		Name:        promotionType,
+		Type:        promotionType,
+		ValidFrom:   time.Now().AddDate(0, 0, -1),
+		ValidUntil:  time.Now().AddDate(0, 0, 1),
+	}
+	for _, productId := range productIds {
+		promotion.Products = append(promotion.Products, domain.Product{ProductID: productId})
+	}
+	return promotion
+}
+
+func TestApplyPromotionsToOrder(t *testing.T) {
+	buyTwoGetOne := promotionTpl(1, domain.PromotionTypeBuyXGetY, 1)
+	buyTwoGetOne.BuyQty, buyTwoGetOne.FreeQty = 2, 1
+
+	bundle := promotionTpl(2, domain.PromotionTypeBundle, 1, 2)
+	bundle.BundlePrice = money.New(12000)
+
+	quantityBreak := promotionTpl(3, domain.PromotionTypeQuantityBreak, 1)
+	quantityBreak.MinQty, quantityBreak.DiscountPct = 5, 10
+
+	sameQuantityBreak := quantityBreak
+	sameQuantityBreak.PromotionID = 4
+
+	parentBundle := promotionTpl(6, domain.PromotionTypeBundle, 10, 2)
+	parentBundle.BundlePrice = money.New(12000)
+
+	parentQuantityBreak := promotionTpl(7, domain.PromotionTypeQuantityBreak, 10)
+	parentQuantityBreak.MinQty, parentQuantityBreak.DiscountPct = 3, 10
+
+	expired := promotionTpl(5, domain.PromotionTypeQuantityBreak, 1)
+	expired.MinQty, expired.DiscountPct = 1, 90
+	expired.ValidUntil = time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name        string
+		promotions  []domain.Promotion
+		items       []domain.OrderItem
+		adjustments []domain.OrderAdjustment
+	}{
+		{
+			name:       "Buy two get one free",
+			promotions: []domain.Promotion{buyTwoGetOne},
+			items:      []domain.OrderItem{{ProductID: 1, Quantity: 7, UnitPrice: money.New(10000), TotalPrice: money.New(70000)}},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 1, PromotionID: 1, PromotionName: "BuyXGetY", Quantity: 6, Amount: money.New(20000), Explanation: "Buy 2 get 1 free: 2 free"},
+			},
+		},
+		{
+			name:       "Bundle saving shared by unit price",
+			promotions: []domain.Promotion{bundle},
+			items: []domain.OrderItem{
+				{ProductID: 1, Quantity: 2, UnitPrice: money.New(10000), TotalPrice: money.New(20000)},
+				{ProductID: 2, Quantity: 1, UnitPrice: money.New(5000), TotalPrice: money.New(5000)},
+			},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 1, PromotionID: 2, PromotionName: "Bundle", Quantity: 1, Amount: money.New(2000), Explanation: "1 bundle(s) at 12000.00"},
+				{ProductID: 2, PromotionID: 2, PromotionName: "Bundle", Quantity: 1, Amount: money.New(1000), Explanation: "1 bundle(s) at 12000.00"},
+			},
+		},
+		{
+			name:       "Bigger saving wins the shared units",
+			promotions: []domain.Promotion{bundle, quantityBreak, expired},
+			items: []domain.OrderItem{
+				{ProductID: 1, Quantity: 5, UnitPrice: money.New(10000), TotalPrice: money.New(50000)},
+				{ProductID: 2, Quantity: 1, UnitPrice: money.New(5000), TotalPrice: money.New(5000)},
+			},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 1, PromotionID: 3, PromotionName: "QuantityBreak", Quantity: 5, Amount: money.New(5000), Explanation: "10% off 5 or more"},
+			},
+		},
+		{
+			name:       "Oldest promotion wins a tie",
+			promotions: []domain.Promotion{sameQuantityBreak, quantityBreak},
+			items:      []domain.OrderItem{{ProductID: 1, Quantity: 5, UnitPrice: money.New(10000), TotalPrice: money.New(50000)}},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 1, PromotionID: 3, PromotionName: "QuantityBreak", Quantity: 5, Amount: money.New(5000), Explanation: "10% off 5 or more"},
+			},
+		},
+		{
+			name:       "Bundle of a parent takes its variants",
+			promotions: []domain.Promotion{parentBundle},
+			items: []domain.OrderItem{
+				{ProductID: 11, Quantity: 1, UnitPrice: money.New(10000), TotalPrice: money.New(10000), Product: variantModelTpl(11, "S")},
+				{ProductID: 12, Quantity: 1, UnitPrice: money.New(10000), TotalPrice: money.New(10000), Product: variantModelTpl(12, "M")},
+				{ProductID: 2, Quantity: 3, UnitPrice: money.New(5000), TotalPrice: money.New(15000)},
+			},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 2, PromotionID: 6, PromotionName: "Bundle", Quantity: 2, Amount: money.New(2000), Explanation: "2 bundle(s) at 12000.00"},
+				{ProductID: 11, PromotionID: 6, PromotionName: "Bundle", Quantity: 1, Amount: money.New(2000), Explanation: "2 bundle(s) at 12000.00"},
+				{ProductID: 12, PromotionID: 6, PromotionName: "Bundle", Quantity: 1, Amount: money.New(2000), Explanation: "2 bundle(s) at 12000.00"},
+			},
+		},
+		{
+			name:       "Quantity break of a parent counts each variant",
+			promotions: []domain.Promotion{parentQuantityBreak},
+			items: []domain.OrderItem{
+				{ProductID: 11, Quantity: 3, UnitPrice: money.New(10000), TotalPrice: money.New(30000), Product: variantModelTpl(11, "S")},
+				{ProductID: 12, Quantity: 2, UnitPrice: money.New(10000), TotalPrice: money.New(20000), Product: variantModelTpl(12, "M")},
+			},
+			adjustments: []domain.OrderAdjustment{
+				{ProductID: 11, PromotionID: 7, PromotionName: "QuantityBreak", Quantity: 3, Amount: money.New(3000), Explanation: "10% off 3 or more"},
+			},
+		},
+		{
+			name:       "Below the quantity break",
+			promotions: []domain.Promotion{quantityBreak},
+			items:      []domain.OrderItem{{ProductID: 1, Quantity: 4, UnitPrice: money.New(10000), TotalPrice: money.New(40000)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			promotionRepo := mocks.NewMockPromotionRepository(ctrl)
+			promotionRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(tt.promotions, nil)
+
+			order := domain.Order{OrderItems: tt.items}
+			err := newPromotionService(promotionRepo).ApplyToOrder(context.Background(), &order, time.Now())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.adjustments, order.Adjustments)
+
+			var total money.Money
+			for _, item := range order.OrderItems {
+				total += item.PromotionAmount
+			}
+			var expected money.Money
+			for _, adjustment := range tt.adjustments {
+				expected += adjustment.Amount
+			}
+			assert.Equal(t, expected, total)
+		})
+	}
+}
+
+func TestDiscountSkipsPromotedLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	discountRepo := mocks.NewMockDiscountRepository(ctrl)
+	discountRepo.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return([]domain.Discount{discountModelTpl}, nil)
+
+	order := domain.Order{OrderItems: []domain.OrderItem{
+		{ProductID: 1, TotalPrice: money.New(10000), PromotionAmount: money.New(1000)},
+		{ProductID: 2, TotalPrice: money.New(10000)},
+	}}
+	assert.NoError(t, newDiscountService(discountRepo).ApplyToOrder(context.Background(), &order, time.Now()))
+	assert.Nil(t, order.OrderItems[0].DiscountID)
+	assert.Equal(t, money.New(1000), order.OrderItems[1].DiscountAmount)
+}
+
+func TestCreateBundleNeedsTwoProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	productRepo := mocks.NewMockProductRepository(ctrl)
+	productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+
+	service := NewPromotionService(mocks.NewMockPromotionRepository(ctrl), productRepo, validator.New())
+	_, err := service.Create(context.Background(), web.PromotionCreateRequest{
+		Name: "Lonely bundle", Type: domain.PromotionTypeBundle, BundlePrice: money.New(5000), ProductIDs: []uint64{1, 1},
+		ValidFrom: time.Now(), ValidUntil: time.Now().AddDate(0, 1, 0),
+	})
+	assert.Equal(t, exception.NewBadRequestError("A bundle needs at least two different products"), err)
+}
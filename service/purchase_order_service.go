@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type PurchaseOrderService interface {
+	Create(ctx context.Context, request web.PurchaseOrderCreateRequest) (web.PurchaseOrderResponse, error)
+	Update(ctx context.Context, request web.PurchaseOrderUpdateRequest) (web.PurchaseOrderResponse, error)
+	Delete(ctx context.Context, purchaseOrderId uint64) error
+	Send(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error)
+	Receive(ctx context.Context, request web.PurchaseOrderReceiveRequest) (web.PurchaseOrderResponse, error)
+	FindById(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.PurchaseOrderResponse, error)
+}
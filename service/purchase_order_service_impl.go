@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type PurchaseOrderServiceImpl struct {
+	TxManager               repository.TxManager
+	PurchaseOrderRepository repository.PurchaseOrderRepository
+	SupplierRepository      repository.SupplierRepository
+	ProductRepository       repository.ProductRepository
+	EmployeeRepository      repository.EmployeeRepository
+	StoreService            StoreService
+	Validate                *validator.Validate
+}
+
+func NewPurchaseOrderService(txManager repository.TxManager, purchaseOrderRepository repository.PurchaseOrderRepository,
+	supplierRepository repository.SupplierRepository, productRepository repository.ProductRepository,
+	employeeRepository repository.EmployeeRepository, storeService StoreService, validate *validator.Validate) PurchaseOrderService {
+	return &PurchaseOrderServiceImpl{
+		TxManager:               txManager,
+		PurchaseOrderRepository: purchaseOrderRepository,
+		SupplierRepository:      supplierRepository,
+		ProductRepository:       productRepository,
+		EmployeeRepository:      employeeRepository,
+		StoreService:            storeService,
+		Validate:                validate,
+	}
+}
+
+// Create Purchase Order as a draft, delivered to one store
+func (service *PurchaseOrderServiceImpl) Create(ctx context.Context, request web.PurchaseOrderCreateRequest) (web.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	supplier, err := service.findSupplier(ctx, request.SupplierID)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	lines, err := service.toLines(ctx, request.Lines)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	purchaseOrder := domain.PurchaseOrder{
+		SupplierID: supplier.SupplierID,
+		StoreID:    request.StoreID,
+		Status:     domain.PurchaseOrderStatusDraft,
+		Note:       request.Note,
+		Lines:      lines,
+	}
+	savedPurchaseOrder, err := service.PurchaseOrderRepository.Save(ctx, purchaseOrder)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	savedPurchaseOrder.Supplier = supplier
+
+	return helper.ToPurchaseOrderResponse(savedPurchaseOrder), nil
+}
+
+// Update a draft Purchase Order, replacing its lines
+func (service *PurchaseOrderServiceImpl) Update(ctx context.Context, request web.PurchaseOrderUpdateRequest) (web.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	purchaseOrder, err := service.findPurchaseOrder(ctx, request.Id)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	if purchaseOrder.Status != domain.PurchaseOrderStatusDraft {
+		return web.PurchaseOrderResponse{}, exception.NewConflictError(fmt.Sprintf("Purchase order cannot be changed while %s", purchaseOrder.Status))
+	}
+
+	supplier, err := service.findSupplier(ctx, request.SupplierID)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	lines, err := service.toLines(ctx, request.Lines)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	purchaseOrder.SupplierID = supplier.SupplierID
+	purchaseOrder.Supplier = supplier
+	purchaseOrder.StoreID = request.StoreID
+	purchaseOrder.Note = request.Note
+	purchaseOrder.Lines = lines
+	updatedPurchaseOrder, err := service.PurchaseOrderRepository.Update(ctx, purchaseOrder)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	return helper.ToPurchaseOrderResponse(updatedPurchaseOrder), nil
+}
+
+// Delete a draft Purchase Order
+func (service *PurchaseOrderServiceImpl) Delete(ctx context.Context, purchaseOrderId uint64) error {
+	purchaseOrder, err := service.findPurchaseOrder(ctx, purchaseOrderId)
+	if err != nil {
+		return err
+	}
+	if purchaseOrder.Status != domain.PurchaseOrderStatusDraft {
+		return exception.NewConflictError(fmt.Sprintf("Purchase order cannot be deleted while %s", purchaseOrder.Status))
+	}
+
+	return service.PurchaseOrderRepository.Delete(ctx, purchaseOrder)
+}
+
+// Send a draft Purchase Order to its supplier, after which its lines are fixed
+func (service *PurchaseOrderServiceImpl) Send(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error) {
+	purchaseOrder, err := service.findPurchaseOrder(ctx, purchaseOrderId)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	if purchaseOrder.Status != domain.PurchaseOrderStatusDraft {
+		return web.PurchaseOrderResponse{}, exception.NewConflictError(fmt.Sprintf("Purchase order cannot be sent while %s", purchaseOrder.Status))
+	}
+
+	sentAt := time.Now()
+	purchaseOrder.Status = domain.PurchaseOrderStatusSent
+	purchaseOrder.SentAt = &sentAt
+	sentPurchaseOrder, err := service.PurchaseOrderRepository.UpdateStatus(ctx, purchaseOrder)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	return helper.ToPurchaseOrderResponse(sentPurchaseOrder), nil
+}
+
+// Receive books a delivery against a sent Purchase Order. Every received quantity goes into stock at the
+// store of the order, also what arrives on top of the ordered quantity, and is added to the line so short
+// and over deliveries stay visible per line. The order is received once every line is complete or when it
+// is closed.
+func (service *PurchaseOrderServiceImpl) Receive(ctx context.Context, request web.PurchaseOrderReceiveRequest) (web.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+	if request.EmployeeID != nil {
+		_, err := service.EmployeeRepository.FindById(ctx, *request.EmployeeID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.PurchaseOrderResponse{}, exception.NewNotFoundError("Employee not found")
+		} else if err != nil {
+			return web.PurchaseOrderResponse{}, err
+		}
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The row lock keeps two deliveries booked at once from losing each other's quantities
+		purchaseOrder, err := service.PurchaseOrderRepository.FindByIdForUpdate(ctx, request.PurchaseOrderID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Purchase order not found")
+		} else if err != nil {
+			return err
+		}
+
+		if purchaseOrder.Status != domain.PurchaseOrderStatusSent && purchaseOrder.Status != domain.PurchaseOrderStatusPartiallyReceived {
+			return exception.NewConflictError(fmt.Sprintf("Purchase order cannot be received while %s", purchaseOrder.Status))
+		}
+
+		lineIndex := make(map[uint64]int, len(purchaseOrder.Lines))
+		for i, line := range purchaseOrder.Lines {
+			lineIndex[line.ProductID] = i
+		}
+
+		reference := fmt.Sprintf("Purchase order #%d", purchaseOrder.PurchaseOrderID)
+		if request.Reference != "" {
+			reference += ", " + request.Reference
+		}
+
+		for _, received := range request.Lines {
+			i, ok := lineIndex[received.ProductID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is not on purchase order #%d", received.ProductID, purchaseOrder.PurchaseOrderID))
+			}
+
+			purchaseOrder.Lines[i].ReceivedQty += received.Quantity
+			if err := service.PurchaseOrderRepository.UpdateReceivedQty(ctx, purchaseOrder.Lines[i]); err != nil {
+				return err
+			}
+
+			_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+				ProductID:  received.ProductID,
+				StoreID:    purchaseOrder.StoreID,
+				Delta:      received.Quantity,
+				Reason:     domain.StockReasonRestock,
+				Reference:  reference,
+				EmployeeID: request.EmployeeID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		purchaseOrder.Status = domain.PurchaseOrderStatusPartiallyReceived
+		if request.Close || purchaseOrder.FullyReceived() {
+			receivedAt := time.Now()
+			purchaseOrder.Status = domain.PurchaseOrderStatusReceived
+			purchaseOrder.ReceivedAt = &receivedAt
+		}
+		_, err = service.PurchaseOrderRepository.UpdateStatus(ctx, purchaseOrder)
+		return err
+	})
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	return service.FindById(ctx, request.PurchaseOrderID)
+}
+
+// Find Purchase Order By ID
+func (service *PurchaseOrderServiceImpl) FindById(ctx context.Context, purchaseOrderId uint64) (web.PurchaseOrderResponse, error) {
+	purchaseOrder, err := service.findPurchaseOrder(ctx, purchaseOrderId)
+	if err != nil {
+		return web.PurchaseOrderResponse{}, err
+	}
+
+	return helper.ToPurchaseOrderResponse(purchaseOrder), nil
+}
+
+// Find All Purchase Orders delivered to a store, or to any store when storeId is 0
+func (service *PurchaseOrderServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.PurchaseOrderResponse, error) {
+	purchaseOrders, err := service.PurchaseOrderRepository.FindAll(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToPurchaseOrderResponses(purchaseOrders), nil
+}
+
+// toLines checks every product exists and is ordered only once
+func (service *PurchaseOrderServiceImpl) toLines(ctx context.Context, requests []web.PurchaseOrderLineRequest) ([]domain.PurchaseOrderLine, error) {
+	lines := make([]domain.PurchaseOrderLine, 0, len(requests))
+	seen := make(map[uint64]bool, len(requests))
+	for _, request := range requests {
+		if seen[request.ProductID] {
+			return nil, exception.NewBadRequestError(fmt.Sprintf("Product %d is on the purchase order more than once", request.ProductID))
+		}
+		seen[request.ProductID] = true
+
+		product, err := service.ProductRepository.FindById(ctx, request.ProductID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.NewNotFoundError(fmt.Sprintf("Product %d not found", request.ProductID))
+		} else if err != nil {
+			return nil, err
+		}
+		if product.HasVariants() {
+			return nil, exception.NewBadRequestError(fmt.Sprintf("Product %d has variants, order one of them", product.ProductID))
+		}
+
+		lines = append(lines, domain.PurchaseOrderLine{
+			ProductID:  product.ProductID,
+			OrderedQty: request.OrderedQty,
+			UnitCost:   request.UnitCost,
+			Product:    product,
+		})
+	}
+	return lines, nil
+}
+
+func (service *PurchaseOrderServiceImpl) findSupplier(ctx context.Context, supplierId uint64) (domain.Supplier, error) {
+	supplier, err := service.SupplierRepository.FindById(ctx, supplierId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Supplier{}, exception.NewNotFoundError("Supplier not found")
+	}
+	return supplier, err
+}
+
+func (service *PurchaseOrderServiceImpl) findPurchaseOrder(ctx context.Context, purchaseOrderId uint64) (domain.PurchaseOrder, error) {
+	purchaseOrder, err := service.PurchaseOrderRepository.FindById(ctx, purchaseOrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.PurchaseOrder{}, exception.NewNotFoundError("Purchase order not found")
+	}
+	return purchaseOrder, err
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var purchaseOrderModelTpl = domain.PurchaseOrder{
+	PurchaseOrderID: 4,
+	SupplierID:      1,
+	StoreID:         2,
+	Status:          domain.PurchaseOrderStatusSent,
+	Supplier:        supplierModelTpl,
+	Lines: []domain.PurchaseOrderLine{
+		{PurchaseOrderLineID: 1, PurchaseOrderID: 4, ProductID: 1, OrderedQty: 10, UnitCost: money.New(7000)},
+		{PurchaseOrderLineID: 2, PurchaseOrderID: 4, ProductID: 2, OrderedQty: 5, UnitCost: money.New(3000)},
+	},
+}
+
+// sentPurchaseOrder copies the template so a test can change its lines without touching the others
+func sentPurchaseOrder() domain.PurchaseOrder {
+	purchaseOrder := purchaseOrderModelTpl
+	purchaseOrder.Lines = append([]domain.PurchaseOrderLine(nil), purchaseOrderModelTpl.Lines...)
+	return purchaseOrder
+}
+
+func TestCreatePurchaseOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.PurchaseOrderCreateRequest
+		mock  func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, supplierRepo *mocks.MockSupplierRepository, productRepo *mocks.MockProductRepository)
+		err   error
+	}{
+		{
+			name: "Success",
+			input: web.PurchaseOrderCreateRequest{SupplierID: 1, StoreID: 2, Lines: []web.PurchaseOrderLineRequest{
+				{ProductID: 1, OrderedQty: 10, UnitCost: money.New(7000)},
+			}},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, supplierRepo *mocks.MockSupplierRepository, productRepo *mocks.MockProductRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(supplierModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				purchaseOrderRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+						assert.Equal(t, domain.PurchaseOrderStatusDraft, purchaseOrder.Status)
+						assert.Equal(t, uint64(2), purchaseOrder.StoreID)
+						purchaseOrder.PurchaseOrderID = 4
+						return purchaseOrder, nil
+					})
+			},
+		},
+		{
+			name: "Product ordered twice",
+			input: web.PurchaseOrderCreateRequest{SupplierID: 1, StoreID: 2, Lines: []web.PurchaseOrderLineRequest{
+				{ProductID: 1, OrderedQty: 10, UnitCost: money.New(7000)},
+				{ProductID: 1, OrderedQty: 2, UnitCost: money.New(7000)},
+			}},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, supplierRepo *mocks.MockSupplierRepository, productRepo *mocks.MockProductRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(supplierModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+			},
+			err: exception.NewBadRequestError("Product 1 is on the purchase order more than once"),
+		},
+		{
+			name: "Parent product",
+			input: web.PurchaseOrderCreateRequest{SupplierID: 1, StoreID: 2, Lines: []web.PurchaseOrderLineRequest{
+				{ProductID: 10, OrderedQty: 10, UnitCost: money.New(30000)},
+			}},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, supplierRepo *mocks.MockSupplierRepository, productRepo *mocks.MockProductRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(supplierModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			},
+			err: exception.NewBadRequestError("Product 10 has variants, order one of them"),
+		},
+		{
+			name: "Supplier Not Found",
+			input: web.PurchaseOrderCreateRequest{SupplierID: 9, StoreID: 2, Lines: []web.PurchaseOrderLineRequest{
+				{ProductID: 1, OrderedQty: 10, UnitCost: money.New(7000)},
+			}},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, supplierRepo *mocks.MockSupplierRepository, productRepo *mocks.MockProductRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Supplier{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Supplier not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			purchaseOrderRepo := mocks.NewMockPurchaseOrderRepository(ctrl)
+			supplierRepo := mocks.NewMockSupplierRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			tt.mock(purchaseOrderRepo, supplierRepo, productRepo)
+
+			service := NewPurchaseOrderService(newTxManagerMock(ctrl), purchaseOrderRepo, supplierRepo, productRepo, nil, openStore(ctrl), validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, money.New(70000), result.TotalCost)
+				assert.Equal(t, "PT Sumber Makmur", result.SupplierName)
+				assert.Equal(t, 10, result.Lines[0].OutstandingQty)
+			}
+		})
+	}
+}
+
+func TestSendPurchaseOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	draft := sentPurchaseOrder()
+	draft.Status = domain.PurchaseOrderStatusDraft
+	purchaseOrderRepo := mocks.NewMockPurchaseOrderRepository(ctrl)
+	purchaseOrderRepo.EXPECT().FindById(gomock.Any(), uint64(4)).Return(draft, nil)
+	purchaseOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+			return purchaseOrder, nil
+		})
+	purchaseOrderRepo.EXPECT().FindById(gomock.Any(), uint64(4)).Return(purchaseOrderModelTpl, nil)
+
+	service := NewPurchaseOrderService(newTxManagerMock(ctrl), purchaseOrderRepo, nil, nil, nil, nil, validator.New())
+	result, err := service.Send(context.Background(), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PurchaseOrderStatusSent, result.Status)
+	assert.NotNil(t, result.SentAt)
+
+	_, err = service.Send(context.Background(), 4)
+	assert.Equal(t, exception.NewConflictError("Purchase order cannot be sent while Sent"), err)
+}
+
+func TestReceivePurchaseOrder(t *testing.T) {
+	restock := func(productId uint64, quantity int) domain.StockMovement {
+		return domain.StockMovement{ProductID: productId, StoreID: 2, Delta: quantity, Reason: domain.StockReasonRestock, Reference: "Purchase order #4, DN-17"}
+	}
+
+	tests := []struct {
+		name   string
+		input  web.PurchaseOrderReceiveRequest
+		order  func() domain.PurchaseOrder
+		mock   func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository)
+		status string
+		err    error
+	}{
+		{
+			name:  "Partial delivery",
+			input: web.PurchaseOrderReceiveRequest{PurchaseOrderID: 4, Reference: "DN-17", Lines: []web.ReceiveLineRequest{{ProductID: 1, Quantity: 6}}},
+			order: sentPurchaseOrder,
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository) {
+				purchaseOrderRepo.EXPECT().UpdateReceivedQty(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, line domain.PurchaseOrderLine) error {
+						assert.Equal(t, 6, line.ReceivedQty)
+						return nil
+					})
+				productRepo.EXPECT().MoveStock(gomock.Any(), restock(1, 6)).Return(domain.StockMovement{}, nil)
+			},
+			status: domain.PurchaseOrderStatusPartiallyReceived,
+		},
+		{
+			name: "Over delivery completes the order",
+			input: web.PurchaseOrderReceiveRequest{PurchaseOrderID: 4, Reference: "DN-17", Lines: []web.ReceiveLineRequest{
+				{ProductID: 1, Quantity: 4}, {ProductID: 2, Quantity: 7},
+			}},
+			order: func() domain.PurchaseOrder {
+				purchaseOrder := sentPurchaseOrder()
+				purchaseOrder.Status = domain.PurchaseOrderStatusPartiallyReceived
+				purchaseOrder.Lines[0].ReceivedQty = 6
+				return purchaseOrder
+			},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository) {
+				purchaseOrderRepo.EXPECT().UpdateReceivedQty(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				productRepo.EXPECT().MoveStock(gomock.Any(), restock(1, 4)).Return(domain.StockMovement{}, nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), restock(2, 7)).Return(domain.StockMovement{}, nil)
+			},
+			status: domain.PurchaseOrderStatusReceived,
+		},
+		{
+			name:   "Closed short",
+			input:  web.PurchaseOrderReceiveRequest{PurchaseOrderID: 4, Close: true},
+			order:  sentPurchaseOrder,
+			mock:   func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository) {},
+			status: domain.PurchaseOrderStatusReceived,
+		},
+		{
+			name:  "Product not on the order",
+			input: web.PurchaseOrderReceiveRequest{PurchaseOrderID: 4, Lines: []web.ReceiveLineRequest{{ProductID: 3, Quantity: 1}}},
+			order: sentPurchaseOrder,
+			mock:  func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository) {},
+			err:   exception.NewBadRequestError("Product 3 is not on purchase order #4"),
+		},
+		{
+			name:  "Draft cannot be received",
+			input: web.PurchaseOrderReceiveRequest{PurchaseOrderID: 4, Lines: []web.ReceiveLineRequest{{ProductID: 1, Quantity: 1}}},
+			order: func() domain.PurchaseOrder {
+				purchaseOrder := sentPurchaseOrder()
+				purchaseOrder.Status = domain.PurchaseOrderStatusDraft
+				return purchaseOrder
+			},
+			mock: func(purchaseOrderRepo *mocks.MockPurchaseOrderRepository, productRepo *mocks.MockProductRepository) {},
+			err:  exception.NewConflictError("Purchase order cannot be received while Draft"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			purchaseOrderRepo := mocks.NewMockPurchaseOrderRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			purchaseOrderRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(4)).Return(tt.order(), nil)
+			tt.mock(purchaseOrderRepo, productRepo)
+
+			var saved domain.PurchaseOrder
+			if tt.err == nil {
+				purchaseOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+						saved = purchaseOrder
+						return purchaseOrder, nil
+					})
+				purchaseOrderRepo.EXPECT().FindById(gomock.Any(), uint64(4)).DoAndReturn(func(ctx context.Context, id uint64) (domain.PurchaseOrder, error) {
+					return saved, nil
+				})
+			}
+
+			service := NewPurchaseOrderService(newTxManagerMock(ctrl), purchaseOrderRepo, nil, productRepo, nil, nil, validator.New())
+			result, err := service.Receive(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, tt.status, result.Status)
+				assert.Equal(t, tt.status == domain.PurchaseOrderStatusReceived, result.ReceivedAt != nil)
+			}
+		})
+	}
+}
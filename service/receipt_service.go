@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type ReceiptService interface {
+	Issue(ctx context.Context, orderId uint64) (web.ReceiptResponse, error)
+	FindById(ctx context.Context, receiptId uint64) (web.ReceiptResponse, error)
+	FindByOrderId(ctx context.Context, orderId uint64) (web.ReceiptResponse, error)
+	Print(ctx context.Context, receiptId uint64, format string, profileName string) ([]byte, error)
+}
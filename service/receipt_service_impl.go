@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type ReceiptServiceImpl struct {
+	ReceiptRepository repository.ReceiptRepository
+	OrderRepository   repository.OrderRepository
+	TaxService        TaxService
+	PrinterProfiles   map[string]render.PrinterProfile
+	StoreHeader       render.StoreHeader
+}
+
+func NewReceiptService(receiptRepository repository.ReceiptRepository, orderRepository repository.OrderRepository,
+	taxService TaxService, printerProfiles map[string]render.PrinterProfile, storeHeader render.StoreHeader) ReceiptService {
+	return &ReceiptServiceImpl{
+		ReceiptRepository: receiptRepository,
+		OrderRepository:   orderRepository,
+		TaxService:        taxService,
+		PrinterProfiles:   printerProfiles,
+		StoreHeader:       storeHeader,
+	}
+}
+
+// Issue Receipt for a fully paid order. An order only ever gets one receipt, issuing again returns it unchanged.
+func (service *ReceiptServiceImpl) Issue(ctx context.Context, orderId uint64) (web.ReceiptResponse, error) {
+	existing, err := service.ReceiptRepository.FindByOrderId(ctx, orderId)
+	if err == nil {
+		return helper.ToReceiptResponse(existing), nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ReceiptResponse{}, err
+	}
+
+	order, err := service.OrderRepository.FindById(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ReceiptResponse{}, exception.NewNotFoundError("Order not found")
+	} else if err != nil {
+		return web.ReceiptResponse{}, err
+	}
+
+	if order.PaymentStatus() != domain.OrderPaymentPaid {
+		return web.ReceiptResponse{}, exception.NewConflictError("Order is not fully paid")
+	}
+
+	receipt := domain.Receipt{
+		OrderID:          order.OrderID,
+		ReceiptDate:      time.Now(),
+		TotalAmount:      order.Subtotal,
+		Taxes:            order.TaxAmount,
+		Discount:         order.Discount,
+		FinalAmount:      order.TotalAmount,
+		ChangeDue:        order.ChangeDue(),
+		PricesIncludeTax: order.PricesIncludeTax,
+		Currency:         order.Currency,
+	}
+	calculation := service.TaxService.Calculate(orderTaxLines(order.OrderItems), order.PricesIncludeTax)
+	for _, rate := range calculation.Rates {
+		receipt.TaxLines = append(receipt.TaxLines, domain.ReceiptTax{
+			TaxName:       rate.TaxName,
+			TaxRate:       rate.TaxRate,
+			TaxableAmount: rate.Net,
+			TaxAmount:     rate.Tax,
+		})
+	}
+	for _, item := range order.OrderItems {
+		receiptItem := domain.ReceiptItem{
+			ProductID:       item.ProductID,
+			ProductName:     item.Product.Name,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice,
+			TaxRate:         item.TaxRate,
+			TaxAmount:       item.TaxAmount,
+			TotalPrice:      item.TotalPrice,
+			DiscountID:      item.DiscountID,
+			DiscountAmount:  item.DiscountAmount,
+			PromotionName:   promotionNames(order.Adjustments, item.ProductID),
+			PromotionAmount: item.PromotionAmount,
+			TierDiscount:    item.TierDiscount,
+		}
+		if item.TierDiscount > 0 {
+			receiptItem.TierName = order.LoyaltyTier
+		}
+		if item.Discount != nil {
+			receiptItem.DiscountName = item.Discount.Description
+		}
+		receipt.Items = append(receipt.Items, receiptItem)
+	}
+	for _, payment := range order.Payments {
+		if payment.Status != domain.PaymentStatusCompleted {
+			continue
+		}
+		receipt.Tenders = append(receipt.Tenders, domain.ReceiptTender{
+			PaymentID:      payment.PaymentID,
+			PaymentType:    payment.PaymentType,
+			Amount:         payment.Amount,
+			AmountTendered: payment.AmountTendered,
+			ChangeDue:      payment.ChangeDue,
+			Currency:       payment.Currency,
+			ExchangeRate:   payment.ExchangeRate,
+			ForeignAmount:  payment.ForeignAmount,
+		})
+	}
+
+	savedReceipt, err := service.ReceiptRepository.Save(ctx, receipt)
+	if err != nil {
+		return web.ReceiptResponse{}, err
+	}
+
+	return helper.ToReceiptResponse(savedReceipt), nil
+}
+
+// promotionNames lists the promotions that adjusted the line of the given product
+func promotionNames(adjustments []domain.OrderAdjustment, productId uint64) string {
+	var names []string
+	for _, adjustment := range adjustments {
+		if adjustment.ProductID == productId {
+			names = append(names, adjustment.PromotionName)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// Find Receipt By ID
+func (service *ReceiptServiceImpl) FindById(ctx context.Context, receiptId uint64) (web.ReceiptResponse, error) {
+	receipt, err := service.ReceiptRepository.FindById(ctx, receiptId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ReceiptResponse{}, exception.NewNotFoundError("Receipt not found")
+	} else if err != nil {
+		return web.ReceiptResponse{}, err
+	}
+
+	return helper.ToReceiptResponse(receipt), nil
+}
+
+// Find Receipt By Order ID
+func (service *ReceiptServiceImpl) FindByOrderId(ctx context.Context, orderId uint64) (web.ReceiptResponse, error) {
+	receipt, err := service.ReceiptRepository.FindByOrderId(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.ReceiptResponse{}, exception.NewNotFoundError("Receipt not found")
+	} else if err != nil {
+		return web.ReceiptResponse{}, err
+	}
+
+	return helper.ToReceiptResponse(receipt), nil
+}
+
+// Print Receipt as plain text or as an ESC/POS byte stream laid out for the named printer profile
+func (service *ReceiptServiceImpl) Print(ctx context.Context, receiptId uint64, format string, profileName string) ([]byte, error) {
+	profile, ok := service.PrinterProfiles[profileName]
+	if !ok {
+		return nil, exception.NewBadRequestError(fmt.Sprintf("Unknown printer profile %q", profileName))
+	}
+
+	receipt, err := service.FindById(ctx, receiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case render.FormatText:
+		return []byte(render.ReceiptText(receipt, service.StoreHeader, profile)), nil
+	case render.FormatEscPos:
+		return render.ReceiptEscPos(receipt, service.StoreHeader, profile), nil
+	default:
+		return nil, exception.NewBadRequestError(fmt.Sprintf("Unknown receipt format %q", format))
+	}
+}
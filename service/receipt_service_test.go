@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/render"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var paidOrderModelTpl = domain.Order{
+	OrderID:     1,
+	CustomerID:  1,
+	Status:      domain.OrderStatusPlaced,
+	Subtotal:    money.New(20000),
+	TaxAmount:   money.New(2000),
+	TotalAmount: money.New(22000),
+	OrderItems: []domain.OrderItem{
+		{OrderItemID: 1, OrderID: 1, ProductID: 1, Quantity: 2, UnitPrice: money.New(10000), TaxName: "VAT 10%", TaxRate: 10,
+			TaxAmount: money.New(2000), TotalPrice: money.New(20000), Product: productModelTpl},
+	},
+	Payments: []domain.Payment{
+		{PaymentID: 1, OrderID: 1, Amount: money.New(12000), AmountTendered: money.New(12000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted,
+			Currency: "USD", ExchangeRate: 15000, ForeignAmount: money.Money(80)},
+		{PaymentID: 2, OrderID: 1, Amount: money.New(500), PaymentType: domain.PaymentTypeQRIS, Status: domain.PaymentStatusVoided},
+		{PaymentID: 3, OrderID: 1, Amount: money.New(10000), AmountTendered: money.New(15000), ChangeDue: money.New(5000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted},
+	},
+}
+
+func TestIssueReceipt(t *testing.T) {
+	unpaidOrder := paidOrderModelTpl
+	unpaidOrder.Payments = nil
+
+	tests := []struct {
+		name string
+		mock func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository) {
+				receiptRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(domain.Receipt{}, gorm.ErrRecordNotFound)
+				orderRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(paidOrderModelTpl, nil)
+				receiptRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt domain.Receipt) (domain.Receipt, error) {
+						assert.Equal(t, money.New(20000), receipt.TotalAmount)
+						assert.Equal(t, money.New(2000), receipt.Taxes)
+						assert.Equal(t, money.New(22000), receipt.FinalAmount)
+						assert.Equal(t, money.New(5000), receipt.ChangeDue)
+						assert.Equal(t, productModelTpl.Name, receipt.Items[0].ProductName)
+						assert.Len(t, receipt.Tenders, 2)
+						assert.Equal(t, domain.ReceiptTender{PaymentID: 1, PaymentType: domain.PaymentTypeCard, Amount: money.New(12000),
+							AmountTendered: money.New(12000), Currency: "USD", ExchangeRate: 15000, ForeignAmount: money.Money(80)}, receipt.Tenders[0])
+						assert.Equal(t, []domain.ReceiptTax{{TaxName: "VAT 10%", TaxRate: 10, TaxableAmount: money.New(20000), TaxAmount: money.New(2000)}}, receipt.TaxLines)
+						return receipt, nil
+					})
+			},
+			err: nil,
+		},
+		{
+			name: "Already Issued",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository) {
+				receiptRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(domain.Receipt{ReceiptID: 5, OrderID: 1}, nil)
+			},
+			err: nil,
+		},
+		{
+			name: "Not Fully Paid",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository) {
+				receiptRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(domain.Receipt{}, gorm.ErrRecordNotFound)
+				orderRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(unpaidOrder, nil)
+			},
+			err: exception.NewConflictError("Order is not fully paid"),
+		},
+		{
+			name: "Database Error",
+			mock: func(receiptRepo *mocks.MockReceiptRepository, orderRepo *mocks.MockOrderRepository) {
+				receiptRepo.EXPECT().FindByOrderId(gomock.Any(), uint64(1)).Return(domain.Receipt{}, errors.New("database error"))
+			},
+			err: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			receiptRepo := mocks.NewMockReceiptRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			tt.mock(receiptRepo, orderRepo)
+
+			service := NewReceiptService(receiptRepo, orderRepo, NewTaxService(nil, nil), nil, render.StoreHeader{})
+			result, err := service.Issue(context.Background(), 1)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(1), result.OrderID)
+			}
+		})
+	}
+}
+
+func TestFindReceiptById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	receiptRepo := mocks.NewMockReceiptRepository(ctrl)
+	receiptRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Receipt{}, gorm.ErrRecordNotFound)
+
+	service := NewReceiptService(receiptRepo, mocks.NewMockOrderRepository(ctrl), NewTaxService(nil, nil), nil, render.StoreHeader{})
+	_, err := service.FindById(context.Background(), 9)
+	assert.Equal(t, exception.NewNotFoundError("Receipt not found"), err)
+}
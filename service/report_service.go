@@ -0,0 +1,10 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type ReportService interface {
+	SalesReport(ctx context.Context, request web.SalesReportRequest) (web.SalesReportResponse, error)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"time"
+)
+
+type ReportServiceImpl struct {
+	ReportRepository repository.ReportRepository
+	Validate         *validator.Validate
+}
+
+func NewReportService(reportRepository repository.ReportRepository, validate *validator.Validate) ReportService {
+	return &ReportServiceImpl{
+		ReportRepository: reportRepository,
+		Validate:         validate,
+	}
+}
+
+// SalesReport totals the sales of a store, or of the chain, between two dates, both included, grouped by
+// day unless asked otherwise.
+// The totals come from their own query, an order selling several products counts once there.
+func (service *ReportServiceImpl) SalesReport(ctx context.Context, request web.SalesReportRequest) (web.SalesReportResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.SalesReportResponse{}, err
+	}
+
+	from, err := time.ParseInLocation("2006-01-02", request.From, time.Local)
+	if err != nil {
+		return web.SalesReportResponse{}, err
+	}
+	to, err := time.ParseInLocation("2006-01-02", request.To, time.Local)
+	if err != nil {
+		return web.SalesReportResponse{}, err
+	}
+	if to.Before(from) {
+		return web.SalesReportResponse{}, exception.NewBadRequestError("From must not be after to")
+	}
+	groupBy := request.GroupBy
+	if groupBy == "" {
+		groupBy = domain.SalesGroupDay
+	}
+
+	rows, err := service.ReportRepository.SumSales(ctx, groupBy, from, to.AddDate(0, 0, 1), request.StoreID)
+	if err != nil {
+		return web.SalesReportResponse{}, err
+	}
+	totals, err := service.ReportRepository.SumSales(ctx, "", from, to.AddDate(0, 0, 1), request.StoreID)
+	if err != nil {
+		return web.SalesReportResponse{}, err
+	}
+
+	report := web.SalesReportResponse{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+		StoreID: request.StoreID,
+		Rows:    helper.ToSalesReportRowResponses(rows),
+		Totals:  web.SalesReportRowResponse{Label: "Total"},
+	}
+	if len(totals) > 0 {
+		report.Totals = helper.ToSalesReportRowResponse(totals[0])
+	}
+	return report, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSalesReport(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)
+	byCategory := []domain.SalesReportRow{
+		{GroupKey: "32", Label: "Groceries", OrderCount: 4, Quantity: 12, GrossSales: money.New(120000), Discounts: money.New(12000), TaxAmount: money.New(11880), NetSales: money.New(108000)},
+		{GroupKey: "7", Label: "Drinks", OrderCount: 3, Quantity: 5, GrossSales: money.New(25000), TaxAmount: money.New(2750), NetSales: money.New(25000)},
+	}
+	totals := []domain.SalesReportRow{
+		{Label: "Total", OrderCount: 5, Quantity: 17, GrossSales: money.New(145000), Discounts: money.New(12000), TaxAmount: money.New(14630), NetSales: money.New(133000)},
+	}
+
+	tests := []struct {
+		name    string
+		input   web.SalesReportRequest
+		mock    func(reportRepo *mocks.MockReportRepository)
+		groupBy string
+		err     error
+	}{
+		{
+			name:  "By category",
+			input: web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31", GroupBy: domain.SalesGroupCategory},
+			mock: func(reportRepo *mocks.MockReportRepository) {
+				reportRepo.EXPECT().SumSales(gomock.Any(), domain.SalesGroupCategory, from, end, uint64(0)).Return(byCategory, nil)
+				reportRepo.EXPECT().SumSales(gomock.Any(), "", from, end, uint64(0)).Return(totals, nil)
+			},
+			groupBy: domain.SalesGroupCategory,
+		},
+		{
+			name:  "By day when no grouping is given",
+			input: web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31"},
+			mock: func(reportRepo *mocks.MockReportRepository) {
+				reportRepo.EXPECT().SumSales(gomock.Any(), domain.SalesGroupDay, from, end, uint64(0)).Return(nil, nil)
+				reportRepo.EXPECT().SumSales(gomock.Any(), "", from, end, uint64(0)).Return(nil, nil)
+			},
+			groupBy: domain.SalesGroupDay,
+		},
+		{
+			name:  "By store",
+			input: web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31", GroupBy: domain.SalesGroupStore},
+			mock: func(reportRepo *mocks.MockReportRepository) {
+				reportRepo.EXPECT().SumSales(gomock.Any(), domain.SalesGroupStore, from, end, uint64(0)).Return(nil, nil)
+				reportRepo.EXPECT().SumSales(gomock.Any(), "", from, end, uint64(0)).Return(nil, nil)
+			},
+			groupBy: domain.SalesGroupStore,
+		},
+		{
+			name:  "One store by day",
+			input: web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31", StoreID: 2},
+			mock: func(reportRepo *mocks.MockReportRepository) {
+				reportRepo.EXPECT().SumSales(gomock.Any(), domain.SalesGroupDay, from, end, uint64(2)).Return(nil, nil)
+				reportRepo.EXPECT().SumSales(gomock.Any(), "", from, end, uint64(2)).Return(nil, nil)
+			},
+			groupBy: domain.SalesGroupDay,
+		},
+		{
+			name:  "Range backwards",
+			input: web.SalesReportRequest{From: "2026-03-31", To: "2026-03-01"},
+			mock:  func(reportRepo *mocks.MockReportRepository) {},
+			err:   exception.NewBadRequestError("From must not be after to"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			reportRepo := mocks.NewMockReportRepository(ctrl)
+			tt.mock(reportRepo)
+
+			service := NewReportService(reportRepo, validator.New())
+			result, err := service.SalesReport(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			assert.Equal(t, tt.groupBy, result.GroupBy)
+			assert.Equal(t, tt.input.StoreID, result.StoreID)
+			if tt.groupBy == domain.SalesGroupCategory {
+				assert.Len(t, result.Rows, 2)
+				assert.Equal(t, money.New(12000), result.Rows[0].Discounts)
+				assert.Equal(t, money.New(108000), result.Rows[0].NetSales)
+				assert.Equal(t, 5, result.Totals.OrderCount)
+			} else {
+				assert.Empty(t, result.Rows)
+				assert.Equal(t, "Total", result.Totals.Label)
+			}
+		})
+	}
+}
+
+func TestSalesReportInvalidGrouping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewReportService(mocks.NewMockReportRepository(ctrl), validator.New())
+	_, err := service.SalesReport(context.Background(), web.SalesReportRequest{From: "2026-03-01", To: "2026-03-31", GroupBy: "customer"})
+	assert.Error(t, err)
+}
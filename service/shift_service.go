@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type ShiftService interface {
+	Open(ctx context.Context, request web.ShiftOpenRequest) (web.ShiftResponse, error)
+	Close(ctx context.Context, request web.ShiftCloseRequest) (web.ShiftReportResponse, error)
+	RecordCashMovement(ctx context.Context, request web.CashMovementRequest) (web.ShiftResponse, error)
+	FindById(ctx context.Context, shiftId uint64) (web.ShiftResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.ShiftResponse, error)
+	FindReport(ctx context.Context, shiftId uint64) (web.ShiftReportResponse, error)
+	LockOpenShift(ctx context.Context, shiftId uint64) (domain.Shift, error)
+	LockRefundShift(ctx context.Context, shiftId uint64) (domain.Shift, error)
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+type ShiftServiceImpl struct {
+	TxManager          repository.TxManager
+	ShiftRepository    repository.ShiftRepository
+	EmployeeRepository repository.EmployeeRepository
+	StoreService       StoreService
+	Validate           *validator.Validate
+}
+
+func NewShiftService(txManager repository.TxManager, shiftRepository repository.ShiftRepository,
+	employeeRepository repository.EmployeeRepository, storeService StoreService, validate *validator.Validate) ShiftService {
+	return &ShiftServiceImpl{
+		TxManager:          txManager,
+		ShiftRepository:    shiftRepository,
+		EmployeeRepository: employeeRepository,
+		StoreService:       storeService,
+		Validate:           validate,
+	}
+}
+
+// Open a shift on a register of a store the employee works at. A register has one drawer and an employee
+// works one register, so neither may have another shift open. Registers are named per store. The store and
+// employee rows stay locked until the shift is saved, so two opens cannot both pass the checks.
+func (service *ShiftServiceImpl) Open(ctx context.Context, request web.ShiftOpenRequest) (web.ShiftResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.ShiftResponse{}, err
+	}
+
+	var shift domain.Shift
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		store, err := service.StoreService.LockActive(ctx, request.StoreID)
+		if err != nil {
+			return err
+		}
+		employee, err := service.EmployeeRepository.FindByIdForUpdate(ctx, request.EmployeeID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.NewNotFoundError("Employee not found")
+		} else if err != nil {
+			return err
+		}
+		if !employee.WorksAt(store.StoreID) {
+			return exception.NewBadRequestError(fmt.Sprintf("Employee does not work at store %s", store.Code))
+		}
+
+		openShifts, err := service.ShiftRepository.FindOpen(ctx)
+		if err != nil {
+			return err
+		}
+		for _, open := range openShifts {
+			if open.StoreID == store.StoreID && open.Register == request.Register {
+				return exception.NewConflictError(fmt.Sprintf("Register %s already has shift #%d open", open.Register, open.ShiftID))
+			}
+			if open.EmployeeID == request.EmployeeID {
+				return exception.NewConflictError(fmt.Sprintf("Employee already has shift #%d open", open.ShiftID))
+			}
+		}
+
+		shift, err = service.ShiftRepository.Save(ctx, domain.Shift{
+			EmployeeID:   request.EmployeeID,
+			StoreID:      store.StoreID,
+			Register:     request.Register,
+			Status:       domain.ShiftStatusOpen,
+			OpeningFloat: request.OpeningFloat,
+			Note:         request.Note,
+			OpenedAt:     time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		shift.Employee = employee
+		return nil
+	})
+	if err != nil {
+		return web.ShiftResponse{}, err
+	}
+
+	return helper.ToShiftResponse(shift), nil
+}
+
+// Close the shift with the counted drawer. The expected cash and the report figures are worked out under
+// the shift lock, so no payment can land on the shift while it is being closed, and are kept on the shift.
+func (service *ShiftServiceImpl) Close(ctx context.Context, request web.ShiftCloseRequest) (web.ShiftReportResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		shift, err := service.LockOpenShift(ctx, request.ShiftID)
+		if err != nil {
+			return err
+		}
+
+		report, err := service.report(ctx, shift)
+		if err != nil {
+			return err
+		}
+
+		closedAt := time.Now()
+		shift.Status = domain.ShiftStatusClosed
+		shift.ExpectedCash = report.Cash.ExpectedCash
+		shift.CountedCash = request.CountedCash
+		shift.OrderCount = report.OrderCount
+		shift.GrossSales = report.GrossSales
+		shift.TotalRefunds = report.TotalRefunds
+		shift.Discounts = report.Discounts
+		shift.CashSales = report.Cash.CashSales
+		shift.CashRefunds = report.Cash.CashRefunds
+		shift.TotalsRecorded = true
+		shift.ClosedAt = &closedAt
+		if request.Note != "" {
+			shift.Note = request.Note
+		}
+		_, err = service.ShiftRepository.Close(ctx, shift)
+		return err
+	})
+	if err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+
+	return service.FindReport(ctx, request.ShiftID)
+}
+
+// RecordCashMovement records cash put into or taken out of the drawer of an open shift. A pay-out
+// cannot take more than the drawer is expected to hold.
+func (service *ShiftServiceImpl) RecordCashMovement(ctx context.Context, request web.CashMovementRequest) (web.ShiftResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.ShiftResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		shift, err := service.LockOpenShift(ctx, request.ShiftID)
+		if err != nil {
+			return err
+		}
+
+		amount := request.Amount
+		if request.Type == domain.CashMovementPayOut {
+			report, err := service.report(ctx, shift)
+			if err != nil {
+				return err
+			}
+			if amount > report.Cash.ExpectedCash {
+				return exception.NewConflictError(fmt.Sprintf("Pay-out of %s exceeds the %s expected in the drawer", amount, report.Cash.ExpectedCash))
+			}
+		}
+
+		_, err = service.ShiftRepository.SaveCashMovement(ctx, domain.CashMovement{
+			ShiftID:   shift.ShiftID,
+			Type:      request.Type,
+			Amount:    amount,
+			Reason:    request.Reason,
+			CreatedAt: time.Now(),
+		})
+		return err
+	})
+	if err != nil {
+		return web.ShiftResponse{}, err
+	}
+
+	return service.FindById(ctx, request.ShiftID)
+}
+
+// Find Shift By ID
+func (service *ShiftServiceImpl) FindById(ctx context.Context, shiftId uint64) (web.ShiftResponse, error) {
+	shift, err := service.findShift(ctx, shiftId)
+	if err != nil {
+		return web.ShiftResponse{}, err
+	}
+
+	return helper.ToShiftResponse(shift), nil
+}
+
+// Find All Shifts of a store, or of every store when storeId is 0
+func (service *ShiftServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.ShiftResponse, error) {
+	shifts, err := service.ShiftRepository.FindAll(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToShiftResponses(shifts), nil
+}
+
+// FindReport gives the Z-report of a closed shift, or the running figures of an open one
+func (service *ShiftServiceImpl) FindReport(ctx context.Context, shiftId uint64) (web.ShiftReportResponse, error) {
+	shift, err := service.findShift(ctx, shiftId)
+	if err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+
+	return service.report(ctx, shift)
+}
+
+// LockOpenShift locks the shift a payment or refund is taken on, so it cannot be closed underneath it
+func (service *ShiftServiceImpl) LockOpenShift(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	shift, err := service.ShiftRepository.FindByIdForUpdate(ctx, shiftId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Shift{}, exception.NewNotFoundError("Shift not found")
+	} else if err != nil {
+		return domain.Shift{}, err
+	}
+	if shift.Status != domain.ShiftStatusOpen {
+		return domain.Shift{}, exception.NewConflictError(fmt.Sprintf("Shift #%d is closed", shift.ShiftID))
+	}
+	return shift, nil
+}
+
+// LockRefundShift locks the shift the refund of a payment taken on shiftId is paid out on: that shift while
+// it is open, otherwise the shift now open on the same register, as the closed drawer is counted already
+func (service *ShiftServiceImpl) LockRefundShift(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	shift, err := service.ShiftRepository.FindByIdForUpdate(ctx, shiftId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Shift{}, exception.NewNotFoundError("Shift not found")
+	} else if err != nil {
+		return domain.Shift{}, err
+	}
+	if shift.Status == domain.ShiftStatusOpen {
+		return shift, nil
+	}
+
+	openShifts, err := service.ShiftRepository.FindOpen(ctx)
+	if err != nil {
+		return domain.Shift{}, err
+	}
+	for _, open := range openShifts {
+		if open.StoreID == shift.StoreID && open.Register == shift.Register {
+			return service.LockOpenShift(ctx, open.ShiftID)
+		}
+	}
+	return domain.Shift{}, exception.NewConflictError(fmt.Sprintf("Shift #%d is closed and register %s has no shift open to refund from", shift.ShiftID, shift.Register))
+}
+
+func (service *ShiftServiceImpl) report(ctx context.Context, shift domain.Shift) (web.ShiftReportResponse, error) {
+	payments, err := service.ShiftRepository.FindPayments(ctx, shift.ShiftID)
+	if err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+	refundPaymentIds, err := service.ShiftRepository.FindRefundPaymentIds(ctx, shift.ShiftID)
+	if err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+	discounts, err := service.ShiftRepository.SumOrderDiscounts(ctx, shift.ShiftID)
+	if err != nil {
+		return web.ShiftReportResponse{}, err
+	}
+
+	return shiftReport(shift, payments, refundPaymentIds, discounts), nil
+}
+
+func (service *ShiftServiceImpl) findShift(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+	shift, err := service.ShiftRepository.FindById(ctx, shiftId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Shift{}, exception.NewNotFoundError("Shift not found")
+	}
+	return shift, err
+}
+
+// shiftReport totals the payments of a shift. A sale counts once it is completed and stays in the sales
+// when it is refunded later, the refund is listed on the shift that paid it out. Voided sales never reached
+// the drawer. Returns paid out during the shift are refunds without a sale. Foreign cash goes into the drawer
+// in its own currency while its change and any refund of it go out in the store currency. A closed shift
+// reports the figures it was closed with.
+func shiftReport(shift domain.Shift, payments []domain.Payment, refundPaymentIds []uint64, discounts money.Money) web.ShiftReportResponse {
+	isReturn := make(map[uint64]bool, len(refundPaymentIds))
+	for _, paymentId := range refundPaymentIds {
+		isReturn[paymentId] = true
+	}
+
+	sales, refunds, voids := tenderTotals{}, tenderTotals{}, tenderTotals{}
+	orders := make(map[uint64]bool)
+	foreignCash := foreignCashTotals{}
+	var cashSales, cashRefunds, foreignChange money.Money
+	for _, payment := range payments {
+		cash := payment.PaymentType == domain.PaymentTypeCash
+		takenOnShift := payment.ShiftID != nil && *payment.ShiftID == shift.ShiftID
+		switch {
+		case isReturn[payment.PaymentID] || !takenOnShift:
+			refunds.add(payment)
+			if cash {
+				cashRefunds += payment.Amount
+			}
+		case payment.Status == domain.PaymentStatusVoided:
+			voids.add(payment)
+		case payment.Status == domain.PaymentStatusCompleted || payment.Status == domain.PaymentStatusRefunded:
+			sales.add(payment)
+			orders[payment.OrderID] = true
+			if cash && payment.Currency != "" {
+				foreignCash.add(payment)
+				foreignChange += payment.ChangeDue
+			} else if cash {
+				cashSales += payment.Amount
+			}
+			refundedOnShift := payment.RefundShiftID == nil || *payment.RefundShiftID == shift.ShiftID
+			if payment.Status == domain.PaymentStatusRefunded && refundedOnShift {
+				refunds.add(payment)
+				if cash {
+					cashRefunds += payment.Amount
+				}
+			}
+		}
+	}
+
+	var payIns, payOuts money.Money
+	for _, movement := range shift.CashMovements {
+		if movement.Type == domain.CashMovementPayIn {
+			payIns += movement.Amount
+		} else {
+			payOuts += movement.Amount
+		}
+	}
+
+	cash := web.CashSummaryResponse{
+		OpeningFloat:  shift.OpeningFloat,
+		CashSales:     cashSales,
+		CashRefunds:   cashRefunds,
+		ForeignChange: foreignChange,
+		PayIns:        payIns,
+		PayOuts:       payOuts,
+		ExpectedCash:  shift.OpeningFloat + cashSales - cashRefunds - foreignChange + payIns - payOuts,
+		ForeignCash:   foreignCash.responses(),
+	}
+	report := web.ShiftReportResponse{
+		Shift:        helper.ToShiftResponse(shift),
+		OrderCount:   len(orders),
+		GrossSales:   sales.total(),
+		TotalRefunds: refunds.total(),
+		NetSales:     sales.total() - refunds.total(),
+		Discounts:    discounts,
+		Sales:        sales.responses(),
+		Refunds:      refunds.responses(),
+		Voids:        voids.responses(),
+		Cash:         cash,
+	}
+	if shift.Status == domain.ShiftStatusClosed {
+		counted, variance := shift.CountedCash, shift.CashVariance()
+		report.Cash.ExpectedCash, report.Cash.CountedCash, report.Cash.Variance = shift.ExpectedCash, &counted, &variance
+	}
+	if shift.Status == domain.ShiftStatusClosed && shift.TotalsRecorded {
+		report.OrderCount, report.GrossSales, report.TotalRefunds = shift.OrderCount, shift.GrossSales, shift.TotalRefunds
+		report.NetSales, report.Discounts = shift.GrossSales-shift.TotalRefunds, shift.Discounts
+		report.Cash.CashSales, report.Cash.CashRefunds = shift.CashSales, shift.CashRefunds
+	}
+	return report
+}
+
+// tenderTotals counts and sums payments per payment type
+type tenderTotals map[string]*web.TenderTotalResponse
+
+func (totals tenderTotals) add(payment domain.Payment) {
+	total, ok := totals[payment.PaymentType]
+	if !ok {
+		total = &web.TenderTotalResponse{PaymentType: payment.PaymentType}
+		totals[payment.PaymentType] = total
+	}
+	total.Count++
+	total.Amount += payment.Amount
+}
+
+func (totals tenderTotals) total() money.Money {
+	var sum money.Money
+	for _, total := range totals {
+		sum += total.Amount
+	}
+	return sum
+}
+
+func (totals tenderTotals) responses() []web.TenderTotalResponse {
+	responses := make([]web.TenderTotalResponse, 0, len(totals))
+	for _, total := range totals {
+		responses = append(responses, web.TenderTotalResponse{
+			PaymentType: total.PaymentType,
+			Count:       total.Count,
+			Amount:      total.Amount,
+		})
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].PaymentType < responses[j].PaymentType
+	})
+	return responses
+}
+
+// foreignCashTotals counts and sums the foreign cash taken per currency, in that currency
+type foreignCashTotals map[string]*web.ForeignCashResponse
+
+func (totals foreignCashTotals) add(payment domain.Payment) {
+	total, ok := totals[payment.Currency]
+	if !ok {
+		total = &web.ForeignCashResponse{Currency: payment.Currency}
+		totals[payment.Currency] = total
+	}
+	total.Count++
+	total.ExpectedCash += payment.ForeignAmount
+}
+
+func (totals foreignCashTotals) responses() []web.ForeignCashResponse {
+	responses := make([]web.ForeignCashResponse, 0, len(totals))
+	for _, total := range totals {
+		responses = append(responses, *total)
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Currency < responses[j].Currency
+	})
+	return responses
+}
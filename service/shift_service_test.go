@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	servicemocks "github.com/Kahffi/go-rest-api-test/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+// openShift started with a float of 200000, had 10000 paid in and 30000 paid out
+func openShift() domain.Shift {
+	return domain.Shift{
+		ShiftID:      3,
+		EmployeeID:   1,
+		StoreID:      1,
+		Register:     "Till 1",
+		Status:       domain.ShiftStatusOpen,
+		OpeningFloat: money.New(200000),
+		CashMovements: []domain.CashMovement{
+			{CashMovementID: 1, ShiftID: 3, Type: domain.CashMovementPayIn, Amount: money.New(10000)},
+			{CashMovementID: 2, ShiftID: 3, Type: domain.CashMovementPayOut, Amount: money.New(30000)},
+		},
+	}
+}
+
+var shiftIdTpl = uint64(3)
+
+// shiftPaymentsTpl holds a cash and a card sale, a cash sale refunded later, a voided and a pending
+// payment and payment 6, the cash refund of a return
+var shiftPaymentsTpl = []domain.Payment{
+	{PaymentID: 1, OrderID: 1, Amount: money.New(50000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted, ShiftID: &shiftIdTpl},
+	{PaymentID: 2, OrderID: 2, Amount: money.New(80000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusCompleted, ShiftID: &shiftIdTpl},
+	{PaymentID: 3, OrderID: 3, Amount: money.New(20000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusRefunded, ShiftID: &shiftIdTpl},
+	{PaymentID: 4, OrderID: 4, Amount: money.New(10000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusVoided, ShiftID: &shiftIdTpl},
+	{PaymentID: 5, OrderID: 5, Amount: money.New(5000), PaymentType: domain.PaymentTypeCard, Status: domain.PaymentStatusPending, ShiftID: &shiftIdTpl},
+	{PaymentID: 6, OrderID: 9, Amount: money.New(15000), PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusRefunded, ShiftID: &shiftIdTpl},
+}
+
+func expectShiftTotals(shiftRepo *mocks.MockShiftRepository) {
+	shiftRepo.EXPECT().FindPayments(gomock.Any(), uint64(3)).Return(shiftPaymentsTpl, nil)
+	shiftRepo.EXPECT().FindRefundPaymentIds(gomock.Any(), uint64(3)).Return([]uint64{6}, nil)
+	shiftRepo.EXPECT().SumOrderDiscounts(gomock.Any(), uint64(3)).Return(money.New(7500), nil)
+}
+
+func TestOpenShift(t *testing.T) {
+	firstStoreId := uint64(1)
+
+	tests := []struct {
+		name  string
+		input web.ShiftOpenRequest
+		mock  func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.ShiftOpenRequest{StoreID: 1, EmployeeID: 2, Register: "Till 2", OpeningFloat: money.New(200000)},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2, Name: "Sari"}, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{openShift()}, nil)
+				shiftRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+						assert.Equal(t, domain.ShiftStatusOpen, shift.Status)
+						assert.Equal(t, money.New(200000), shift.OpeningFloat)
+						assert.Equal(t, uint64(1), shift.StoreID)
+						shift.ShiftID = 4
+						return shift, nil
+					})
+			},
+		},
+		{
+			name:  "Register already open",
+			input: web.ShiftOpenRequest{StoreID: 1, EmployeeID: 2, Register: "Till 1"},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{openShift()}, nil)
+			},
+			err: exception.NewConflictError("Register Till 1 already has shift #3 open"),
+		},
+		{
+			name:  "Same register at another store",
+			input: web.ShiftOpenRequest{StoreID: 2, EmployeeID: 2, Register: "Till 1"},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2, Name: "Sari"}, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{openShift()}, nil)
+				shiftRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+						assert.Equal(t, uint64(2), shift.StoreID)
+						shift.ShiftID = 4
+						return shift, nil
+					})
+			},
+		},
+		{
+			name:  "Employee of another store",
+			input: web.ShiftOpenRequest{StoreID: 2, EmployeeID: 2, Register: "Till 2"},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2, StoreID: &firstStoreId}, nil)
+			},
+			err: exception.NewBadRequestError("Employee does not work at store S02"),
+		},
+		{
+			name:  "Employee already on another register",
+			input: web.ShiftOpenRequest{StoreID: 1, EmployeeID: 1, Register: "Till 2"},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(1)).Return(domain.Employee{EmployeeID: 1}, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{openShift()}, nil)
+			},
+			err: exception.NewConflictError("Employee already has shift #3 open"),
+		},
+		{
+			name:  "Unknown employee",
+			input: web.ShiftOpenRequest{StoreID: 1, EmployeeID: 9, Register: "Till 2"},
+			mock: func(shiftRepo *mocks.MockShiftRepository, employeeRepo *mocks.MockEmployeeRepository) {
+				employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(9)).Return(domain.Employee{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Employee not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shiftRepo := mocks.NewMockShiftRepository(ctrl)
+			employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+			tt.mock(shiftRepo, employeeRepo)
+
+			service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, employeeRepo, openStore(ctrl), validator.New())
+			result, err := service.Open(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(4), result.Id)
+				assert.Equal(t, "Sari", result.EmployeeName)
+				assert.Nil(t, result.ExpectedCash)
+			}
+		})
+	}
+}
+
+// inTransaction marks the context the transaction callback runs on
+type inTransaction struct{}
+
+func TestOpenShiftChecksWithinTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txManager := mocks.NewMockTxManager(ctrl)
+	txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, inTransaction{}, true))
+		})
+	isTx := func(ctx context.Context) {
+		assert.Equal(t, true, ctx.Value(inTransaction{}))
+	}
+	storeService := servicemocks.NewMockStoreService(ctrl)
+	shiftRepo := mocks.NewMockShiftRepository(ctrl)
+	employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+	gomock.InOrder(
+		storeService.EXPECT().LockActive(gomock.Any(), uint64(1)).
+			DoAndReturn(func(ctx context.Context, storeId uint64) (domain.Store, error) {
+				isTx(ctx)
+				return domain.Store{StoreID: 1, Code: "S01", Active: true}, nil
+			}),
+		employeeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(2)).
+			DoAndReturn(func(ctx context.Context, employeeId uint64) (domain.Employee, error) {
+				isTx(ctx)
+				return domain.Employee{EmployeeID: 2, Name: "Sari"}, nil
+			}),
+		shiftRepo.EXPECT().FindOpen(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) ([]domain.Shift, error) {
+				isTx(ctx)
+				return nil, nil
+			}),
+		shiftRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+				isTx(ctx)
+				shift.ShiftID = 4
+				return shift, nil
+			}),
+	)
+
+	service := NewShiftService(txManager, shiftRepo, employeeRepo, storeService, validator.New())
+	result, err := service.Open(context.Background(), web.ShiftOpenRequest{StoreID: 1, EmployeeID: 2, Register: "Till 2"})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), result.Id)
+}
+
+func TestCloseShift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shiftRepo := mocks.NewMockShiftRepository(ctrl)
+	shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(openShift(), nil)
+	expectShiftTotals(shiftRepo)
+	var closedShift domain.Shift
+	shiftRepo.EXPECT().Close(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, shift domain.Shift) (domain.Shift, error) {
+			// 200000 float + 70000 cash taken - 35000 cash refunded + 10000 paid in - 30000 paid out
+			assert.Equal(t, money.New(215000), shift.ExpectedCash)
+			assert.Equal(t, money.New(214000), shift.CountedCash)
+			assert.Equal(t, domain.ShiftStatusClosed, shift.Status)
+			assert.NotNil(t, shift.ClosedAt)
+			assert.True(t, shift.TotalsRecorded)
+			assert.Equal(t, 3, shift.OrderCount)
+			assert.Equal(t, money.New(150000), shift.GrossSales)
+			assert.Equal(t, money.New(35000), shift.TotalRefunds)
+			assert.Equal(t, money.New(7500), shift.Discounts)
+			assert.Equal(t, money.New(70000), shift.CashSales)
+			assert.Equal(t, money.New(35000), shift.CashRefunds)
+			closedShift = shift
+			return shift, nil
+		})
+	shiftRepo.EXPECT().FindById(gomock.Any(), uint64(3)).DoAndReturn(func(ctx context.Context, shiftId uint64) (domain.Shift, error) {
+		return closedShift, nil
+	})
+	expectShiftTotals(shiftRepo)
+
+	service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, nil, nil, validator.New())
+	report, err := service.Close(context.Background(), web.ShiftCloseRequest{ShiftID: 3, CountedCash: money.New(214000)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, report.OrderCount)
+	assert.Equal(t, money.New(150000), report.GrossSales)
+	assert.Equal(t, money.New(35000), report.TotalRefunds)
+	assert.Equal(t, money.New(115000), report.NetSales)
+	assert.Equal(t, money.New(7500), report.Discounts)
+	assert.Equal(t, []web.TenderTotalResponse{
+		{PaymentType: domain.PaymentTypeCard, Count: 1, Amount: money.New(80000)},
+		{PaymentType: domain.PaymentTypeCash, Count: 2, Amount: money.New(70000)},
+	}, report.Sales)
+	assert.Equal(t, []web.TenderTotalResponse{{PaymentType: domain.PaymentTypeCash, Count: 2, Amount: money.New(35000)}}, report.Refunds)
+	assert.Equal(t, []web.TenderTotalResponse{{PaymentType: domain.PaymentTypeCash, Count: 1, Amount: money.New(10000)}}, report.Voids)
+	assert.Equal(t, money.New(215000), report.Cash.ExpectedCash)
+	assert.Equal(t, money.New(214000), *report.Cash.CountedCash)
+	assert.Equal(t, money.New(-1000), *report.Cash.Variance)
+	assert.Equal(t, money.New(-1000), *report.Shift.CashVariance)
+}
+
+func TestCloseClosedShift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shift := openShift()
+	shift.Status = domain.ShiftStatusClosed
+	shiftRepo := mocks.NewMockShiftRepository(ctrl)
+	shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(shift, nil)
+
+	service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, nil, nil, validator.New())
+	_, err := service.Close(context.Background(), web.ShiftCloseRequest{ShiftID: 3, CountedCash: money.New(214000)})
+	assert.Equal(t, exception.NewConflictError("Shift #3 is closed"), err)
+}
+
+func TestClosedShiftReportsClosingFigures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shift := openShift()
+	shift.Status = domain.ShiftStatusClosed
+	shift.ExpectedCash, shift.CountedCash = money.New(195000), money.New(195000)
+	shift.OrderCount, shift.GrossSales, shift.TotalRefunds = 2, money.New(130000), money.New(15000)
+	shift.Discounts, shift.CashSales, shift.CashRefunds = money.New(7500), money.New(50000), money.New(15000)
+	shift.TotalsRecorded = true
+	shiftRepo := mocks.NewMockShiftRepository(ctrl)
+	shiftRepo.EXPECT().FindById(gomock.Any(), uint64(3)).Return(shift, nil)
+	expectShiftTotals(shiftRepo)
+
+	service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, nil, nil, validator.New())
+	report, err := service.FindReport(context.Background(), 3)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, report.OrderCount)
+	assert.Equal(t, money.New(130000), report.GrossSales)
+	assert.Equal(t, money.New(15000), report.TotalRefunds)
+	assert.Equal(t, money.New(115000), report.NetSales)
+	assert.Equal(t, money.New(50000), report.Cash.CashSales)
+	assert.Equal(t, money.New(15000), report.Cash.CashRefunds)
+	assert.Equal(t, money.New(195000), report.Cash.ExpectedCash)
+	assert.Equal(t, money.New(0), *report.Cash.Variance)
+}
+
+func TestRefundOnLaterShift(t *testing.T) {
+	takenOn, refundedOn := uint64(3), uint64(4)
+	payment := domain.Payment{PaymentID: 1, OrderID: 1, Amount: money.New(20000), PaymentType: domain.PaymentTypeCash,
+		Status: domain.PaymentStatusRefunded, ShiftID: &takenOn, RefundShiftID: &refundedOn}
+
+	taking := shiftReport(domain.Shift{ShiftID: takenOn, Status: domain.ShiftStatusOpen}, []domain.Payment{payment}, nil, 0)
+	assert.Equal(t, money.New(20000), taking.GrossSales)
+	assert.Equal(t, money.New(0), taking.TotalRefunds)
+	assert.Equal(t, money.New(20000), taking.Cash.ExpectedCash)
+
+	refunding := shiftReport(domain.Shift{ShiftID: refundedOn, Status: domain.ShiftStatusOpen, OpeningFloat: money.New(50000)},
+		[]domain.Payment{payment}, nil, 0)
+	assert.Equal(t, money.New(0), refunding.GrossSales)
+	assert.Equal(t, money.New(20000), refunding.TotalRefunds)
+	assert.Equal(t, 0, refunding.OrderCount)
+	assert.Equal(t, money.New(30000), refunding.Cash.ExpectedCash)
+}
+
+func TestShiftReportForeignCash(t *testing.T) {
+	shift := domain.Shift{ShiftID: shiftIdTpl, Status: domain.ShiftStatusOpen, OpeningFloat: money.New(100000)}
+	payments := []domain.Payment{
+		{PaymentID: 1, OrderID: 1, Amount: money.New(40000), AmountTendered: money.New(40000), PaymentType: domain.PaymentTypeCash,
+			Status: domain.PaymentStatusCompleted, ShiftID: &shiftIdTpl},
+		// 20 USD at 15000 for an order of 250000, 50000 handed back in the store currency
+		{PaymentID: 2, OrderID: 2, Amount: money.New(250000), AmountTendered: money.New(300000), ChangeDue: money.New(50000),
+			PaymentType: domain.PaymentTypeCash, Status: domain.PaymentStatusCompleted, ShiftID: &shiftIdTpl,
+			Currency: "USD", ExchangeRate: 15000, ForeignAmount: money.New(20)},
+	}
+
+	report := shiftReport(shift, payments, nil, 0)
+	assert.Equal(t, money.New(290000), report.GrossSales)
+	assert.Equal(t, money.New(40000), report.Cash.CashSales)
+	assert.Equal(t, money.New(50000), report.Cash.ForeignChange)
+	assert.Equal(t, money.New(90000), report.Cash.ExpectedCash)
+	assert.Equal(t, []web.ForeignCashResponse{{Currency: "USD", Count: 1, ExpectedCash: money.New(20)}}, report.Cash.ForeignCash)
+}
+
+func TestLockRefundShift(t *testing.T) {
+	closed := openShift()
+	closed.Status = domain.ShiftStatusClosed
+	next := domain.Shift{ShiftID: 4, StoreID: 1, Register: "Till 1", Status: domain.ShiftStatusOpen}
+	otherStore := domain.Shift{ShiftID: 5, StoreID: 2, Register: "Till 1", Status: domain.ShiftStatusOpen}
+
+	tests := []struct {
+		name    string
+		mock    func(shiftRepo *mocks.MockShiftRepository)
+		shiftId uint64
+		err     error
+	}{
+		{
+			name: "Shift still open",
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(openShift(), nil)
+			},
+			shiftId: 3,
+		},
+		{
+			name: "Next shift on the register",
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(closed, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{otherStore, next}, nil)
+				shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(4)).Return(next, nil)
+			},
+			shiftId: 4,
+		},
+		{
+			name: "Register not open",
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(closed, nil)
+				shiftRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Shift{otherStore}, nil)
+			},
+			err: exception.NewConflictError("Shift #3 is closed and register Till 1 has no shift open to refund from"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shiftRepo := mocks.NewMockShiftRepository(ctrl)
+			tt.mock(shiftRepo)
+
+			service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, nil, nil, validator.New())
+			shift, err := service.LockRefundShift(context.Background(), 3)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.shiftId, shift.ShiftID)
+		})
+	}
+}
+
+func TestRecordCashMovement(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.CashMovementRequest
+		mock  func(shiftRepo *mocks.MockShiftRepository)
+		err   error
+	}{
+		{
+			name:  "Pay-in",
+			input: web.CashMovementRequest{ShiftID: 3, Type: domain.CashMovementPayIn, Amount: money.New(50000), Reason: "Change from the safe"},
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				shiftRepo.EXPECT().SaveCashMovement(gomock.Any(), gomock.Any()).Return(domain.CashMovement{}, nil)
+				shiftRepo.EXPECT().FindById(gomock.Any(), uint64(3)).Return(openShift(), nil)
+			},
+		},
+		{
+			name:  "Pay-out within the drawer",
+			input: web.CashMovementRequest{ShiftID: 3, Type: domain.CashMovementPayOut, Amount: money.New(215000), Reason: "Cash drop to the safe"},
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				expectShiftTotals(shiftRepo)
+				shiftRepo.EXPECT().SaveCashMovement(gomock.Any(), gomock.Any()).Return(domain.CashMovement{}, nil)
+				shiftRepo.EXPECT().FindById(gomock.Any(), uint64(3)).Return(openShift(), nil)
+			},
+		},
+		{
+			name:  "Pay-out more than the drawer holds",
+			input: web.CashMovementRequest{ShiftID: 3, Type: domain.CashMovementPayOut, Amount: money.Money(21500001), Reason: "Cash drop to the safe"},
+			mock: func(shiftRepo *mocks.MockShiftRepository) {
+				expectShiftTotals(shiftRepo)
+			},
+			err: exception.NewConflictError("Pay-out of 215000.01 exceeds the 215000.00 expected in the drawer"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shiftRepo := mocks.NewMockShiftRepository(ctrl)
+			shiftRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(3)).Return(openShift(), nil)
+			tt.mock(shiftRepo)
+
+			service := NewShiftService(newTxManagerMock(ctrl), shiftRepo, nil, nil, validator.New())
+			_, err := service.RecordCashMovement(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type StockTransferService interface {
+	Create(ctx context.Context, request web.StockTransferCreateRequest) (web.StockTransferResponse, error)
+	Delete(ctx context.Context, stockTransferId uint64) error
+	Ship(ctx context.Context, request web.StockTransferShipRequest) (web.StockTransferResponse, error)
+	Receive(ctx context.Context, request web.StockTransferReceiveRequest) (web.StockTransferResponse, error)
+	FindById(ctx context.Context, stockTransferId uint64) (web.StockTransferResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.StockTransferResponse, error)
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type StockTransferServiceImpl struct {
+	TxManager               repository.TxManager
+	StockTransferRepository repository.StockTransferRepository
+	ProductRepository       repository.ProductRepository
+	EmployeeRepository      repository.EmployeeRepository
+	StoreService            StoreService
+	Validate                *validator.Validate
+}
+
+func NewStockTransferService(txManager repository.TxManager, stockTransferRepository repository.StockTransferRepository,
+	productRepository repository.ProductRepository, employeeRepository repository.EmployeeRepository,
+	storeService StoreService, validate *validator.Validate) StockTransferService {
+	return &StockTransferServiceImpl{
+		TxManager:               txManager,
+		StockTransferRepository: stockTransferRepository,
+		ProductRepository:       productRepository,
+		EmployeeRepository:      employeeRepository,
+		StoreService:            storeService,
+		Validate:                validate,
+	}
+}
+
+// Create a Stock Transfer request between two active stores. Nothing moves until it is shipped.
+func (service *StockTransferServiceImpl) Create(ctx context.Context, request web.StockTransferCreateRequest) (web.StockTransferResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	fromStore, err := service.StoreService.FindActive(ctx, request.FromStoreID)
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+	toStore, err := service.StoreService.FindActive(ctx, request.ToStoreID)
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+	lines, err := service.toLines(ctx, request.Lines)
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	transfer := domain.StockTransfer{
+		FromStoreID: fromStore.StoreID,
+		ToStoreID:   toStore.StoreID,
+		Status:      domain.StockTransferStatusRequested,
+		Note:        request.Note,
+		Lines:       lines,
+	}
+	savedTransfer, err := service.StockTransferRepository.Save(ctx, transfer)
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+	savedTransfer.FromStore = fromStore
+	savedTransfer.ToStore = toStore
+
+	return helper.ToStockTransferResponse(savedTransfer), nil
+}
+
+// Delete a Stock Transfer that has not been shipped yet
+func (service *StockTransferServiceImpl) Delete(ctx context.Context, stockTransferId uint64) error {
+	return service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The row lock keeps the transfer from being shipped while it is deleted
+		transfer, err := service.findStockTransferForUpdate(ctx, stockTransferId)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != domain.StockTransferStatusRequested {
+			return exception.NewConflictError(fmt.Sprintf("Stock transfer cannot be deleted while %s", transfer.Status))
+		}
+
+		return service.StockTransferRepository.Delete(ctx, transfer)
+	})
+}
+
+// Ship takes the shipped quantities off the source store, from then on they are in transit. A line can ship
+// less than was requested but never more, and the whole shipment is refused when the source store is short
+// of any product.
+func (service *StockTransferServiceImpl) Ship(ctx context.Context, request web.StockTransferShipRequest) (web.StockTransferResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StockTransferResponse{}, err
+	}
+	if err := service.checkEmployee(ctx, request.EmployeeID); err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The row lock keeps the transfer from being shipped twice
+		transfer, err := service.findStockTransferForUpdate(ctx, request.StockTransferID)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != domain.StockTransferStatusRequested {
+			return exception.NewConflictError(fmt.Sprintf("Stock transfer cannot be shipped while %s", transfer.Status))
+		}
+
+		lineIndex := make(map[uint64]int, len(transfer.Lines))
+		for i, line := range transfer.Lines {
+			lineIndex[line.ProductID] = i
+			transfer.Lines[i].ShippedQty = line.RequestedQty
+		}
+		for _, shipped := range request.Lines {
+			i, ok := lineIndex[shipped.ProductID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is not on stock transfer #%d", shipped.ProductID, transfer.StockTransferID))
+			}
+			if shipped.Quantity > transfer.Lines[i].RequestedQty {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d ships more than the %d requested", shipped.ProductID, transfer.Lines[i].RequestedQty))
+			}
+			transfer.Lines[i].ShippedQty = shipped.Quantity
+		}
+
+		productIds := make([]uint64, 0, len(transfer.Lines))
+		for _, line := range transfer.Lines {
+			productIds = append(productIds, line.ProductID)
+		}
+		products, err := service.ProductRepository.FindByIdsForUpdate(ctx, productIds)
+		if err != nil {
+			return err
+		}
+		available := make(map[uint64]int, len(products))
+		for _, product := range products {
+			available[product.ProductID] = product.AtStore(transfer.FromStoreID).StockQty
+		}
+
+		var shortages []web.StockShortageResponse
+		for _, line := range transfer.Lines {
+			if available[line.ProductID] < line.ShippedQty {
+				shortages = append(shortages, web.StockShortageResponse{
+					ProductID: line.ProductID,
+					Requested: line.ShippedQty,
+					Available: available[line.ProductID],
+				})
+			}
+		}
+		if len(shortages) > 0 {
+			return exception.NewInsufficientStockError(shortages)
+		}
+
+		reference := fmt.Sprintf("Transfer #%d to %s", transfer.StockTransferID, transfer.ToStore.Code)
+		for _, line := range transfer.Lines {
+			if err := service.StockTransferRepository.UpdateLine(ctx, line); err != nil {
+				return err
+			}
+			if line.ShippedQty == 0 {
+				continue
+			}
+			_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+				ProductID:  line.ProductID,
+				StoreID:    transfer.FromStoreID,
+				Delta:      -line.ShippedQty,
+				Reason:     domain.StockReasonTransfer,
+				Reference:  reference,
+				EmployeeID: request.EmployeeID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		shippedAt := time.Now()
+		transfer.Status = domain.StockTransferStatusShipped
+		transfer.ShippedAt = &shippedAt
+		_, err = service.StockTransferRepository.UpdateStatus(ctx, transfer)
+		return err
+	})
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	return service.FindById(ctx, request.StockTransferID)
+}
+
+// Receive adds what actually arrived to the destination store. A line that arrives short or over what was
+// shipped keeps the difference and its note as the discrepancy of the transfer, the missing stock is not
+// put back at the source store.
+func (service *StockTransferServiceImpl) Receive(ctx context.Context, request web.StockTransferReceiveRequest) (web.StockTransferResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StockTransferResponse{}, err
+	}
+	if err := service.checkEmployee(ctx, request.EmployeeID); err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		transfer, err := service.findStockTransferForUpdate(ctx, request.StockTransferID)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != domain.StockTransferStatusShipped {
+			return exception.NewConflictError(fmt.Sprintf("Stock transfer cannot be received while %s", transfer.Status))
+		}
+
+		lineIndex := make(map[uint64]int, len(transfer.Lines))
+		for i, line := range transfer.Lines {
+			lineIndex[line.ProductID] = i
+			transfer.Lines[i].ReceivedQty = line.ShippedQty
+		}
+		for _, received := range request.Lines {
+			i, ok := lineIndex[received.ProductID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is not on stock transfer #%d", received.ProductID, transfer.StockTransferID))
+			}
+			transfer.Lines[i].ReceivedQty = received.Quantity
+			transfer.Lines[i].DiscrepancyNote = received.Note
+		}
+
+		reference := fmt.Sprintf("Transfer #%d from %s", transfer.StockTransferID, transfer.FromStore.Code)
+		for _, line := range transfer.Lines {
+			if err := service.StockTransferRepository.UpdateLine(ctx, line); err != nil {
+				return err
+			}
+			if line.ReceivedQty == 0 {
+				continue
+			}
+			_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+				ProductID:  line.ProductID,
+				StoreID:    transfer.ToStoreID,
+				Delta:      line.ReceivedQty,
+				Reason:     domain.StockReasonTransfer,
+				Reference:  reference,
+				EmployeeID: request.EmployeeID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		receivedAt := time.Now()
+		transfer.Status = domain.StockTransferStatusReceived
+		transfer.ReceivedAt = &receivedAt
+		_, err = service.StockTransferRepository.UpdateStatus(ctx, transfer)
+		return err
+	})
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	return service.FindById(ctx, request.StockTransferID)
+}
+
+// Find Stock Transfer By ID
+func (service *StockTransferServiceImpl) FindById(ctx context.Context, stockTransferId uint64) (web.StockTransferResponse, error) {
+	transfer, err := service.findStockTransfer(ctx, stockTransferId)
+	if err != nil {
+		return web.StockTransferResponse{}, err
+	}
+
+	return helper.ToStockTransferResponse(transfer), nil
+}
+
+// Find All Stock Transfers leaving or reaching a store, or between any stores when storeId is 0
+func (service *StockTransferServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.StockTransferResponse, error) {
+	transfers, err := service.StockTransferRepository.FindAll(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToStockTransferResponses(transfers), nil
+}
+
+// toLines checks every product exists and is requested only once
+func (service *StockTransferServiceImpl) toLines(ctx context.Context, requests []web.StockTransferLineRequest) ([]domain.StockTransferLine, error) {
+	lines := make([]domain.StockTransferLine, 0, len(requests))
+	seen := make(map[uint64]bool, len(requests))
+	for _, request := range requests {
+		if seen[request.ProductID] {
+			return nil, exception.NewBadRequestError(fmt.Sprintf("Product %d is on the stock transfer more than once", request.ProductID))
+		}
+		seen[request.ProductID] = true
+
+		product, err := service.ProductRepository.FindById(ctx, request.ProductID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.NewNotFoundError(fmt.Sprintf("Product %d not found", request.ProductID))
+		} else if err != nil {
+			return nil, err
+		}
+		if product.HasVariants() {
+			return nil, exception.NewBadRequestError(fmt.Sprintf("Product %d has variants, transfer one of them", product.ProductID))
+		}
+
+		lines = append(lines, domain.StockTransferLine{
+			ProductID:    product.ProductID,
+			RequestedQty: request.Quantity,
+			Product:      product,
+		})
+	}
+	return lines, nil
+}
+
+func (service *StockTransferServiceImpl) checkEmployee(ctx context.Context, employeeId *uint64) error {
+	if employeeId == nil {
+		return nil
+	}
+	_, err := service.EmployeeRepository.FindById(ctx, *employeeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Employee not found")
+	}
+	return err
+}
+
+func (service *StockTransferServiceImpl) findStockTransfer(ctx context.Context, stockTransferId uint64) (domain.StockTransfer, error) {
+	transfer, err := service.StockTransferRepository.FindById(ctx, stockTransferId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.StockTransfer{}, exception.NewNotFoundError("Stock transfer not found")
+	}
+	return transfer, err
+}
+
+func (service *StockTransferServiceImpl) findStockTransferForUpdate(ctx context.Context, stockTransferId uint64) (domain.StockTransfer, error) {
+	transfer, err := service.StockTransferRepository.FindByIdForUpdate(ctx, stockTransferId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.StockTransfer{}, exception.NewNotFoundError("Stock transfer not found")
+	}
+	return transfer, err
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var stockTransferModelTpl = domain.StockTransfer{
+	StockTransferID: 6,
+	FromStoreID:     1,
+	ToStoreID:       2,
+	Status:          domain.StockTransferStatusRequested,
+	FromStore:       domain.Store{StoreID: 1, Code: "S01", Name: "Main store"},
+	ToStore:         domain.Store{StoreID: 2, Code: "S02", Name: "Bandung"},
+	Lines: []domain.StockTransferLine{
+		{StockTransferLineID: 1, StockTransferID: 6, ProductID: 1, RequestedQty: 10},
+	},
+}
+
+// requestedTransfer copies the template so a test can change its lines without touching the others
+func requestedTransfer() domain.StockTransfer {
+	transfer := stockTransferModelTpl
+	transfer.Lines = append([]domain.StockTransferLine(nil), stockTransferModelTpl.Lines...)
+	return transfer
+}
+
+func shippedTransfer() domain.StockTransfer {
+	transfer := requestedTransfer()
+	transfer.Status = domain.StockTransferStatusShipped
+	transfer.Lines[0].ShippedQty = 8
+	return transfer
+}
+
+func TestCreateStockTransfer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.StockTransferCreateRequest
+		mock  func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository)
+		err   bool
+	}{
+		{
+			name:  "Success",
+			input: web.StockTransferCreateRequest{FromStoreID: 1, ToStoreID: 2, Lines: []web.StockTransferLineRequest{{ProductID: 1, Quantity: 10}}},
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				transferRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+						assert.Equal(t, domain.StockTransferStatusRequested, transfer.Status)
+						assert.Equal(t, 10, transfer.Lines[0].RequestedQty)
+						transfer.StockTransferID = 6
+						return transfer, nil
+					})
+			},
+		},
+		{
+			name:  "Same store on both ends",
+			input: web.StockTransferCreateRequest{FromStoreID: 1, ToStoreID: 1, Lines: []web.StockTransferLineRequest{{ProductID: 1, Quantity: 10}}},
+			mock:  func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {},
+			err:   true,
+		},
+		{
+			name: "Product requested twice",
+			input: web.StockTransferCreateRequest{FromStoreID: 1, ToStoreID: 2, Lines: []web.StockTransferLineRequest{
+				{ProductID: 1, Quantity: 10}, {ProductID: 1, Quantity: 2},
+			}},
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+			},
+			err: true,
+		},
+		{
+			name:  "Parent product",
+			input: web.StockTransferCreateRequest{FromStoreID: 1, ToStoreID: 2, Lines: []web.StockTransferLineRequest{{ProductID: 10, Quantity: 4}}},
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(10)).Return(parentModelTpl(), nil)
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			transferRepo := mocks.NewMockStockTransferRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			tt.mock(transferRepo, productRepo)
+
+			service := NewStockTransferService(newTxManagerMock(ctrl), transferRepo, productRepo, nil, openStore(ctrl), validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err != nil)
+			if !tt.err {
+				assert.Equal(t, uint64(2), result.ToStoreID)
+				assert.Equal(t, 0, result.Lines[0].InTransitQty)
+			}
+		})
+	}
+}
+
+func TestShipStockTransfer(t *testing.T) {
+	transferOut := func(quantity int) domain.StockMovement {
+		return domain.StockMovement{ProductID: 1, StoreID: 1, Delta: -quantity, Reason: domain.StockReasonTransfer, Reference: "Transfer #6 to S02"}
+	}
+
+	tests := []struct {
+		name     string
+		input    web.StockTransferShipRequest
+		transfer func() domain.StockTransfer
+		stock    int
+		mock     func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository)
+		shipped  int
+		err      error
+	}{
+		{
+			name:     "Ships the requested quantity",
+			input:    web.StockTransferShipRequest{StockTransferID: 6},
+			transfer: requestedTransfer,
+			stock:    100,
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				transferRepo.EXPECT().UpdateLine(gomock.Any(), gomock.Any()).Return(nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), transferOut(10)).Return(domain.StockMovement{}, nil)
+			},
+			shipped: 10,
+		},
+		{
+			name:     "Ships less than requested",
+			input:    web.StockTransferShipRequest{StockTransferID: 6, Lines: []web.TransferShipLineRequest{{ProductID: 1, Quantity: 8}}},
+			transfer: requestedTransfer,
+			stock:    8,
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				transferRepo.EXPECT().UpdateLine(gomock.Any(), gomock.Any()).Return(nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), transferOut(8)).Return(domain.StockMovement{}, nil)
+			},
+			shipped: 8,
+		},
+		{
+			name:     "Source store is short",
+			input:    web.StockTransferShipRequest{StockTransferID: 6},
+			transfer: requestedTransfer,
+			stock:    4,
+			mock:     func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {},
+			err:      exception.NewInsufficientStockError([]web.StockShortageResponse{{ProductID: 1, Requested: 10, Available: 4}}),
+		},
+		{
+			name:     "Ships more than requested",
+			input:    web.StockTransferShipRequest{StockTransferID: 6, Lines: []web.TransferShipLineRequest{{ProductID: 1, Quantity: 11}}},
+			transfer: requestedTransfer,
+			err:      exception.NewBadRequestError("Product 1 ships more than the 10 requested"),
+		},
+		{
+			name:     "Already shipped",
+			input:    web.StockTransferShipRequest{StockTransferID: 6},
+			transfer: shippedTransfer,
+			err:      exception.NewConflictError("Stock transfer cannot be shipped while Shipped"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			transferRepo := mocks.NewMockStockTransferRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			transferRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(tt.transfer(), nil)
+			if tt.mock != nil {
+				product := productModelTpl
+				product.Stores = []domain.StoreProduct{{StoreID: 1, ProductID: 1, StockQty: tt.stock}}
+				productRepo.EXPECT().FindByIdsForUpdate(gomock.Any(), []uint64{1}).Return([]domain.Product{product}, nil)
+				tt.mock(transferRepo, productRepo)
+			}
+
+			var saved domain.StockTransfer
+			if tt.err == nil {
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+						saved = transfer
+						return transfer, nil
+					})
+				transferRepo.EXPECT().FindById(gomock.Any(), uint64(6)).DoAndReturn(func(ctx context.Context, id uint64) (domain.StockTransfer, error) {
+					return saved, nil
+				})
+			}
+
+			service := NewStockTransferService(newTxManagerMock(ctrl), transferRepo, productRepo, nil, nil, validator.New())
+			result, err := service.Ship(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, domain.StockTransferStatusShipped, result.Status)
+				assert.NotNil(t, result.ShippedAt)
+				assert.Equal(t, tt.shipped, result.Lines[0].ShippedQty)
+				assert.Equal(t, tt.shipped, result.Lines[0].InTransitQty)
+			}
+		})
+	}
+}
+
+func TestReceiveStockTransfer(t *testing.T) {
+	transferIn := func(quantity int) domain.StockMovement {
+		return domain.StockMovement{ProductID: 1, StoreID: 2, Delta: quantity, Reason: domain.StockReasonTransfer, Reference: "Transfer #6 from S01"}
+	}
+
+	tests := []struct {
+		name        string
+		input       web.StockTransferReceiveRequest
+		transfer    func() domain.StockTransfer
+		mock        func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository)
+		discrepancy int
+		err         error
+	}{
+		{
+			name:     "Received as shipped",
+			input:    web.StockTransferReceiveRequest{StockTransferID: 6},
+			transfer: shippedTransfer,
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				transferRepo.EXPECT().UpdateLine(gomock.Any(), gomock.Any()).Return(nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), transferIn(8)).Return(domain.StockMovement{}, nil)
+			},
+		},
+		{
+			name: "Arrived short",
+			input: web.StockTransferReceiveRequest{StockTransferID: 6, Lines: []web.TransferReceiveLineRequest{
+				{ProductID: 1, Quantity: 7, Note: "One broken in transit"},
+			}},
+			transfer: shippedTransfer,
+			mock: func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {
+				transferRepo.EXPECT().UpdateLine(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, line domain.StockTransferLine) error {
+						assert.Equal(t, 7, line.ReceivedQty)
+						assert.Equal(t, "One broken in transit", line.DiscrepancyNote)
+						return nil
+					})
+				productRepo.EXPECT().MoveStock(gomock.Any(), transferIn(7)).Return(domain.StockMovement{}, nil)
+			},
+			discrepancy: -1,
+		},
+		{
+			name:     "Product not on the transfer",
+			input:    web.StockTransferReceiveRequest{StockTransferID: 6, Lines: []web.TransferReceiveLineRequest{{ProductID: 3, Quantity: 1}}},
+			transfer: shippedTransfer,
+			mock:     func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {},
+			err:      exception.NewBadRequestError("Product 3 is not on stock transfer #6"),
+		},
+		{
+			name:     "Not shipped yet",
+			input:    web.StockTransferReceiveRequest{StockTransferID: 6},
+			transfer: requestedTransfer,
+			mock:     func(transferRepo *mocks.MockStockTransferRepository, productRepo *mocks.MockProductRepository) {},
+			err:      exception.NewConflictError("Stock transfer cannot be received while Requested"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			transferRepo := mocks.NewMockStockTransferRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			transferRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(tt.transfer(), nil)
+			tt.mock(transferRepo, productRepo)
+
+			var saved domain.StockTransfer
+			if tt.err == nil {
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transfer domain.StockTransfer) (domain.StockTransfer, error) {
+						saved = transfer
+						return transfer, nil
+					})
+				transferRepo.EXPECT().FindById(gomock.Any(), uint64(6)).DoAndReturn(func(ctx context.Context, id uint64) (domain.StockTransfer, error) {
+					return saved, nil
+				})
+			}
+
+			service := NewStockTransferService(newTxManagerMock(ctrl), transferRepo, productRepo, nil, nil, validator.New())
+			result, err := service.Receive(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, domain.StockTransferStatusReceived, result.Status)
+				assert.NotNil(t, result.ReceivedAt)
+				assert.Equal(t, 0, result.Lines[0].InTransitQty)
+				assert.Equal(t, tt.discrepancy, result.Lines[0].DiscrepancyQty)
+			}
+		})
+	}
+}
+
+func TestDeleteStockTransfer(t *testing.T) {
+	tests := []struct {
+		name string
+		mock func(transferRepo *mocks.MockStockTransferRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(transferRepo *mocks.MockStockTransferRepository) {
+				transferRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(requestedTransfer(), nil)
+				transferRepo.EXPECT().Delete(gomock.Any(), requestedTransfer()).Return(nil)
+			},
+		},
+		{
+			name: "Shipped while waiting for the lock",
+			mock: func(transferRepo *mocks.MockStockTransferRepository) {
+				transferRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(shippedTransfer(), nil)
+			},
+			err: exception.NewConflictError("Stock transfer cannot be deleted while Shipped"),
+		},
+		{
+			name: "Not found",
+			mock: func(transferRepo *mocks.MockStockTransferRepository) {
+				transferRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(domain.StockTransfer{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Stock transfer not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			transferRepo := mocks.NewMockStockTransferRepository(ctrl)
+			tt.mock(transferRepo)
+
+			service := NewStockTransferService(newTxManagerMock(ctrl), transferRepo, nil, nil, nil, validator.New())
+			assert.Equal(t, tt.err, service.Delete(context.Background(), 6))
+		})
+	}
+}
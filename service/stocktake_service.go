@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type StocktakeService interface {
+	Create(ctx context.Context, request web.StocktakeCreateRequest) (web.StocktakeResponse, error)
+	RecordCounts(ctx context.Context, request web.StocktakeCountRequest) (web.StocktakeResponse, error)
+	FindVariances(ctx context.Context, stocktakeId uint64) (web.StocktakeVarianceResponse, error)
+	Commit(ctx context.Context, request web.StocktakeCommitRequest) (web.StocktakeResponse, error)
+	Cancel(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error)
+	FindById(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error)
+	FindAll(ctx context.Context, storeId uint64) ([]web.StocktakeResponse, error)
+}
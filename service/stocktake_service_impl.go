@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"time"
+)
+
+type StocktakeServiceImpl struct {
+	TxManager           repository.TxManager
+	StocktakeRepository repository.StocktakeRepository
+	ProductRepository   repository.ProductRepository
+	CategoryRepository  repository.CategoryRepository
+	EmployeeRepository  repository.EmployeeRepository
+	StoreService        StoreService
+	Validate            *validator.Validate
+}
+
+func NewStocktakeService(txManager repository.TxManager, stocktakeRepository repository.StocktakeRepository,
+	productRepository repository.ProductRepository, categoryRepository repository.CategoryRepository,
+	employeeRepository repository.EmployeeRepository, storeService StoreService, validate *validator.Validate) StocktakeService {
+	return &StocktakeServiceImpl{
+		TxManager:           txManager,
+		StocktakeRepository: stocktakeRepository,
+		ProductRepository:   productRepository,
+		CategoryRepository:  categoryRepository,
+		EmployeeRepository:  employeeRepository,
+		StoreService:        storeService,
+		Validate:            validate,
+	}
+}
+
+// Create opens a stocktake at a store and snapshots the stock the store holds of every product it covers.
+// Two open stocktakes may not cover the same product at the same store, committing both would post its
+// variance twice.
+func (service *StocktakeServiceImpl) Create(ctx context.Context, request web.StocktakeCreateRequest) (web.StocktakeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	if _, err := service.StoreService.FindActive(ctx, request.StoreID); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+	openStocktakes, err := service.StocktakeRepository.FindOpen(ctx)
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+	for _, open := range openStocktakes {
+		if open.StoreID != request.StoreID {
+			continue
+		}
+		if open.CategoryID == nil || request.CategoryID == nil || *open.CategoryID == *request.CategoryID {
+			return web.StocktakeResponse{}, exception.NewConflictError(fmt.Sprintf("Stocktake #%d is still open for these products", open.StocktakeID))
+		}
+	}
+
+	stocktake := domain.Stocktake{
+		StoreID:    request.StoreID,
+		CategoryID: request.CategoryID,
+		Status:     domain.StocktakeStatusOpen,
+		Note:       request.Note,
+	}
+
+	var products []domain.Product
+	if request.CategoryID != nil {
+		category, err := service.CategoryRepository.FindById(ctx, *request.CategoryID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return web.StocktakeResponse{}, exception.NewNotFoundError("Category not found")
+		} else if err != nil {
+			return web.StocktakeResponse{}, err
+		}
+		stocktake.Category = &category
+
+		products, err = service.ProductRepository.FindByCategoryId(ctx, category.Id)
+	} else {
+		products, err = service.ProductRepository.FindAll(ctx)
+	}
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	for _, product := range products {
+		// A parent holds no stock, its variants are counted instead
+		if product.HasVariants() {
+			continue
+		}
+		stocktake.Lines = append(stocktake.Lines, domain.StocktakeLine{
+			ProductID:   product.ProductID,
+			ExpectedQty: product.AtStore(request.StoreID).StockQty,
+			Product:     product,
+		})
+	}
+	if len(stocktake.Lines) == 0 {
+		return web.StocktakeResponse{}, exception.NewBadRequestError("There are no products to count")
+	}
+
+	savedStocktake, err := service.StocktakeRepository.Save(ctx, stocktake)
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	return helper.ToStocktakeResponse(savedStocktake), nil
+}
+
+// RecordCounts stores what an employee counted while the stocktake is open
+func (service *StocktakeServiceImpl) RecordCounts(ctx context.Context, request web.StocktakeCountRequest) (web.StocktakeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+	if err := service.findEmployee(ctx, request.EmployeeID); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stocktake, err := service.lockOpenStocktake(ctx, request.StocktakeID, "counted")
+		if err != nil {
+			return err
+		}
+		lineIndex := indexStocktakeLines(stocktake)
+
+		countedAt := time.Now()
+		seen := make(map[uint64]bool, len(request.Counts))
+		for _, count := range request.Counts {
+			if seen[count.ProductID] {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is counted more than once", count.ProductID))
+			}
+			seen[count.ProductID] = true
+
+			i, ok := lineIndex[count.ProductID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is not part of stocktake #%d", count.ProductID, stocktake.StocktakeID))
+			}
+
+			_, err := service.StocktakeRepository.SaveCount(ctx, domain.StocktakeCount{
+				StocktakeLineID: stocktake.Lines[i].StocktakeLineID,
+				EmployeeID:      request.EmployeeID,
+				Quantity:        count.Quantity,
+				CountedAt:       countedAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	return service.FindById(ctx, request.StocktakeID)
+}
+
+// FindVariances reports what committing the stocktake would post
+func (service *StocktakeServiceImpl) FindVariances(ctx context.Context, stocktakeId uint64) (web.StocktakeVarianceResponse, error) {
+	stocktake, err := service.findStocktake(ctx, stocktakeId)
+	if err != nil {
+		return web.StocktakeVarianceResponse{}, err
+	}
+
+	return helper.ToStocktakeVarianceResponse(stocktake), nil
+}
+
+// Commit posts the variance of every counted product as a stock adjustment. A product that is off its
+// snapshot needs a reason code, uncounted products keep their stock.
+func (service *StocktakeServiceImpl) Commit(ctx context.Context, request web.StocktakeCommitRequest) (web.StocktakeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+	if err := service.findEmployee(ctx, request.EmployeeID); err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stocktake, err := service.lockOpenStocktake(ctx, request.StocktakeID, "committed")
+		if err != nil {
+			return err
+		}
+		lineIndex := indexStocktakeLines(stocktake)
+
+		for _, reason := range request.Reasons {
+			i, ok := lineIndex[reason.ProductID]
+			if !ok {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is not part of stocktake #%d", reason.ProductID, stocktake.StocktakeID))
+			}
+			stocktake.Lines[i].ReasonCode = reason.ReasonCode
+		}
+
+		var counted int
+		for _, line := range stocktake.Lines {
+			if _, ok := line.CountedQty(); !ok {
+				continue
+			}
+			counted++
+			if line.Variance() != 0 && line.ReasonCode == "" {
+				return exception.NewBadRequestError(fmt.Sprintf("Product %d is off by %d and needs a reason code", line.ProductID, line.Variance()))
+			}
+		}
+		if counted == 0 {
+			return exception.NewBadRequestError("Nothing has been counted yet")
+		}
+
+		for _, line := range stocktake.Lines {
+			if line.Variance() == 0 {
+				continue
+			}
+			if err := service.StocktakeRepository.UpdateReasonCode(ctx, line); err != nil {
+				return err
+			}
+
+			_, err := service.ProductRepository.MoveStock(ctx, domain.StockMovement{
+				ProductID:  line.ProductID,
+				StoreID:    stocktake.StoreID,
+				Delta:      line.Variance(),
+				Reason:     domain.StockReasonAdjustment,
+				Reference:  fmt.Sprintf("Stocktake #%d, %s", stocktake.StocktakeID, line.ReasonCode),
+				EmployeeID: &request.EmployeeID,
+			})
+			if errors.Is(err, repository.ErrInsufficientStock) {
+				// Sold since the snapshot, the missing units cannot be taken out a second time
+				return exception.NewConflictError(fmt.Sprintf("Product %d has less stock left than the count takes out", line.ProductID))
+			} else if err != nil {
+				return err
+			}
+		}
+
+		committedAt := time.Now()
+		stocktake.Status = domain.StocktakeStatusCommitted
+		stocktake.CommittedAt = &committedAt
+		stocktake.CommittedByID = &request.EmployeeID
+		_, err = service.StocktakeRepository.UpdateStatus(ctx, stocktake)
+		return err
+	})
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	return service.FindById(ctx, request.StocktakeID)
+}
+
+// Cancel an open stocktake without touching stock
+func (service *StocktakeServiceImpl) Cancel(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error) {
+	err := service.TxManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stocktake, err := service.lockOpenStocktake(ctx, stocktakeId, "cancelled")
+		if err != nil {
+			return err
+		}
+
+		stocktake.Status = domain.StocktakeStatusCancelled
+		_, err = service.StocktakeRepository.UpdateStatus(ctx, stocktake)
+		return err
+	})
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	return service.FindById(ctx, stocktakeId)
+}
+
+// Find Stocktake By ID
+func (service *StocktakeServiceImpl) FindById(ctx context.Context, stocktakeId uint64) (web.StocktakeResponse, error) {
+	stocktake, err := service.findStocktake(ctx, stocktakeId)
+	if err != nil {
+		return web.StocktakeResponse{}, err
+	}
+
+	return helper.ToStocktakeResponse(stocktake), nil
+}
+
+// Find All Stocktakes of a store, or of every store when storeId is 0
+func (service *StocktakeServiceImpl) FindAll(ctx context.Context, storeId uint64) ([]web.StocktakeResponse, error) {
+	stocktakes, err := service.StocktakeRepository.FindAll(ctx, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToStocktakeResponses(stocktakes), nil
+}
+
+// lockOpenStocktake locks the stocktake so counts cannot arrive while it is being committed
+func (service *StocktakeServiceImpl) lockOpenStocktake(ctx context.Context, stocktakeId uint64, action string) (domain.Stocktake, error) {
+	stocktake, err := service.StocktakeRepository.FindByIdForUpdate(ctx, stocktakeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Stocktake{}, exception.NewNotFoundError("Stocktake not found")
+	} else if err != nil {
+		return domain.Stocktake{}, err
+	}
+	if stocktake.Status != domain.StocktakeStatusOpen {
+		return domain.Stocktake{}, exception.NewConflictError(fmt.Sprintf("Stocktake cannot be %s while %s", action, stocktake.Status))
+	}
+	return stocktake, nil
+}
+
+func (service *StocktakeServiceImpl) findStocktake(ctx context.Context, stocktakeId uint64) (domain.Stocktake, error) {
+	stocktake, err := service.StocktakeRepository.FindById(ctx, stocktakeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Stocktake{}, exception.NewNotFoundError("Stocktake not found")
+	}
+	return stocktake, err
+}
+
+func (service *StocktakeServiceImpl) findEmployee(ctx context.Context, employeeId uint64) error {
+	_, err := service.EmployeeRepository.FindById(ctx, employeeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Employee not found")
+	}
+	return err
+}
+
+func indexStocktakeLines(stocktake domain.Stocktake) map[uint64]int {
+	lineIndex := make(map[uint64]int, len(stocktake.Lines))
+	for i, line := range stocktake.Lines {
+		lineIndex[line.ProductID] = i
+	}
+	return lineIndex
+}
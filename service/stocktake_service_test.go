@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+// openStocktake has product 1 counted short by two employees and product 2 not counted yet
+func openStocktake() domain.Stocktake {
+	return domain.Stocktake{
+		StocktakeID: 6,
+		StoreID:     1,
+		Status:      domain.StocktakeStatusOpen,
+		Lines: []domain.StocktakeLine{
+			{StocktakeLineID: 1, StocktakeID: 6, ProductID: 1, ExpectedQty: 100, Product: productModelTpl, Counts: []domain.StocktakeCount{
+				{StocktakeLineID: 1, EmployeeID: 1, Quantity: 60},
+				{StocktakeLineID: 1, EmployeeID: 2, Quantity: 37},
+			}},
+			{StocktakeLineID: 2, StocktakeID: 6, ProductID: 2, ExpectedQty: 5, Product: domain.Product{ProductID: 2, Price: money.New(3000)}},
+		},
+	}
+}
+
+func TestCreateStocktake(t *testing.T) {
+	categoryId := uint64(32)
+	otherCategoryId := uint64(7)
+
+	tests := []struct {
+		name  string
+		input web.StocktakeCreateRequest
+		mock  func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository)
+		lines int
+		err   error
+	}{
+		{
+			name:  "Whole store",
+			input: web.StocktakeCreateRequest{StoreID: 1},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository) {
+				stocktakeRepo.EXPECT().FindOpen(gomock.Any()).Return(nil, nil)
+				productRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Product{productModelTpl, {ProductID: 2, StockQty: 12,
+					Stores: []domain.StoreProduct{{StoreID: 1, StockQty: 5}, {StoreID: 2, StockQty: 7}}}}, nil)
+				stocktakeRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+						assert.Equal(t, uint64(1), stocktake.StoreID)
+						assert.Equal(t, 100, stocktake.Lines[0].ExpectedQty)
+						assert.Equal(t, 5, stocktake.Lines[1].ExpectedQty)
+						stocktake.StocktakeID = 6
+						return stocktake, nil
+					})
+			},
+			lines: 2,
+		},
+		{
+			name:  "One category next to another open one",
+			input: web.StocktakeCreateRequest{StoreID: 1, CategoryID: &categoryId},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository) {
+				stocktakeRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Stocktake{
+					{StocktakeID: 4, StoreID: 2},
+					{StocktakeID: 5, StoreID: 1, CategoryID: &otherCategoryId},
+				}, nil)
+				categoryRepo.EXPECT().FindById(gomock.Any(), categoryId).Return(domain.Category{Id: categoryId, Name: "Snacks"}, nil)
+				productRepo.EXPECT().FindByCategoryId(gomock.Any(), categoryId).Return([]domain.Product{productModelTpl}, nil)
+				stocktakeRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+						return stocktake, nil
+					})
+			},
+			lines: 1,
+		},
+		{
+			name:  "Variants are counted instead of their parent",
+			input: web.StocktakeCreateRequest{StoreID: 1, CategoryID: &categoryId},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository) {
+				stocktakeRepo.EXPECT().FindOpen(gomock.Any()).Return(nil, nil)
+				categoryRepo.EXPECT().FindById(gomock.Any(), categoryId).Return(domain.Category{Id: categoryId, Name: "Apparel"}, nil)
+				productRepo.EXPECT().FindByCategoryId(gomock.Any(), categoryId).
+					Return([]domain.Product{parentModelTpl(), variantModelTpl(11, "S"), variantModelTpl(12, "M")}, nil)
+				stocktakeRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+						assert.Equal(t, uint64(11), stocktake.Lines[0].ProductID)
+						assert.Equal(t, 4, stocktake.Lines[0].ExpectedQty)
+						return stocktake, nil
+					})
+			},
+			lines: 2,
+		},
+		{
+			name:  "Overlaps an open whole store stocktake",
+			input: web.StocktakeCreateRequest{StoreID: 1, CategoryID: &categoryId},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository) {
+				stocktakeRepo.EXPECT().FindOpen(gomock.Any()).Return([]domain.Stocktake{{StocktakeID: 5, StoreID: 1}}, nil)
+			},
+			err: exception.NewConflictError("Stocktake #5 is still open for these products"),
+		},
+		{
+			name:  "Category Not Found",
+			input: web.StocktakeCreateRequest{StoreID: 1, CategoryID: &categoryId},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository, categoryRepo *mocks.MockCategoryRepository) {
+				stocktakeRepo.EXPECT().FindOpen(gomock.Any()).Return(nil, nil)
+				categoryRepo.EXPECT().FindById(gomock.Any(), categoryId).Return(domain.Category{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Category not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stocktakeRepo := mocks.NewMockStocktakeRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			categoryRepo := mocks.NewMockCategoryRepository(ctrl)
+			tt.mock(stocktakeRepo, productRepo, categoryRepo)
+
+			service := NewStocktakeService(newTxManagerMock(ctrl), stocktakeRepo, productRepo, categoryRepo, nil, openStore(ctrl), validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, domain.StocktakeStatusOpen, result.Status)
+				assert.Len(t, result.Lines, tt.lines)
+			}
+		})
+	}
+}
+
+func TestRecordStocktakeCounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     web.StocktakeCountRequest
+		stocktake func() domain.Stocktake
+		mock      func(stocktakeRepo *mocks.MockStocktakeRepository)
+		err       error
+	}{
+		{
+			name:      "Success",
+			input:     web.StocktakeCountRequest{StocktakeID: 6, EmployeeID: 2, Counts: []web.StocktakeCountLineRequest{{ProductID: 2, Quantity: 0}}},
+			stocktake: openStocktake,
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository) {
+				stocktakeRepo.EXPECT().SaveCount(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, count domain.StocktakeCount) (domain.StocktakeCount, error) {
+						assert.Equal(t, uint64(2), count.StocktakeLineID)
+						assert.Equal(t, uint64(2), count.EmployeeID)
+						return count, nil
+					})
+				stocktakeRepo.EXPECT().FindById(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+			},
+		},
+		{
+			name:      "Product not in the stocktake",
+			input:     web.StocktakeCountRequest{StocktakeID: 6, EmployeeID: 2, Counts: []web.StocktakeCountLineRequest{{ProductID: 9, Quantity: 3}}},
+			stocktake: openStocktake,
+			mock:      func(stocktakeRepo *mocks.MockStocktakeRepository) {},
+			err:       exception.NewBadRequestError("Product 9 is not part of stocktake #6"),
+		},
+		{
+			name:  "Already committed",
+			input: web.StocktakeCountRequest{StocktakeID: 6, EmployeeID: 2, Counts: []web.StocktakeCountLineRequest{{ProductID: 1, Quantity: 3}}},
+			stocktake: func() domain.Stocktake {
+				stocktake := openStocktake()
+				stocktake.Status = domain.StocktakeStatusCommitted
+				return stocktake
+			},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository) {},
+			err:  exception.NewConflictError("Stocktake cannot be counted while Committed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stocktakeRepo := mocks.NewMockStocktakeRepository(ctrl)
+			employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+			employeeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+			stocktakeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(tt.stocktake(), nil)
+			tt.mock(stocktakeRepo)
+
+			service := NewStocktakeService(newTxManagerMock(ctrl), stocktakeRepo, nil, nil, employeeRepo, nil, validator.New())
+			_, err := service.RecordCounts(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFindStocktakeVariances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stocktakeRepo := mocks.NewMockStocktakeRepository(ctrl)
+	stocktakeRepo.EXPECT().FindById(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+
+	service := NewStocktakeService(nil, stocktakeRepo, nil, nil, nil, nil, validator.New())
+	result, err := service.FindVariances(context.Background(), 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.CountedCount)
+	assert.Equal(t, -3, result.TotalVarianceQty)
+	assert.Equal(t, money.New(-30000), result.TotalVarianceValue)
+	assert.Equal(t, 97, *result.Variances[0].CountedQty)
+	assert.Equal(t, uint64(2), result.Uncounted[0].ProductID)
+}
+
+func TestCommitStocktake(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.StocktakeCommitRequest
+		mock  func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository)
+		err   error
+	}{
+		{
+			name: "Success",
+			input: web.StocktakeCommitRequest{StocktakeID: 6, EmployeeID: 2, Reasons: []web.StocktakeReasonRequest{
+				{ProductID: 1, ReasonCode: domain.StocktakeReasonDamaged},
+			}},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository) {
+				stocktakeRepo.EXPECT().UpdateReasonCode(gomock.Any(), gomock.Any()).Return(nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error) {
+						assert.Equal(t, uint64(1), movement.ProductID)
+						assert.Equal(t, uint64(1), movement.StoreID)
+						assert.Equal(t, -3, movement.Delta)
+						assert.Equal(t, domain.StockReasonAdjustment, movement.Reason)
+						assert.Equal(t, "Stocktake #6, Damaged", movement.Reference)
+						return movement, nil
+					})
+				stocktakeRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+						assert.Equal(t, domain.StocktakeStatusCommitted, stocktake.Status)
+						assert.Equal(t, uint64(2), *stocktake.CommittedByID)
+						return stocktake, nil
+					})
+				stocktakeRepo.EXPECT().FindById(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+			},
+		},
+		{
+			name:  "Variance without a reason code",
+			input: web.StocktakeCommitRequest{StocktakeID: 6, EmployeeID: 2},
+			mock:  func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository) {},
+			err:   exception.NewBadRequestError("Product 1 is off by -3 and needs a reason code"),
+		},
+		{
+			name: "Sold out since the snapshot",
+			input: web.StocktakeCommitRequest{StocktakeID: 6, EmployeeID: 2, Reasons: []web.StocktakeReasonRequest{
+				{ProductID: 1, ReasonCode: domain.StocktakeReasonShrinkage},
+			}},
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository, productRepo *mocks.MockProductRepository) {
+				stocktakeRepo.EXPECT().UpdateReasonCode(gomock.Any(), gomock.Any()).Return(nil)
+				productRepo.EXPECT().MoveStock(gomock.Any(), gomock.Any()).Return(domain.StockMovement{}, repository.ErrInsufficientStock)
+			},
+			err: exception.NewConflictError("Product 1 has less stock left than the count takes out"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stocktakeRepo := mocks.NewMockStocktakeRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			employeeRepo := mocks.NewMockEmployeeRepository(ctrl)
+			employeeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Employee{EmployeeID: 2}, nil)
+			stocktakeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+			tt.mock(stocktakeRepo, productRepo)
+
+			service := NewStocktakeService(newTxManagerMock(ctrl), stocktakeRepo, productRepo, nil, employeeRepo, nil, validator.New())
+			_, err := service.Commit(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCancelStocktake(t *testing.T) {
+	committed := openStocktake()
+	committed.Status = domain.StocktakeStatusCommitted
+
+	tests := []struct {
+		name string
+		mock func(stocktakeRepo *mocks.MockStocktakeRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository) {
+				stocktakeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+				stocktakeRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, stocktake domain.Stocktake) (domain.Stocktake, error) {
+						assert.Equal(t, domain.StocktakeStatusCancelled, stocktake.Status)
+						return stocktake, nil
+					})
+				stocktakeRepo.EXPECT().FindById(gomock.Any(), uint64(6)).Return(openStocktake(), nil)
+			},
+		},
+		{
+			name: "Committed while waiting for the lock",
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository) {
+				stocktakeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(committed, nil)
+			},
+			err: exception.NewConflictError("Stocktake cannot be cancelled while Committed"),
+		},
+		{
+			name: "Not found",
+			mock: func(stocktakeRepo *mocks.MockStocktakeRepository) {
+				stocktakeRepo.EXPECT().FindByIdForUpdate(gomock.Any(), uint64(6)).Return(domain.Stocktake{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Stocktake not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stocktakeRepo := mocks.NewMockStocktakeRepository(ctrl)
+			tt.mock(stocktakeRepo)
+
+			service := NewStocktakeService(newTxManagerMock(ctrl), stocktakeRepo, nil, nil, nil, nil, validator.New())
+			_, err := service.Cancel(context.Background(), 6)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
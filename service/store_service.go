@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type StoreService interface {
+	Create(ctx context.Context, request web.StoreCreateRequest) (web.StoreResponse, error)
+	Update(ctx context.Context, request web.StoreUpdateRequest) (web.StoreResponse, error)
+	FindById(ctx context.Context, storeId uint64) (web.StoreResponse, error)
+	FindAll(ctx context.Context) ([]web.StoreResponse, error)
+	SetPrice(ctx context.Context, request web.StorePriceRequest) (web.StoreProductResponse, error)
+	FindActive(ctx context.Context, storeId uint64) (domain.Store, error)
+	LockActive(ctx context.Context, storeId uint64) (domain.Store, error)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+type StoreServiceImpl struct {
+	StoreRepository   repository.StoreRepository
+	ProductRepository repository.ProductRepository
+	Validate          *validator.Validate
+}
+
+func NewStoreService(storeRepository repository.StoreRepository, productRepository repository.ProductRepository,
+	validate *validator.Validate) StoreService {
+	return &StoreServiceImpl{
+		StoreRepository:   storeRepository,
+		ProductRepository: productRepository,
+		Validate:          validate,
+	}
+}
+
+// Create Store, a new store starts active and without stock
+func (service *StoreServiceImpl) Create(ctx context.Context, request web.StoreCreateRequest) (web.StoreResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	store := domain.Store{
+		Code:    request.Code,
+		Name:    request.Name,
+		Address: request.Address,
+		Phone:   request.Phone,
+		Active:  true,
+	}
+	if err := service.checkCode(ctx, store); err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	savedStore, err := service.StoreRepository.Save(ctx, store)
+	if err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	return helper.ToStoreResponse(savedStore), nil
+}
+
+// Update Store. A store is deactivated rather than deleted, its orders and stock ledger stay.
+func (service *StoreServiceImpl) Update(ctx context.Context, request web.StoreUpdateRequest) (web.StoreResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	store, err := service.findStore(ctx, request.Id)
+	if err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	store.Code = request.Code
+	store.Name = request.Name
+	store.Address = request.Address
+	store.Phone = request.Phone
+	store.Active = request.Active
+	if err := service.checkCode(ctx, store); err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	updatedStore, err := service.StoreRepository.Update(ctx, store)
+	if err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	return helper.ToStoreResponse(updatedStore), nil
+}
+
+// Find Store By ID
+func (service *StoreServiceImpl) FindById(ctx context.Context, storeId uint64) (web.StoreResponse, error) {
+	store, err := service.findStore(ctx, storeId)
+	if err != nil {
+		return web.StoreResponse{}, err
+	}
+
+	return helper.ToStoreResponse(store), nil
+}
+
+// Find All Stores
+func (service *StoreServiceImpl) FindAll(ctx context.Context) ([]web.StoreResponse, error) {
+	stores, err := service.StoreRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToStoreResponses(stores), nil
+}
+
+// SetPrice overrides the catalogue price of a product at a store, or drops the override when Price is nil.
+// Orders already created keep the price they were created with.
+func (service *StoreServiceImpl) SetPrice(ctx context.Context, request web.StorePriceRequest) (web.StoreProductResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.StoreProductResponse{}, err
+	}
+
+	if _, err := service.findStore(ctx, request.StoreID); err != nil {
+		return web.StoreProductResponse{}, err
+	}
+	product, err := service.ProductRepository.FindById(ctx, request.ProductID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.StoreProductResponse{}, exception.NewNotFoundError("Product not found")
+	} else if err != nil {
+		return web.StoreProductResponse{}, err
+	}
+
+	storeProduct := domain.StoreProduct{StoreID: request.StoreID, ProductID: product.ProductID, Price: request.Price}
+	if err := service.StoreRepository.SavePrice(ctx, storeProduct); err != nil {
+		return web.StoreProductResponse{}, err
+	}
+
+	priced := false
+	for i := range product.Stores {
+		if product.Stores[i].StoreID == request.StoreID {
+			product.Stores[i].Price, priced = request.Price, true
+		}
+	}
+	if !priced {
+		product.Stores = append(product.Stores, storeProduct)
+	}
+
+	return helper.ToStoreProductResponse(product, request.StoreID), nil
+}
+
+// FindActive gets a store that still trades, for the services that put new orders, shifts and stock on it
+func (service *StoreServiceImpl) FindActive(ctx context.Context, storeId uint64) (domain.Store, error) {
+	store, err := service.findStore(ctx, storeId)
+	if err != nil {
+		return domain.Store{}, err
+	}
+	return activeStore(store)
+}
+
+// LockActive gets a store that still trades like FindActive and locks its row until the surrounding
+// transaction ends, for rules that span everything open at the store
+func (service *StoreServiceImpl) LockActive(ctx context.Context, storeId uint64) (domain.Store, error) {
+	store, err := service.StoreRepository.FindByIdForUpdate(ctx, storeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Store{}, exception.NewNotFoundError("Store not found")
+	} else if err != nil {
+		return domain.Store{}, err
+	}
+	return activeStore(store)
+}
+
+func activeStore(store domain.Store) (domain.Store, error) {
+	if !store.Active {
+		return domain.Store{}, exception.NewConflictError(fmt.Sprintf("Store %s is inactive", store.Code))
+	}
+	return store, nil
+}
+
+// checkCode makes sure no other store has the same code, documents refer to stores by code
+func (service *StoreServiceImpl) checkCode(ctx context.Context, store domain.Store) error {
+	stores, err := service.StoreRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range stores {
+		if other.StoreID != store.StoreID && other.Code == store.Code {
+			return exception.NewConflictError(fmt.Sprintf("There already is a store with code %s", store.Code))
+		}
+	}
+	return nil
+}
+
+func (service *StoreServiceImpl) findStore(ctx context.Context, storeId uint64) (domain.Store, error) {
+	store, err := service.StoreRepository.FindById(ctx, storeId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Store{}, exception.NewNotFoundError("Store not found")
+	}
+	return store, err
+}
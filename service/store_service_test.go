@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var storeModelTpl = domain.Store{StoreID: 1, Code: "MAIN", Name: "Main store", Active: true}
+
+func TestCreateStore(t *testing.T) {
+	tests := []struct {
+		name  string
+		input web.StoreCreateRequest
+		mock  func(storeRepo *mocks.MockStoreRepository)
+		err   error
+	}{
+		{
+			name:  "Success",
+			input: web.StoreCreateRequest{Code: "JKT01", Name: "Jakarta Kemang"},
+			mock: func(storeRepo *mocks.MockStoreRepository) {
+				storeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Store{storeModelTpl}, nil)
+				storeRepo.EXPECT().Save(gomock.Any(), domain.Store{Code: "JKT01", Name: "Jakarta Kemang", Active: true}).
+					DoAndReturn(func(ctx context.Context, store domain.Store) (domain.Store, error) {
+						store.StoreID = 2
+						return store, nil
+					})
+			},
+		},
+		{
+			name:  "Code taken",
+			input: web.StoreCreateRequest{Code: "MAIN", Name: "Second main"},
+			mock: func(storeRepo *mocks.MockStoreRepository) {
+				storeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Store{storeModelTpl}, nil)
+			},
+			err: exception.NewConflictError("There already is a store with code MAIN"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			storeRepo := mocks.NewMockStoreRepository(ctrl)
+			tt.mock(storeRepo)
+
+			service := NewStoreService(storeRepo, nil, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, uint64(2), result.Id)
+				assert.True(t, result.Active)
+			}
+		})
+	}
+}
+
+func TestUpdateStoreKeepsItsOwnCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storeRepo := mocks.NewMockStoreRepository(ctrl)
+	storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(storeModelTpl, nil)
+	storeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.Store{storeModelTpl}, nil)
+	storeRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, store domain.Store) (domain.Store, error) {
+		return store, nil
+	})
+
+	service := NewStoreService(storeRepo, nil, validator.New())
+	result, err := service.Update(context.Background(), web.StoreUpdateRequest{Id: 1, Code: "MAIN", Name: "Head office shop"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Head office shop", result.Name)
+	assert.False(t, result.Active)
+}
+
+func TestFindActiveStore(t *testing.T) {
+	closed := storeModelTpl
+	closed.Active = false
+
+	tests := []struct {
+		name   string
+		mock   func(storeRepo *mocks.MockStoreRepository)
+		expect domain.Store
+		err    error
+	}{
+		{
+			name: "Active",
+			mock: func(storeRepo *mocks.MockStoreRepository) {
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(storeModelTpl, nil)
+			},
+			expect: storeModelTpl,
+		},
+		{
+			name: "Inactive",
+			mock: func(storeRepo *mocks.MockStoreRepository) {
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(closed, nil)
+			},
+			err: exception.NewConflictError("Store MAIN is inactive"),
+		},
+		{
+			name: "Not Found",
+			mock: func(storeRepo *mocks.MockStoreRepository) {
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Store{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Store not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			storeRepo := mocks.NewMockStoreRepository(ctrl)
+			tt.mock(storeRepo)
+
+			service := NewStoreService(storeRepo, nil, validator.New())
+			result, err := service.FindActive(context.Background(), 1)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestSetStorePrice(t *testing.T) {
+	storePrice := money.New(9500)
+
+	tests := []struct {
+		name   string
+		input  web.StorePriceRequest
+		mock   func(storeRepo *mocks.MockStoreRepository, productRepo *mocks.MockProductRepository)
+		expect web.StoreProductResponse
+		err    error
+	}{
+		{
+			name:  "Overrides the catalogue price",
+			input: web.StorePriceRequest{StoreID: 1, ProductID: 1, Price: &storePrice},
+			mock: func(storeRepo *mocks.MockStoreRepository, productRepo *mocks.MockProductRepository) {
+				// Its own stores, the price is set on them in place
+				stocked := productModelTpl
+				stocked.Stores = []domain.StoreProduct{{StoreID: 1, ProductID: 1, StockQty: 100}}
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(storeModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(stocked, nil)
+				storeRepo.EXPECT().SavePrice(gomock.Any(), domain.StoreProduct{StoreID: 1, ProductID: 1, Price: &storePrice}).Return(nil)
+			},
+			expect: web.StoreProductResponse{StoreID: 1, ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH",
+				CatalogPrice: money.New(10000), PriceOverride: &storePrice, Price: storePrice, StockQty: 100},
+		},
+		{
+			name:  "Store without stock of the product",
+			input: web.StorePriceRequest{StoreID: 2, ProductID: 1, Price: &storePrice},
+			mock: func(storeRepo *mocks.MockStoreRepository, productRepo *mocks.MockProductRepository) {
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(2)).Return(domain.Store{StoreID: 2, Active: true}, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(productModelTpl, nil)
+				storeRepo.EXPECT().SavePrice(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expect: web.StoreProductResponse{StoreID: 2, ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH",
+				CatalogPrice: money.New(10000), PriceOverride: &storePrice, Price: storePrice},
+		},
+		{
+			name:  "Back to the catalogue price",
+			input: web.StorePriceRequest{StoreID: 1, ProductID: 1},
+			mock: func(storeRepo *mocks.MockStoreRepository, productRepo *mocks.MockProductRepository) {
+				overridden := productModelTpl
+				overridden.Stores = []domain.StoreProduct{{StoreID: 1, ProductID: 1, StockQty: 100, Price: &storePrice}}
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(storeModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(overridden, nil)
+				storeRepo.EXPECT().SavePrice(gomock.Any(), domain.StoreProduct{StoreID: 1, ProductID: 1}).Return(nil)
+			},
+			expect: web.StoreProductResponse{StoreID: 1, ProductID: 1, ProductName: "Barang mewwah", SKU: "MWH",
+				CatalogPrice: money.New(10000), Price: money.New(10000), StockQty: 100},
+		},
+		{
+			name:  "Product Not Found",
+			input: web.StorePriceRequest{StoreID: 1, ProductID: 9, Price: &storePrice},
+			mock: func(storeRepo *mocks.MockStoreRepository, productRepo *mocks.MockProductRepository) {
+				storeRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(storeModelTpl, nil)
+				productRepo.EXPECT().FindById(gomock.Any(), uint64(9)).Return(domain.Product{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Product not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			storeRepo := mocks.NewMockStoreRepository(ctrl)
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			tt.mock(storeRepo, productRepo)
+
+			service := NewStoreService(storeRepo, productRepo, validator.New())
+			result, err := service.SetPrice(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
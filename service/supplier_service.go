@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type SupplierService interface {
+	Create(ctx context.Context, request web.SupplierCreateRequest) (web.SupplierResponse, error)
+	Update(ctx context.Context, request web.SupplierUpdateRequest) (web.SupplierResponse, error)
+	Delete(ctx context.Context, supplierId uint64) error
+	FindById(ctx context.Context, supplierId uint64) (web.SupplierResponse, error)
+	FindAll(ctx context.Context) ([]web.SupplierResponse, error)
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+type SupplierServiceImpl struct {
+	SupplierRepository repository.SupplierRepository
+	Validate           *validator.Validate
+}
+
+func NewSupplierService(supplierRepository repository.SupplierRepository, validate *validator.Validate) SupplierService {
+	return &SupplierServiceImpl{
+		SupplierRepository: supplierRepository,
+		Validate:           validate,
+	}
+}
+
+// Create Supplier
+func (service *SupplierServiceImpl) Create(ctx context.Context, request web.SupplierCreateRequest) (web.SupplierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	supplier := domain.Supplier{
+		Name:        request.Name,
+		ContactName: request.ContactName,
+		Email:       request.Email,
+		Phone:       request.Phone,
+		Address:     request.Address,
+	}
+	savedSupplier, err := service.SupplierRepository.Save(ctx, supplier)
+	if err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	return helper.ToSupplierResponse(savedSupplier), nil
+}
+
+// Update Supplier
+func (service *SupplierServiceImpl) Update(ctx context.Context, request web.SupplierUpdateRequest) (web.SupplierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	supplier, err := service.SupplierRepository.FindById(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.SupplierResponse{}, exception.NewNotFoundError("Supplier not found")
+	} else if err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	supplier.Name = request.Name
+	supplier.ContactName = request.ContactName
+	supplier.Email = request.Email
+	supplier.Phone = request.Phone
+	supplier.Address = request.Address
+	updatedSupplier, err := service.SupplierRepository.Update(ctx, supplier)
+	if err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	return helper.ToSupplierResponse(updatedSupplier), nil
+}
+
+// Delete Supplier, refused once purchase orders were placed with it
+func (service *SupplierServiceImpl) Delete(ctx context.Context, supplierId uint64) error {
+	supplier, err := service.SupplierRepository.FindById(ctx, supplierId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Supplier not found")
+	} else if err != nil {
+		return err
+	}
+
+	count, err := service.SupplierRepository.CountPurchaseOrders(ctx, supplierId)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return exception.NewConflictError(fmt.Sprintf("Supplier still has %d purchase order(s)", count))
+	}
+
+	return service.SupplierRepository.Delete(ctx, supplier)
+}
+
+// Find Supplier By ID
+func (service *SupplierServiceImpl) FindById(ctx context.Context, supplierId uint64) (web.SupplierResponse, error) {
+	supplier, err := service.SupplierRepository.FindById(ctx, supplierId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.SupplierResponse{}, exception.NewNotFoundError("Supplier not found")
+	} else if err != nil {
+		return web.SupplierResponse{}, err
+	}
+
+	return helper.ToSupplierResponse(supplier), nil
+}
+
+// Find All Suppliers
+func (service *SupplierServiceImpl) FindAll(ctx context.Context) ([]web.SupplierResponse, error) {
+	suppliers, err := service.SupplierRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToSupplierResponses(suppliers), nil
+}
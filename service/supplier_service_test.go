@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var supplierModelTpl = domain.Supplier{
+	SupplierID:  1,
+	Name:        "PT Sumber Makmur",
+	ContactName: "Budi",
+	Email:       "order@sumbermakmur.co.id",
+	Phone:       "021-7654321",
+}
+
+func TestCreateSupplier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	supplierRepo := mocks.NewMockSupplierRepository(ctrl)
+	supplierRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+		supplier.SupplierID = 1
+		return supplier, nil
+	})
+
+	service := NewSupplierService(supplierRepo, validator.New())
+	result, err := service.Create(context.Background(), web.SupplierCreateRequest{Name: "PT Sumber Makmur", ContactName: "Budi",
+		Email: "order@sumbermakmur.co.id", Phone: "021-7654321"})
+	assert.NoError(t, err)
+	assert.Equal(t, web.SupplierResponse{Id: 1, Name: "PT Sumber Makmur", ContactName: "Budi",
+		Email: "order@sumbermakmur.co.id", Phone: "021-7654321"}, result)
+
+	_, err = service.Create(context.Background(), web.SupplierCreateRequest{Name: "PT Sumber Makmur", Email: "not-an-email"})
+	assert.IsType(t, validator.ValidationErrors{}, err)
+}
+
+func TestDeleteSupplier(t *testing.T) {
+	tests := []struct {
+		name string
+		mock func(supplierRepo *mocks.MockSupplierRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(supplierRepo *mocks.MockSupplierRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(supplierModelTpl, nil)
+				supplierRepo.EXPECT().CountPurchaseOrders(gomock.Any(), uint64(1)).Return(int64(0), nil)
+				supplierRepo.EXPECT().Delete(gomock.Any(), supplierModelTpl).Return(nil)
+			},
+		},
+		{
+			name: "Has Purchase Orders",
+			mock: func(supplierRepo *mocks.MockSupplierRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(supplierModelTpl, nil)
+				supplierRepo.EXPECT().CountPurchaseOrders(gomock.Any(), uint64(1)).Return(int64(2), nil)
+			},
+			err: exception.NewConflictError("Supplier still has 2 purchase order(s)"),
+		},
+		{
+			name: "Not Found",
+			mock: func(supplierRepo *mocks.MockSupplierRepository) {
+				supplierRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Supplier{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Supplier not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			supplierRepo := mocks.NewMockSupplierRepository(ctrl)
+			tt.mock(supplierRepo)
+
+			service := NewSupplierService(supplierRepo, validator.New())
+			assert.Equal(t, tt.err, service.Delete(context.Background(), 1))
+		})
+	}
+}
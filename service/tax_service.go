@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+)
+
+type TaxService interface {
+	Create(ctx context.Context, request web.TaxCreateRequest) (web.TaxResponse, error)
+	Update(ctx context.Context, request web.TaxUpdateRequest) (web.TaxResponse, error)
+	Delete(ctx context.Context, taxId uint64) error
+	FindById(ctx context.Context, taxId uint64) (web.TaxResponse, error)
+	FindAll(ctx context.Context) ([]web.TaxResponse, error)
+	FindSettings(ctx context.Context) (web.TaxSettingsResponse, error)
+	UpdateSettings(ctx context.Context, request web.TaxSettingsUpdateRequest) (web.TaxSettingsResponse, error)
+	PricesIncludeTax(ctx context.Context) (bool, error)
+	FindMoneySettings(ctx context.Context) (web.MoneySettingsResponse, error)
+	UpdateMoneySettings(ctx context.Context, request web.MoneySettingsUpdateRequest) (web.MoneySettingsResponse, error)
+	ConfigureMoney(ctx context.Context) error
+	Calculate(lines []domain.TaxLine, pricesIncludeTax bool) domain.TaxCalculation
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/helper"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+	"sort"
+)
+
+type TaxServiceImpl struct {
+	TaxRepository repository.TaxRepository
+	Validate      *validator.Validate
+}
+
+func NewTaxService(taxRepository repository.TaxRepository, validate *validator.Validate) TaxService {
+	return &TaxServiceImpl{
+		TaxRepository: taxRepository,
+		Validate:      validate,
+	}
+}
+
+// Create Tax class
+func (service *TaxServiceImpl) Create(ctx context.Context, request web.TaxCreateRequest) (web.TaxResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.TaxResponse{}, err
+	}
+	if err := validateTaxRate(request.TaxType, request.TaxRate); err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	tax := domain.Tax{
+		Name:        request.Name,
+		TaxRate:     request.TaxRate,
+		TaxType:     request.TaxType,
+		Description: request.Description,
+	}
+	savedTax, err := service.TaxRepository.Save(ctx, tax)
+	if err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	return helper.ToTaxResponse(savedTax), nil
+}
+
+// Update Tax class. Orders and receipts keep the rate they were priced with.
+func (service *TaxServiceImpl) Update(ctx context.Context, request web.TaxUpdateRequest) (web.TaxResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.TaxResponse{}, err
+	}
+	if err := validateTaxRate(request.TaxType, request.TaxRate); err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	tax, err := service.TaxRepository.FindById(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.TaxResponse{}, exception.NewNotFoundError("Tax not found")
+	} else if err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	tax.Name = request.Name
+	tax.TaxRate = request.TaxRate
+	tax.TaxType = request.TaxType
+	tax.Description = request.Description
+	updatedTax, err := service.TaxRepository.Update(ctx, tax)
+	if err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	return helper.ToTaxResponse(updatedTax), nil
+}
+
+// validateTaxRate makes sure exempt classes carry no rate and taxed classes do
+func validateTaxRate(taxType string, taxRate float64) error {
+	if taxType == domain.TaxTypeExempt && taxRate != 0 {
+		return exception.NewBadRequestError("Exempt tax classes must have a rate of 0")
+	}
+	if taxType != domain.TaxTypeExempt && taxRate <= 0 {
+		return exception.NewBadRequestError(fmt.Sprintf("%s tax classes need a rate above 0", taxType))
+	}
+	return nil
+}
+
+// Delete Tax class, refused while products are still assigned to it
+func (service *TaxServiceImpl) Delete(ctx context.Context, taxId uint64) error {
+	tax, err := service.TaxRepository.FindById(ctx, taxId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewNotFoundError("Tax not found")
+	} else if err != nil {
+		return err
+	}
+
+	count, err := service.TaxRepository.CountProducts(ctx, taxId)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return exception.NewConflictError(fmt.Sprintf("Tax is still assigned to %d product(s)", count))
+	}
+
+	return service.TaxRepository.Delete(ctx, tax)
+}
+
+// Find Tax class By ID
+func (service *TaxServiceImpl) FindById(ctx context.Context, taxId uint64) (web.TaxResponse, error) {
+	tax, err := service.TaxRepository.FindById(ctx, taxId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return web.TaxResponse{}, exception.NewNotFoundError("Tax not found")
+	} else if err != nil {
+		return web.TaxResponse{}, err
+	}
+
+	return helper.ToTaxResponse(tax), nil
+}
+
+// Find All Tax classes
+func (service *TaxServiceImpl) FindAll(ctx context.Context) ([]web.TaxResponse, error) {
+	taxes, err := service.TaxRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ToTaxResponses(taxes), nil
+}
+
+// FindSettings - Get whether the store prices include tax
+func (service *TaxServiceImpl) FindSettings(ctx context.Context) (web.TaxSettingsResponse, error) {
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return web.TaxSettingsResponse{}, err
+	}
+
+	return web.TaxSettingsResponse{PricesIncludeTax: setting.PricesIncludeTax}, nil
+}
+
+// UpdateSettings - Switch the store between tax inclusive and tax exclusive prices. Only orders priced
+// afterwards are affected.
+func (service *TaxServiceImpl) UpdateSettings(ctx context.Context, request web.TaxSettingsUpdateRequest) (web.TaxSettingsResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.TaxSettingsResponse{}, err
+	}
+
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return web.TaxSettingsResponse{}, err
+	}
+
+	setting.PricesIncludeTax = *request.PricesIncludeTax
+	savedSetting, err := service.TaxRepository.SaveSetting(ctx, setting)
+	if err != nil {
+		return web.TaxSettingsResponse{}, err
+	}
+
+	return web.TaxSettingsResponse{PricesIncludeTax: savedSetting.PricesIncludeTax}, nil
+}
+
+// FindMoneySettings - Get the store currency and the rounding rule
+func (service *TaxServiceImpl) FindMoneySettings(ctx context.Context) (web.MoneySettingsResponse, error) {
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return web.MoneySettingsResponse{}, err
+	}
+
+	return toMoneySettingsResponse(setting.MoneySettings()), nil
+}
+
+// UpdateMoneySettings - Change the store currency and the rounding rule. They apply straight away, to
+// orders priced afterwards. Amounts are saved without their currency, so the currency only changes while
+// nothing has been priced or paid yet.
+func (service *TaxServiceImpl) UpdateMoneySettings(ctx context.Context, request web.MoneySettingsUpdateRequest) (web.MoneySettingsResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return web.MoneySettingsResponse{}, err
+	}
+
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return web.MoneySettingsResponse{}, err
+	}
+
+	if current := setting.MoneySettings().Currency; request.Currency != current {
+		count, err := service.TaxRepository.CountAmounts(ctx)
+		if err != nil {
+			return web.MoneySettingsResponse{}, err
+		}
+		if count > 0 {
+			return web.MoneySettingsResponse{}, exception.NewConflictError(fmt.Sprintf("Currency cannot change from %s to %s once amounts are saved in %s", current, request.Currency, current))
+		}
+	}
+
+	setting.Currency = request.Currency
+	setting.RoundingMode = request.RoundingMode
+	setting.RoundingStep = request.RoundingStep
+	savedSetting, err := service.TaxRepository.SaveSetting(ctx, setting)
+	if err != nil {
+		return web.MoneySettingsResponse{}, err
+	}
+	if err := money.Configure(savedSetting.MoneySettings()); err != nil {
+		return web.MoneySettingsResponse{}, err
+	}
+
+	return toMoneySettingsResponse(savedSetting.MoneySettings()), nil
+}
+
+// ConfigureMoney applies the saved currency and rounding rule, the store starts with them
+func (service *TaxServiceImpl) ConfigureMoney(ctx context.Context) error {
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return err
+	}
+	return money.Configure(setting.MoneySettings())
+}
+
+func toMoneySettingsResponse(settings money.Settings) web.MoneySettingsResponse {
+	return web.MoneySettingsResponse{
+		Currency:     settings.Currency,
+		RoundingMode: settings.Rounding.Mode,
+		RoundingStep: settings.Rounding.Step,
+	}
+}
+
+// PricesIncludeTax reports whether product prices are entered with tax included
+func (service *TaxServiceImpl) PricesIncludeTax(ctx context.Context) (bool, error) {
+	setting, err := service.TaxRepository.FindSetting(ctx)
+	if err != nil {
+		return false, err
+	}
+	return setting.PricesIncludeTax, nil
+}
+
+// Calculate taxes the lines per rate. Tax is rounded once per rate on the summed amounts and then shared
+// out over the lines, largest remainder first, so the line taxes always add up to the rate totals instead
+// of drifting a cent away from them.
+func (service *TaxServiceImpl) Calculate(lines []domain.TaxLine, pricesIncludeTax bool) domain.TaxCalculation {
+	calculation := domain.TaxCalculation{
+		PricesIncludeTax: pricesIncludeTax,
+		Lines:            make([]domain.TaxLineResult, len(lines)),
+	}
+
+	type rateGroup struct {
+		total domain.TaxRateTotal
+		lines []int
+	}
+	groups := make(map[string]*rateGroup)
+	var keys []string
+	for i, line := range lines {
+		key := fmt.Sprintf("%s|%g", line.TaxName, line.TaxRate)
+		group, ok := groups[key]
+		if !ok {
+			group = &rateGroup{total: domain.TaxRateTotal{TaxName: line.TaxName, TaxRate: line.TaxRate}}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.lines = append(group.lines, i)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := groups[keys[i]].total, groups[keys[j]].total
+		if a.TaxRate != b.TaxRate {
+			return a.TaxRate < b.TaxRate
+		}
+		return a.TaxName < b.TaxName
+	})
+
+	for _, key := range keys {
+		group := groups[key]
+		amounts := make([]money.Money, len(group.lines))
+		var groupAmount money.Money
+		for j, i := range group.lines {
+			amounts[j] = lines[i].Amount
+			groupAmount += lines[i].Amount
+		}
+
+		taxes := money.Allocate(rateTax(groupAmount, group.total.TaxRate, pricesIncludeTax), amounts)
+		for j, i := range group.lines {
+			result := domain.TaxLineResult{Tax: taxes[j]}
+			if pricesIncludeTax {
+				result.Gross = lines[i].Amount
+				result.Net = lines[i].Amount - taxes[j]
+			} else {
+				result.Net = lines[i].Amount
+				result.Gross = lines[i].Amount + taxes[j]
+			}
+			calculation.Lines[i] = result
+			group.total.Net += result.Net
+			group.total.Tax += result.Tax
+		}
+
+		if group.total.TaxRate != 0 {
+			calculation.Rates = append(calculation.Rates, group.total)
+		}
+	}
+
+	for _, line := range calculation.Lines {
+		calculation.Net += line.Net
+		calculation.Tax += line.Tax
+		calculation.Gross += line.Gross
+	}
+	return calculation
+}
+
+// orderTaxLines turns the order items into tax lines, discounts and promotions taken off
+func orderTaxLines(items []domain.OrderItem) []domain.TaxLine {
+	lines := make([]domain.TaxLine, len(items))
+	for i, item := range items {
+		lines[i] = domain.TaxLine{
+			TaxName: item.TaxName,
+			TaxRate: item.TaxRate,
+			Amount:  item.TotalPrice - item.Reductions(),
+		}
+	}
+	return lines
+}
+
+// rateTax is the tax in amount, extracted from it when prices include tax, rounded by the store rounding rule
+func rateTax(amount money.Money, rate float64, pricesIncludeTax bool) money.Money {
+	if pricesIncludeTax {
+		return amount.IncludedPercent(rate)
+	}
+	return amount.Percent(rate)
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"github.com/Kahffi/go-rest-api-test/exception"
+	"github.com/Kahffi/go-rest-api-test/model/domain"
+	"github.com/Kahffi/go-rest-api-test/model/web"
+	"github.com/Kahffi/go-rest-api-test/money"
+	"github.com/Kahffi/go-rest-api-test/repository/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var taxModelTpl = domain.Tax{
+	TaxID:   1,
+	Name:    "VAT 10%",
+	TaxRate: 10,
+	TaxType: domain.TaxTypeVAT,
+}
+
+func TestCreateTax(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  web.TaxCreateRequest
+		mock   func(taxRepo *mocks.MockTaxRepository)
+		expect web.TaxResponse
+		err    error
+	}{
+		{
+			name:  "Success",
+			input: web.TaxCreateRequest{Name: "VAT 10%", TaxRate: 10, TaxType: domain.TaxTypeVAT},
+			mock: func(taxRepo *mocks.MockTaxRepository) {
+				taxRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, tax domain.Tax) (domain.Tax, error) {
+					tax.TaxID = 1
+					return tax, nil
+				})
+			},
+			expect: web.TaxResponse{Id: 1, Name: "VAT 10%", TaxRate: 10, TaxType: domain.TaxTypeVAT},
+		},
+		{
+			name:  "Exempt with a rate",
+			input: web.TaxCreateRequest{Name: "Exempt", TaxRate: 5, TaxType: domain.TaxTypeExempt},
+			mock:  func(taxRepo *mocks.MockTaxRepository) {},
+			err:   exception.NewBadRequestError("Exempt tax classes must have a rate of 0"),
+		},
+		{
+			name:  "Sales tax without a rate",
+			input: web.TaxCreateRequest{Name: "Sales", TaxType: domain.TaxTypeSales},
+			mock:  func(taxRepo *mocks.MockTaxRepository) {},
+			err:   exception.NewBadRequestError("Sales Tax tax classes need a rate above 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			taxRepo := mocks.NewMockTaxRepository(ctrl)
+			tt.mock(taxRepo)
+
+			service := NewTaxService(taxRepo, validator.New())
+			result, err := service.Create(context.Background(), tt.input)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestDeleteTax(t *testing.T) {
+	tests := []struct {
+		name string
+		mock func(taxRepo *mocks.MockTaxRepository)
+		err  error
+	}{
+		{
+			name: "Success",
+			mock: func(taxRepo *mocks.MockTaxRepository) {
+				taxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
+				taxRepo.EXPECT().CountProducts(gomock.Any(), uint64(1)).Return(int64(0), nil)
+				taxRepo.EXPECT().Delete(gomock.Any(), taxModelTpl).Return(nil)
+			},
+		},
+		{
+			name: "Still Assigned",
+			mock: func(taxRepo *mocks.MockTaxRepository) {
+				taxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(taxModelTpl, nil)
+				taxRepo.EXPECT().CountProducts(gomock.Any(), uint64(1)).Return(int64(3), nil)
+			},
+			err: exception.NewConflictError("Tax is still assigned to 3 product(s)"),
+		},
+		{
+			name: "Not Found",
+			mock: func(taxRepo *mocks.MockTaxRepository) {
+				taxRepo.EXPECT().FindById(gomock.Any(), uint64(1)).Return(domain.Tax{}, gorm.ErrRecordNotFound)
+			},
+			err: exception.NewNotFoundError("Tax not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			taxRepo := mocks.NewMockTaxRepository(ctrl)
+			tt.mock(taxRepo)
+
+			service := NewTaxService(taxRepo, validator.New())
+			assert.Equal(t, tt.err, service.Delete(context.Background(), 1))
+		})
+	}
+}
+
+func TestUpdateTaxSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.StoreSetting{StoreSettingID: 1}, nil)
+	taxRepo.EXPECT().SaveSetting(gomock.Any(), domain.StoreSetting{StoreSettingID: 1, PricesIncludeTax: true}).
+		Return(domain.StoreSetting{StoreSettingID: 1, PricesIncludeTax: true}, nil)
+
+	pricesIncludeTax := true
+	service := NewTaxService(taxRepo, validator.New())
+	result, err := service.UpdateSettings(context.Background(), web.TaxSettingsUpdateRequest{PricesIncludeTax: &pricesIncludeTax})
+	assert.NoError(t, err)
+	assert.True(t, result.PricesIncludeTax)
+
+	_, err = service.UpdateSettings(context.Background(), web.TaxSettingsUpdateRequest{})
+	assert.Error(t, err)
+}
+
+func TestUpdateMoneySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer money.Configure(money.Settings{Currency: money.DefaultCurrency, Rounding: money.DefaultRounding})
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	saved := domain.StoreSetting{StoreSettingID: 1, PricesIncludeTax: true, Currency: "IDR", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(1)}
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.StoreSetting{StoreSettingID: 1, PricesIncludeTax: true}, nil)
+	taxRepo.EXPECT().SaveSetting(gomock.Any(), saved).Return(saved, nil)
+
+	service := NewTaxService(taxRepo, validator.New())
+	result, err := service.UpdateMoneySettings(context.Background(), web.MoneySettingsUpdateRequest{
+		Currency: "IDR", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(1),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, web.MoneySettingsResponse{Currency: "IDR", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(1)}, result)
+
+	// Tax is rounded to whole rupiah from now on
+	calculation := service.Calculate([]domain.TaxLine{{TaxName: "VAT 11%", TaxRate: 11, Amount: money.New(10150)}}, false)
+	assert.Equal(t, money.New(1117), calculation.Tax)
+
+	_, err = service.UpdateMoneySettings(context.Background(), web.MoneySettingsUpdateRequest{Currency: "IDR", RoundingMode: "Nearest", RoundingStep: 1})
+	assert.Error(t, err)
+}
+
+func TestUpdateMoneySettingsCurrencyInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer money.Configure(money.Settings{Currency: money.DefaultCurrency, Rounding: money.DefaultRounding})
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	current := domain.StoreSetting{StoreSettingID: 1, Currency: "IDR", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(1)}
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(current, nil).Times(2)
+	taxRepo.EXPECT().CountAmounts(gomock.Any()).Return(int64(3), nil)
+	rounded := current
+	rounded.RoundingStep = money.New(100)
+	taxRepo.EXPECT().SaveSetting(gomock.Any(), rounded).Return(rounded, nil)
+
+	service := NewTaxService(taxRepo, validator.New())
+	_, err := service.UpdateMoneySettings(context.Background(), web.MoneySettingsUpdateRequest{
+		Currency: "USD", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(1),
+	})
+	assert.Equal(t, exception.NewConflictError("Currency cannot change from IDR to USD once amounts are saved in IDR"), err)
+
+	// The rounding rule still changes
+	result, err := service.UpdateMoneySettings(context.Background(), web.MoneySettingsUpdateRequest{
+		Currency: "IDR", RoundingMode: money.RoundHalfUp, RoundingStep: money.New(100),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, money.New(100), result.RoundingStep)
+}
+
+func TestFindMoneySettingsDefaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	taxRepo := mocks.NewMockTaxRepository(ctrl)
+	taxRepo.EXPECT().FindSetting(gomock.Any()).Return(domain.StoreSetting{}, nil)
+
+	result, err := NewTaxService(taxRepo, validator.New()).FindMoneySettings(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, web.MoneySettingsResponse{Currency: money.DefaultCurrency, RoundingMode: money.RoundHalfUp, RoundingStep: 1}, result)
+}
+
+func TestCalculateTax(t *testing.T) {
+	tests := []struct {
+		name             string
+		lines            []domain.TaxLine
+		pricesIncludeTax bool
+		expect           domain.TaxCalculation
+	}{
+		{
+			name: "Exclusive shares the rounded cents over the lines",
+			lines: []domain.TaxLine{
+				{TaxName: "VAT 10%", TaxRate: 10, Amount: money.Money(33333)},
+				{TaxName: "VAT 10%", TaxRate: 10, Amount: money.Money(33333)},
+				{TaxName: "VAT 10%", TaxRate: 10, Amount: money.Money(33334)},
+			},
+			expect: domain.TaxCalculation{
+				Lines: []domain.TaxLineResult{
+					{Net: money.Money(33333), Tax: money.Money(3333), Gross: money.Money(36666)},
+					{Net: money.Money(33333), Tax: money.Money(3333), Gross: money.Money(36666)},
+					{Net: money.Money(33334), Tax: money.Money(3334), Gross: money.Money(36668)},
+				},
+				Rates: []domain.TaxRateTotal{{TaxName: "VAT 10%", TaxRate: 10, Net: money.New(1000), Tax: money.New(100)}},
+				Net:   money.New(1000),
+				Tax:   money.New(100),
+				Gross: money.New(1100),
+			},
+		},
+		{
+			name: "Inclusive extracts the tax and leaves exempt lines out of the rates",
+			lines: []domain.TaxLine{
+				{TaxName: "VAT 10%", TaxRate: 10, Amount: money.New(11000)},
+				{TaxName: "VAT 5%", TaxRate: 5, Amount: money.New(10500)},
+				{TaxName: "Exempt", TaxRate: 0, Amount: money.New(2000)},
+			},
+			pricesIncludeTax: true,
+			expect: domain.TaxCalculation{
+				PricesIncludeTax: true,
+				Lines: []domain.TaxLineResult{
+					{Net: money.New(10000), Tax: money.New(1000), Gross: money.New(11000)},
+					{Net: money.New(10000), Tax: money.New(500), Gross: money.New(10500)},
+					{Net: money.New(2000), Tax: 0, Gross: money.New(2000)},
+				},
+				Rates: []domain.TaxRateTotal{
+					{TaxName: "VAT 5%", TaxRate: 5, Net: money.New(10000), Tax: money.New(500)},
+					{TaxName: "VAT 10%", TaxRate: 10, Net: money.New(10000), Tax: money.New(1000)},
+				},
+				Net:   money.New(22000),
+				Tax:   money.New(1500),
+				Gross: money.New(23500),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTaxService(nil, nil)
+			assert.Equal(t, tt.expect, service.Calculate(tt.lines, tt.pricesIncludeTax))
+		})
+	}
+}